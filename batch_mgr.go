@@ -20,6 +20,8 @@ import (
 
 	"github.com/axiomesh/axiom-kit/txpool"
 	"github.com/axiomesh/axiom-kit/types"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
 )
 
 // batchManager manages basic batch issues, including:
@@ -34,6 +36,11 @@ type batchManager[T any, Constraint types.TXConstraint[T]] struct {
 	minTimeoutBatchTime   float64                        // track the min timeout batch time [only used by primary], reset when become primary.
 	minTimeoutNoBatchTime float64                        // track the min no tx timeout batch time [only used by primary], reset when become primary.
 	requestPool           txpool.TxPool[T, Constraint]
+	batchPolicy           BatchPolicy
+	policyGenerator       PolicyBatchGenerator[T, Constraint] // nil if request pool doesn't implement PolicyBatchGenerator
+	evictor               RequestEvictor                      // nil if request pool doesn't implement RequestEvictor, which is required by batch policy
+	receiveTimes          TxReceiveTimeProvider               // nil if request pool doesn't implement TxReceiveTimeProvider
+	bftTimeCommits        []*consensus.Commit                 // latest commit quorum certificate to compute BFT time of next batch
 }
 
 // newBatchManager initializes an instance of batchManager.
@@ -42,11 +49,24 @@ type batchManager[T any, Constraint types.TXConstraint[T]] struct {
 func newBatchManager[T any, Constraint types.TXConstraint[T]](requestPool txpool.TxPool[T, Constraint], c Config) *batchManager[T, Constraint] {
 	bm := &batchManager[T, Constraint]{
 		requestPool: requestPool,
+		batchPolicy: c.BatchPolicy,
 	}
+	bm.policyGenerator, _ = any(requestPool).(PolicyBatchGenerator[T, Constraint])
+	bm.evictor, _ = any(requestPool).(RequestEvictor)
+	bm.receiveTimes, _ = any(requestPool).(TxReceiveTimeProvider)
 
 	return bm
 }
 
+// generateRequestBatch generates a batch from request pool, which is generated under the batch policy
+// if request pool implements PolicyBatchGenerator.
+func (bm *batchManager[T, Constraint]) generateRequestBatch(typ int) (*txpool.RequestHashBatch[T, Constraint], error) {
+	if bm.batchPolicy != nil && bm.policyGenerator != nil {
+		return bm.policyGenerator.GenerateRequestBatchWithPolicy(typ, bm.batchPolicy)
+	}
+	return bm.requestPool.GenerateRequestBatch(typ)
+}
+
 func (bm *batchManager[T, Constraint]) getSeqNo() uint64 {
 	return bm.seqNo
 }
@@ -273,6 +293,19 @@ func (rbft *rbftImpl[T, Constraint]) maybeSendPrePrepare(batch *RequestBatch[T,
 		return
 	}
 
+	// restrict the number of in-flight batches in pipelining mode, cached batches will be
+	// proposed after some in-flight batches committed.
+	if rbft.pipelineFull() {
+		rbft.logger.Debugf("Replica %d is primary, not sending prePrepare because there are %d in-flight "+
+			"batches, while findCache is %t", rbft.chainConfig.SelfID, len(rbft.storeMgr.outstandingReqBatches), findCache)
+		if !findCache {
+			rbft.batchMgr.cacheBatch = append(rbft.batchMgr.cacheBatch, batch)
+			rbft.metrics.cacheBatchNumber.Add(float64(1))
+		}
+		rbft.metrics.pipelineFullCounter.Add(float64(1))
+		return
+	}
+
 	if findCache {
 		nextBatch = rbft.batchMgr.cacheBatch[0]
 		rbft.batchMgr.cacheBatch = rbft.batchMgr.cacheBatch[1:]
@@ -282,13 +315,41 @@ func (rbft *rbftImpl[T, Constraint]) maybeSendPrePrepare(batch *RequestBatch[T,
 		nextBatch = batch
 	}
 
+	nextBatch.SeqNo = nextSeqNo
+	if rbft.isVRFSeedEnabled() {
+		output, proof, err := rbft.proveVRF(nextSeqNo)
+		if err != nil {
+			rbft.logger.Errorf("Primary %d generate vrf proof for seqNo %d failed: %s, restore the batch",
+				rbft.chainConfig.SelfID, nextSeqNo, err)
+			_ = rbft.batchMgr.requestPool.RestoreOneBatch(nextBatch.BatchHash)
+			return
+		}
+		nextBatch.VRFProof = proof
+		nextBatch.VRFOutput = output
+	}
+	if rbft.isKeyRotationEnabled() {
+		nextBatch.KeyRotations = rbft.keys.proposable()
+	}
+	var bftTimeCommits []*consensus.Commit
+	if rbft.isBFTTimeEnabled() {
+		bftTimeCommits = rbft.proposeBFTTime(nextSeqNo, nextBatch)
+	}
+
 	digest := nextBatch.BatchHash
+	if hashBatch := nextBatch.hashBatch(); hasDigestExtension(hashBatch) {
+		digest = calculateBatchDigest(hashBatch)
+	}
 	// check for other PRE-PREPARE for same digest, but different seqNo
 	if rbft.storeMgr.existedDigest(rbft.chainConfig.View, nextSeqNo, digest) {
 		return
 	}
-
-	nextBatch.SeqNo = nextSeqNo
+	if digest != nextBatch.BatchHash {
+		if err := rbft.rekeyBatch(nextBatch, digest); err != nil {
+			rbft.logger.Warningf("Primary %d failed to re-index request batch %s by digest %s: %v",
+				rbft.chainConfig.SelfID, nextBatch.BatchHash, digest, err)
+			return
+		}
+	}
 
 	// cache and persist batch
 	rbft.storeMgr.outstandingReqBatches[digest] = nextBatch
@@ -302,13 +363,71 @@ func (rbft *rbftImpl[T, Constraint]) maybeSendPrePrepare(batch *RequestBatch[T,
 	rbft.softStartNewViewTimer(rbft.timerMgr.getTimeoutValue(requestTimer),
 		fmt.Sprintf("New request batch for view=%d/seqNo=%d", rbft.chainConfig.View, nextSeqNo), false)
 
-	rbft.sendPrePrepare(nextSeqNo, digest, nextBatch)
+	rbft.sendPrePrepare(nextSeqNo, digest, nextBatch, bftTimeCommits)
+	rbft.updatePipelineMetrics()
 
 	if rbft.sendInW(nextSeqNo+1) && len(rbft.batchMgr.cacheBatch) > 0 {
 		rbft.maybeSendPrePrepare(nil, true)
 	}
 }
 
+// rekeyBatch re-indexes the batch generated by request pool by the given digest, which covers fields
+// set after the batch is generated.
+func (rbft *rbftImpl[T, Constraint]) rekeyBatch(batch *RequestBatch[T, Constraint], digest string) error {
+	if err := rbft.batchMgr.requestPool.RestoreOneBatch(batch.BatchHash); err != nil {
+		return err
+	}
+	if _, err := rbft.batchMgr.requestPool.ReConstructBatchByOrder(&txpool.RequestHashBatch[T, Constraint]{
+		BatchHash:  digest,
+		TxHashList: batch.RequestHashList,
+		TxList:     batch.RequestList,
+		LocalList:  batch.LocalList,
+		Timestamp:  batch.Timestamp,
+	}); err != nil {
+		return err
+	}
+	batch.BatchHash = digest
+	return nil
+}
+
+// isPipelining returns if pipelining mode is enabled.
+func (rbft *rbftImpl[T, Constraint]) isPipelining() bool {
+	return rbft.config.MaxInFlightBatches > 0
+}
+
+// pipelineFull is used by primary to check if the number of in-flight batches has reached
+// MaxInFlightBatches in pipelining mode.
+func (rbft *rbftImpl[T, Constraint]) pipelineFull() bool {
+	return rbft.isPipelining() && uint64(len(rbft.storeMgr.outstandingReqBatches)) >= rbft.config.MaxInFlightBatches
+}
+
+// maybeProposeCachedBatches is used by primary to propose cached batches after some in-flight
+// batches have been committed in pipelining mode.
+func (rbft *rbftImpl[T, Constraint]) maybeProposeCachedBatches() {
+	if !rbft.isPipelining() || !rbft.isPrimary(rbft.chainConfig.SelfID) || !rbft.isNormal() {
+		return
+	}
+	if len(rbft.batchMgr.cacheBatch) == 0 || rbft.pipelineFull() {
+		return
+	}
+	rbft.logger.Debugf("Primary %d try to propose %d cached batches with %d in-flight batches",
+		rbft.chainConfig.SelfID, len(rbft.batchMgr.cacheBatch), len(rbft.storeMgr.outstandingReqBatches))
+	rbft.maybeSendPrePrepare(nil, true)
+}
+
+// updatePipelineMetrics updates the number of in-flight consensus instances and the number of
+// instances waiting for missing txs.
+func (rbft *rbftImpl[T, Constraint]) updatePipelineMetrics() {
+	depth := 0
+	for n := range rbft.storeMgr.seqMap {
+		if n > rbft.exec.lastExec {
+			depth++
+		}
+	}
+	rbft.metrics.pipelineDepthGauge.Set(float64(depth))
+	rbft.metrics.pipelineFetchingGauge.Set(float64(len(rbft.storeMgr.missingBatchesInFetching)))
+}
+
 // findNextPrepareBatch is used by the backup nodes to ensure that the batch corresponding to this cert exists.
 // If it exists, then prepare it.
 func (rbft *rbftImpl[T, Constraint]) findNextPrepareBatch(ctx context.Context, v uint64, n uint64, d string) error {
@@ -362,6 +481,33 @@ func (rbft *rbftImpl[T, Constraint]) findNextPrepareBatch(ctx context.Context, v
 		return nil
 	}
 
+	if err = rbft.validateBatch(txList, n, prePrep.HashBatch.Timestamp, prePrep.HashBatch.Proposer); err != nil {
+		rbft.logger.Warningf("Replica %d rejected batch view=%d/seqNo=%d/digest=%s from primary %d: %v, send viewChange",
+			rbft.chainConfig.SelfID, v, n, d, prePrep.HashBatch.Proposer, err)
+		rbft.metrics.rejectedBatchCounter.Add(float64(1))
+		rbft.sendViewChange()
+		return nil
+	}
+
+	if err = rbft.checkBatchPolicy(txList); err == nil {
+		err = rbft.checkOrderingPolicy(txList, prePrep.HashBatch.ReceiveTimes)
+	}
+	var vrfOutput []byte
+	if err == nil && rbft.isVRFSeedEnabled() {
+		vrfOutput, err = rbft.verifyVRFProof(n, prePrep.HashBatch.Proposer, prePrep.HashBatch.VrfProof)
+	}
+	// key rotations are verified when the batch is executed, only the amount is limited here.
+	if err == nil && len(prePrep.HashBatch.KeyRotations) > len(rbft.chainConfig.ValidatorSet) {
+		err = fmt.Errorf("too many key rotations %d", len(prePrep.HashBatch.KeyRotations))
+	}
+	if err != nil {
+		rbft.logger.Warningf("Replica %d rejected batch view=%d/seqNo=%d/digest=%s from primary %d: %v, send viewChange",
+			rbft.chainConfig.SelfID, v, n, d, prePrep.HashBatch.Proposer, err)
+		rbft.metrics.rejectedBatchCounter.Add(float64(1))
+		rbft.sendViewChange()
+		return nil
+	}
+
 	batch := &RequestBatch[T, Constraint]{
 		RequestHashList: prePrep.HashBatch.RequestHashList,
 		RequestList:     txList,
@@ -370,6 +516,10 @@ func (rbft *rbftImpl[T, Constraint]) findNextPrepareBatch(ctx context.Context, v
 		LocalList:       localList,
 		BatchHash:       d,
 		Proposer:        prePrep.HashBatch.Proposer,
+		VRFProof:        prePrep.HashBatch.VrfProof,
+		VRFOutput:       vrfOutput,
+		KeyRotations:    prePrep.HashBatch.KeyRotations,
+		BFTTime:         prePrep.HashBatch.BftTime,
 	}
 
 	// store batch to outstandingReqBatches until execute this batch
@@ -392,6 +542,191 @@ func (rbft *rbftImpl[T, Constraint]) findNextPrepareBatch(ctx context.Context, v
 	return rbft.sendPrepare(ctx, v, n, d)
 }
 
+// batchPolicyFitNum returns the number of leading txs in the given list which fit the batch policy.
+func (rbft *rbftImpl[T, Constraint]) batchPolicyFitNum(txs []*T) int {
+	if rbft.config.BatchPolicy == nil {
+		return len(txs)
+	}
+	var bytes, gas uint64
+	for i, tx := range txs {
+		bytes += uint64(Constraint(tx).RbftGetSize())
+		gas += Constraint(tx).RbftGetGasLimit()
+		if rbft.config.BatchPolicy.Exceeded(uint64(i+1), bytes, gas) {
+			return i
+		}
+	}
+	return len(txs)
+}
+
+// isOversizedTx returns whether the tx exceeds the batch policy on its own, which can never be packed.
+func (rbft *rbftImpl[T, Constraint]) isOversizedTx(tx *T) bool {
+	return rbft.config.BatchPolicy != nil &&
+		rbft.config.BatchPolicy.Exceeded(1, uint64(Constraint(tx).RbftGetSize()), Constraint(tx).RbftGetGasLimit())
+}
+
+// validateBatch asks application to check the batch proposed by primary if external stack implements
+// BatchValidator.
+func (rbft *rbftImpl[T, Constraint]) validateBatch(txs []*T, seqNo uint64, timestamp int64, proposer uint64) error {
+	if rbft.validator == nil {
+		return nil
+	}
+	return rbft.validator.ValidateBatch(txs, seqNo, timestamp, proposer)
+}
+
+// checkBatchPolicy checks if the batch proposed by primary fits the batch policy.
+func (rbft *rbftImpl[T, Constraint]) checkBatchPolicy(txs []*T) error {
+	if n := rbft.batchPolicyFitNum(txs); n != len(txs) {
+		return fmt.Errorf("batch with %d txs exceeds batch policy, only %d txs fit", len(txs), n)
+	}
+	return nil
+}
+
+// orderingTxs converts txs to the form used by ordering policy.
+func (rbft *rbftImpl[T, Constraint]) orderingTxs(txs []*T) []*OrderingTx {
+	res := make([]*OrderingTx, len(txs))
+	for i, tx := range txs {
+		res[i] = &OrderingTx{
+			Hash:      Constraint(tx).RbftGetTxHash(),
+			From:      Constraint(tx).RbftGetFrom(),
+			Nonce:     Constraint(tx).RbftGetNonce(),
+			GasPrice:  Constraint(tx).RbftGetGasPrice(),
+			Timestamp: Constraint(tx).RbftGetTimeStamp(),
+		}
+		if rbft.batchMgr.receiveTimes != nil {
+			res[i].ReceiveTime = rbft.batchMgr.receiveTimes.GetReceiveTime(res[i].Hash)
+		}
+	}
+	return res
+}
+
+// reportReceiveTimes returns the receive times of txs in the batch proposed by this replica as primary,
+// which are reported in the pre-prepare if the ordering policy implements ReceiveTimeVerifier.
+func (rbft *rbftImpl[T, Constraint]) reportReceiveTimes(txs []*T) []int64 {
+	if _, ok := rbft.config.OrderingPolicy.(ReceiveTimeVerifier); !ok || len(txs) == 0 {
+		return nil
+	}
+	times := make([]int64, len(txs))
+	for i, tx := range rbft.orderingTxs(txs) {
+		times[i] = receiveTime(tx)
+	}
+	return times
+}
+
+// checkOrderingPolicy checks if the batch proposed by primary follows the ordering policy, with the receive
+// times of txs reported by primary if the ordering policy implements ReceiveTimeVerifier.
+func (rbft *rbftImpl[T, Constraint]) checkOrderingPolicy(txs []*T, receiveTimes []int64) error {
+	if rbft.config.OrderingPolicy == nil {
+		return nil
+	}
+	orderingTxs := rbft.orderingTxs(txs)
+	if verifier, ok := rbft.config.OrderingPolicy.(ReceiveTimeVerifier); ok {
+		if len(receiveTimes) != len(txs) {
+			return fmt.Errorf("mismatch number of receive times %d and txs %d", len(receiveTimes), len(txs))
+		}
+		for i, tx := range orderingTxs {
+			tx.LocalReceiveTime, tx.ReceiveTime = tx.ReceiveTime, receiveTimes[i]
+		}
+		if err := verifier.VerifyReceiveTimes(orderingTxs); err != nil {
+			return err
+		}
+	}
+	return rbft.config.OrderingPolicy.Verify(orderingTxs)
+}
+
+// reorganizeRequestBatch reorders the batch generated by request pool with the ordering policy, then
+// cuts it to fit the batch policy, txs beyond the batch policy are restored to request pool and will
+// be packed into subsequent batches. Txs exceeding the batch policy on their own are evicted from
+// request pool, together with subsequent txs of the same sender in this batch which are restored to
+// request pool. It returns nil if failed to reorganize the batch or no tx is left.
+func (rbft *rbftImpl[T, Constraint]) reorganizeRequestBatch(reqBatch *txpool.RequestHashBatch[T, Constraint]) *txpool.RequestHashBatch[T, Constraint] {
+	txHashList, txList, localList := reqBatch.TxHashList, reqBatch.TxList, reqBatch.LocalList
+
+	reordered := false
+	if rbft.config.OrderingPolicy != nil {
+		orderingTxs := rbft.orderingTxs(txList)
+		index := make(map[*OrderingTx]int, len(orderingTxs))
+		for i, tx := range orderingTxs {
+			index[tx] = i
+		}
+		txHashList = make([]string, 0, len(reqBatch.TxList))
+		txList = make([]*T, 0, len(reqBatch.TxList))
+		localList = make([]bool, 0, len(reqBatch.TxList))
+		for i, tx := range rbft.config.OrderingPolicy.Order(orderingTxs) {
+			idx := index[tx]
+			if idx != i {
+				reordered = true
+			}
+			txHashList = append(txHashList, reqBatch.TxHashList[idx])
+			txList = append(txList, reqBatch.TxList[idx])
+			localList = append(localList, reqBatch.LocalList[idx])
+		}
+	}
+
+	var evicted []string
+	if rbft.config.BatchPolicy != nil {
+		blockedSenders := make(map[string]bool)
+		fitHashList := make([]string, 0, len(txList))
+		fitList := make([]*T, 0, len(txList))
+		fitLocalList := make([]bool, 0, len(txList))
+		for i, tx := range txList {
+			from := Constraint(tx).RbftGetFrom()
+			if rbft.isOversizedTx(tx) {
+				evicted = append(evicted, txHashList[i])
+				blockedSenders[from] = true
+				continue
+			}
+			if blockedSenders[from] {
+				continue
+			}
+			fitHashList = append(fitHashList, txHashList[i])
+			fitList = append(fitList, tx)
+			fitLocalList = append(fitLocalList, localList[i])
+		}
+		txHashList, txList, localList = fitHashList, fitList, fitLocalList
+	}
+
+	n := rbft.batchPolicyFitNum(txList)
+	if !reordered && n == len(reqBatch.TxList) {
+		return reqBatch
+	}
+
+	newBatch := &txpool.RequestHashBatch[T, Constraint]{
+		TxHashList: txHashList[:n],
+		TxList:     txList[:n],
+		LocalList:  localList[:n],
+		Timestamp:  reqBatch.Timestamp,
+	}
+	newBatch.BatchHash = calculateMD5Hash(newBatch.TxHashList, newBatch.Timestamp)
+	rbft.logger.Debugf("Primary %d reorganize request batch %s with %d txs into batch %s with %d txs, reordered: %t, evicted: %d",
+		rbft.chainConfig.SelfID, reqBatch.BatchHash, len(reqBatch.TxList), newBatch.BatchHash, n, reordered, len(evicted))
+
+	if err := rbft.batchMgr.requestPool.RestoreOneBatch(reqBatch.BatchHash); err != nil {
+		rbft.logger.Warningf("Primary %d failed to restore request batch %s: %v", rbft.chainConfig.SelfID, reqBatch.BatchHash, err)
+		return nil
+	}
+	if len(evicted) != 0 {
+		rbft.logger.Warningf("Primary %d found %d txs exceeding batch policy: %v", rbft.chainConfig.SelfID, len(evicted), evicted)
+		rbft.metrics.oversizedTxCounter.Add(float64(len(evicted)))
+		if err := rbft.batchMgr.evictor.RemoveRequests(evicted); err != nil {
+			rbft.logger.Warningf("Primary %d failed to evict txs exceeding batch policy: %v", rbft.chainConfig.SelfID, err)
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	if _, err := rbft.batchMgr.requestPool.ReConstructBatchByOrder(newBatch); err != nil {
+		rbft.logger.Warningf("Primary %d failed to reconstruct request batch %s: %v", rbft.chainConfig.SelfID, newBatch.BatchHash, err)
+		return nil
+	}
+	if reordered {
+		rbft.metrics.reorderedBatchCounter.Add(float64(1))
+	}
+	if n < len(reqBatch.TxList) {
+		rbft.metrics.batchPolicyCutCounter.Add(float64(1))
+	}
+	return newBatch
+}
+
 // primaryResubmitTransactions tries to submit transactions for primary after abnormal
 // or stable checkpoint.
 func (rbft *rbftImpl[T, Constraint]) primaryResubmitTransactions() {
@@ -431,13 +766,13 @@ func (rbft *rbftImpl[T, Constraint]) primaryResubmitTransactions() {
 					err   error
 				)
 				if rbft.isTest {
-					batch, err = rbft.batchMgr.requestPool.GenerateRequestBatch(txpool.GenBatchTimeoutEvent)
+					batch, err = rbft.batchMgr.generateRequestBatch(txpool.GenBatchTimeoutEvent)
 					if err != nil {
 						rbft.logger.Warningf("Primary %d failed to generate a batch, err: %v", rbft.chainConfig.SelfID, err)
 						return
 					}
 				} else {
-					batch, err = rbft.batchMgr.requestPool.GenerateRequestBatch(txpool.GenBatchFirstEvent)
+					batch, err = rbft.batchMgr.generateRequestBatch(txpool.GenBatchFirstEvent)
 					if err != nil {
 						rbft.logger.Debugf("Primary %d failed to generate a batch, err: %v", rbft.chainConfig.SelfID, err)
 						return
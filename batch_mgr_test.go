@@ -2,12 +2,15 @@ package rbft
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-kit/txpool"
 )
 
 func TestBatchMgr_startBatchTimer(t *testing.T) {
@@ -60,6 +63,116 @@ func TestBatchMgr_maybeSendPrePrepare(t *testing.T) {
 	assert.Equal(t, batchTmp42, rbfts[0].storeMgr.batchStore[batchTmp42.BatchHash])
 }
 
+func TestBatchMgr_pipelining(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	rbfts[0].config.MaxInFlightBatches = 1
+	assert.True(t, rbfts[0].isPipelining())
+
+	batch1 := &RequestBatch[consensus.FltTransaction, *consensus.FltTransaction]{
+		RequestHashList: []string{"tx-hash-1"},
+		RequestList:     []*consensus.FltTransaction{newTx()},
+		Timestamp:       time.Now().UnixNano(),
+		LocalList:       []bool{true},
+		BatchHash:       "test digest 1",
+	}
+	batch2 := &RequestBatch[consensus.FltTransaction, *consensus.FltTransaction]{
+		RequestHashList: []string{"tx-hash-2"},
+		RequestList:     []*consensus.FltTransaction{newTx()},
+		Timestamp:       time.Now().UnixNano(),
+		LocalList:       []bool{true},
+		BatchHash:       "test digest 2",
+	}
+
+	// the second batch is cached as there is already one in-flight batch.
+	rbfts[0].maybeSendPrePrepare(batch1, false)
+	rbfts[0].maybeSendPrePrepare(batch2, false)
+	assert.Equal(t, uint64(1), rbfts[0].batchMgr.getSeqNo())
+	assert.True(t, rbfts[0].pipelineFull())
+	assert.Equal(t, batch2, rbfts[0].batchMgr.cacheBatch[0])
+
+	// propose cached batch after the first one committed.
+	delete(rbfts[0].storeMgr.outstandingReqBatches, batch1.BatchHash)
+	rbfts[0].maybeProposeCachedBatches()
+	assert.Equal(t, uint64(2), rbfts[0].batchMgr.getSeqNo())
+	assert.Equal(t, 0, len(rbfts[0].batchMgr.cacheBatch))
+	assert.Equal(t, batch2, rbfts[0].storeMgr.outstandingReqBatches[batch2.BatchHash])
+}
+
+// BenchmarkBatchMgr_pipelining commits a fixed number of batches in a 4-node cluster with a
+// simulated network delay on every hop, to compare pipelining mode with different max in-flight
+// batches against the current behavior (0, only limited by high watermark).
+func BenchmarkBatchMgr_pipelining(b *testing.B) {
+	for _, maxInFlight := range []uint64{0, 1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("max_in_flight_%d", maxInFlight), func(b *testing.B) {
+			benchmarkPipelining(b, maxInFlight, 8, time.Millisecond)
+		})
+	}
+}
+
+func benchmarkPipelining(b *testing.B, maxInFlight uint64, batches int, hopDelay time.Duration) {
+	// deliver sends msgs to all other nodes after hopDelay and returns messages broadcast in response.
+	deliver := func(nodes []*testNode[consensus.FltTransaction, *consensus.FltTransaction],
+		rbfts []*rbftImpl[consensus.FltTransaction, *consensus.FltTransaction],
+		msgs []*consensusMessageWrapper) []*consensusMessageWrapper {
+		time.Sleep(hopDelay)
+		var responses []*consensusMessageWrapper
+		for i := range rbfts {
+			for _, msg := range msgs {
+				if msg.From == rbfts[i].chainConfig.SelfID {
+					continue
+				}
+				before := nodes[i].broadcastMessageCache
+				rbfts[i].processEvent(msg)
+				if after := nodes[i].broadcastMessageCache; after != before {
+					responses = append(responses, after)
+				}
+			}
+		}
+		return responses
+	}
+
+	batchTimerEvent := &LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreBatchTimerEvent,
+	}
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+		unlockCluster(rbfts)
+		primary := rbfts[0]
+		primary.config.BatchTimeout = 0
+		primary.config.MaxInFlightBatches = maxInFlight
+		b.StartTimer()
+
+		proposed := 0
+		for primary.exec.lastExec < uint64(batches) {
+			// primary proposes as many batches as it can in one round.
+			var prePrepares []*consensusMessageWrapper
+			for proposed < batches && !primary.pipelineFull() {
+				tx := newTx()
+				for _, r := range rbfts {
+					_ = r.batchMgr.requestPool.AddLocalTx(tx)
+				}
+				primary.processEvent(batchTimerEvent)
+				prePrepares = append(prePrepares, nodes[0].broadcastMessageCache)
+				proposed++
+			}
+			prepares := deliver(nodes, rbfts, prePrepares)
+			commits := deliver(nodes, rbfts, prepares)
+			deliver(nodes, rbfts, commits)
+			for _, r := range rbfts {
+				r.exec.setLastExec(nodes[0].Applied)
+			}
+		}
+		b.StopTimer()
+		for _, r := range rbfts {
+			r.batchMgr.requestPool.Stop()
+		}
+		b.StartTimer()
+	}
+}
+
 func TestBatchMgr_findNextPrepareBatch(t *testing.T) {
 	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
 
@@ -196,3 +309,220 @@ func TestBatchMgr_findNextPrepareBatch(t *testing.T) {
 		rbfts[0].storeMgr.certStore[msgIDConfBatch] = certConfBatch
 	})
 }
+
+func TestBatchMgr_findNextPrepareBatchRejected(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+
+	var validated uint64
+	rbfts[0].external.(*testExternal[consensus.FltTransaction, *consensus.FltTransaction]).validateBatch =
+		func(_ []*consensus.FltTransaction, seqNo uint64, _ int64, _ uint64) error {
+			validated = seqNo
+			return errors.New("invalid batch")
+		}
+
+	msgIDTmp := msgID{
+		v: 0,
+		n: 20,
+		d: "msg",
+	}
+	cert := &msgCert{
+		prePrepare: &consensus.PrePrepare{
+			ReplicaId:      2,
+			View:           0,
+			SequenceNumber: 20,
+			BatchDigest:    "msg",
+			HashBatch:      &consensus.HashBatch{Timestamp: 10086, Proposer: 2},
+		},
+	}
+	rbfts[0].storeMgr.certStore[msgIDTmp] = cert
+
+	assert.Nil(t, rbfts[0].findNextPrepareBatch(context.TODO(), 0, 20, "msg"))
+	assert.Equal(t, uint64(20), validated)
+	assert.False(t, cert.sentPrepare)
+	assert.Nil(t, rbfts[0].storeMgr.batchStore["msg"])
+	assert.True(t, rbfts[0].atomicIn(InViewChange))
+}
+
+func TestBatchMgr_reorganizeRequestBatch(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	pool := rbfts[0].batchMgr.requestPool
+	for i := 0; i < 4; i++ {
+		assert.Nil(t, pool.AddLocalTx(newTx()))
+	}
+	batch, err := pool.GenerateRequestBatch(txpool.GenBatchTimeoutEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(batch.TxList))
+
+	// no batch policy, batch is not cut.
+	assert.Equal(t, batch, rbfts[0].reorganizeRequestBatch(batch))
+
+	// each FltTransaction costs 10 gas, only 2 txs fit.
+	rbfts[0].config.BatchPolicy = &BatchLimitPolicy{MaxGas: 25}
+	cutBatch := rbfts[0].reorganizeRequestBatch(batch)
+	assert.NotNil(t, cutBatch)
+	assert.Equal(t, batch.TxHashList[:2], cutBatch.TxHashList)
+	assert.Equal(t, batch.Timestamp, cutBatch.Timestamp)
+	assert.Equal(t, calculateMD5Hash(cutBatch.TxHashList, cutBatch.Timestamp), cutBatch.BatchHash)
+	assert.Nil(t, rbfts[0].checkBatchPolicy(cutBatch.TxList))
+
+	// txs beyond the policy are restored to pool.
+	txList, _, missing, err := pool.GetRequestsByHashList(cutBatch.BatchHash, cutBatch.Timestamp, cutBatch.TxHashList, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, missing)
+	assert.Equal(t, cutBatch.TxList, txList)
+	assert.True(t, pool.HasPendingRequestInPool())
+	next, err := pool.GenerateRequestBatch(txpool.GenBatchTimeoutEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, batch.TxHashList[2:], next.TxHashList)
+
+	rbfts[0].config.BatchPolicy = &BatchLimitPolicy{MaxCount: 3}
+	assert.Equal(t, 3, rbfts[0].batchPolicyFitNum(batch.TxList))
+
+	// oversized txs never fit and are evicted from pool.
+	evictor := &testRequestEvictor{}
+	rbfts[0].batchMgr.evictor = evictor
+	rbfts[0].config.BatchPolicy = &BatchLimitPolicy{MaxBytes: 1}
+	assert.Equal(t, 0, rbfts[0].batchPolicyFitNum(next.TxList))
+	assert.NotNil(t, rbfts[0].checkBatchPolicy(next.TxList[:1]))
+	assert.Nil(t, rbfts[0].reorganizeRequestBatch(next))
+	assert.Equal(t, next.TxHashList, evictor.removed)
+}
+
+type testRequestEvictor struct {
+	removed []string
+}
+
+func (e *testRequestEvictor) RemoveRequests(txHashes []string) error {
+	e.removed = append(e.removed, txHashes...)
+	return nil
+}
+
+type testPolicyBatchGenerator struct {
+	policy BatchPolicy
+}
+
+func (g *testPolicyBatchGenerator) GenerateRequestBatchWithPolicy(_ int, policy BatchPolicy) (*txpool.RequestHashBatch[consensus.FltTransaction, *consensus.FltTransaction], error) {
+	g.policy = policy
+	return &txpool.RequestHashBatch[consensus.FltTransaction, *consensus.FltTransaction]{}, nil
+}
+
+func TestBatchMgr_generateRequestBatchWithPolicy(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	generator := &testPolicyBatchGenerator{}
+	bm := rbfts[0].batchMgr
+	bm.policyGenerator = generator
+
+	// request pool generates batches by itself without batch policy.
+	assert.Nil(t, bm.requestPool.AddLocalTx(newTx()))
+	batch, err := bm.generateRequestBatch(txpool.GenBatchTimeoutEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(batch.TxList))
+	assert.Nil(t, generator.policy)
+
+	bm.batchPolicy = &BatchLimitPolicy{MaxCount: 1}
+	_, err = bm.generateRequestBatch(txpool.GenBatchTimeoutEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, bm.batchPolicy, generator.policy)
+}
+
+func TestBatchMgr_findNextPrepareBatchBeyondPolicy(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	rbfts[1].config.BatchPolicy = &BatchLimitPolicy{MaxCount: 1}
+
+	txs := []*consensus.FltTransaction{newTx(), newTx()}
+	reqBatch := &txpool.RequestHashBatch[consensus.FltTransaction, *consensus.FltTransaction]{
+		TxHashList: []string{txs[0].RbftGetTxHash(), txs[1].RbftGetTxHash()},
+		TxList:     txs,
+		LocalList:  []bool{false, false},
+		Timestamp:  time.Now().UnixNano(),
+	}
+	reqBatch.BatchHash = calculateMD5Hash(reqBatch.TxHashList, reqBatch.Timestamp)
+	_, err := rbfts[1].batchMgr.requestPool.ReConstructBatchByOrder(reqBatch)
+	assert.Nil(t, err)
+
+	cert := rbfts[1].storeMgr.getCert(0, 1, reqBatch.BatchHash)
+	cert.prePrepare = &consensus.PrePrepare{
+		ReplicaId:      1,
+		View:           0,
+		SequenceNumber: 1,
+		BatchDigest:    reqBatch.BatchHash,
+		HashBatch: &consensus.HashBatch{
+			RequestHashList: reqBatch.TxHashList,
+			Timestamp:       reqBatch.Timestamp,
+			Proposer:        1,
+		},
+	}
+
+	assert.Nil(t, rbfts[1].findNextPrepareBatch(context.TODO(), 0, 1, reqBatch.BatchHash))
+	assert.False(t, cert.sentPrepare)
+	assert.Nil(t, rbfts[1].storeMgr.batchStore[reqBatch.BatchHash])
+	assert.True(t, rbfts[1].atomicIn(InViewChange))
+}
+
+func TestBatchMgr_reorganizeRequestBatchOrdering(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	rbfts[0].config.OrderingPolicy = &FairOrderingPolicy{}
+	rbfts[0].config.BatchPolicy = &BatchLimitPolicy{MaxCount: 2}
+
+	pool := rbfts[0].batchMgr.requestPool
+	now := time.Now().UnixNano()
+	for i := 0; i < 3; i++ {
+		tx := newTx()
+		tx.Timestamp = now - int64(i)
+		assert.Nil(t, pool.AddLocalTx(tx))
+	}
+	batch, err := pool.GenerateRequestBatch(txpool.GenBatchTimeoutEvent)
+	assert.Nil(t, err)
+
+	newBatch := rbfts[0].reorganizeRequestBatch(batch)
+	assert.NotNil(t, newBatch)
+	assert.Equal(t, []string{batch.TxHashList[2], batch.TxHashList[1]}, newBatch.TxHashList)
+	assert.Equal(t, calculateMD5Hash(newBatch.TxHashList, newBatch.Timestamp), newBatch.BatchHash)
+	receiveTimes := rbfts[0].reportReceiveTimes(newBatch.TxList)
+	assert.Equal(t, []int64{now - 2, now - 1}, receiveTimes)
+	assert.Nil(t, rbfts[0].checkOrderingPolicy(newBatch.TxList, receiveTimes))
+	assert.NotNil(t, rbfts[0].checkOrderingPolicy(newBatch.TxList, nil))
+	assert.NotNil(t, rbfts[0].checkOrderingPolicy(batch.TxList, rbfts[0].reportReceiveTimes(batch.TxList)))
+
+	// the oldest-first tx beyond the batch policy is restored to pool.
+	next, err := pool.GenerateRequestBatch(txpool.GenBatchTimeoutEvent)
+	assert.Nil(t, err)
+	assert.Equal(t, batch.TxHashList[:1], next.TxHashList)
+}
+
+func TestBatchMgr_findNextPrepareBatchOutOfOrder(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	rbfts[1].config.OrderingPolicy = &NonceOrderingPolicy{}
+
+	tx0, tx1 := newTx(), newTx()
+	tx1.From = tx0.From
+	tx1.Nonce = tx0.Nonce + 1
+	txs := []*consensus.FltTransaction{tx1, tx0}
+	reqBatch := &txpool.RequestHashBatch[consensus.FltTransaction, *consensus.FltTransaction]{
+		TxHashList: []string{tx1.RbftGetTxHash(), tx0.RbftGetTxHash()},
+		TxList:     txs,
+		LocalList:  []bool{false, false},
+		Timestamp:  time.Now().UnixNano(),
+	}
+	reqBatch.BatchHash = calculateMD5Hash(reqBatch.TxHashList, reqBatch.Timestamp)
+	_, err := rbfts[1].batchMgr.requestPool.ReConstructBatchByOrder(reqBatch)
+	assert.Nil(t, err)
+
+	cert := rbfts[1].storeMgr.getCert(0, 1, reqBatch.BatchHash)
+	cert.prePrepare = &consensus.PrePrepare{
+		ReplicaId:      1,
+		View:           0,
+		SequenceNumber: 1,
+		BatchDigest:    reqBatch.BatchHash,
+		HashBatch: &consensus.HashBatch{
+			RequestHashList: reqBatch.TxHashList,
+			Timestamp:       reqBatch.Timestamp,
+			Proposer:        1,
+		},
+	}
+
+	assert.Nil(t, rbfts[1].findNextPrepareBatch(context.TODO(), 0, 1, reqBatch.BatchHash))
+	assert.False(t, cert.sentPrepare)
+	assert.Nil(t, rbfts[1].storeMgr.batchStore[reqBatch.BatchHash])
+	assert.True(t, rbfts[1].atomicIn(InViewChange))
+}
@@ -0,0 +1,64 @@
+package rbft
+
+import (
+	"time"
+
+	"github.com/axiomesh/axiom-kit/txpool"
+	"github.com/axiomesh/axiom-kit/types"
+)
+
+// BatchPolicy decides when primary closes a request batch, a batch is closed once it reaches any
+// limit of the policy or the batch timeout expires, whichever comes first. Replicas enforce the same
+// policy when validating a prePrepare, so all nodes in cluster must be configured with the same policy.
+type BatchPolicy interface {
+	// BatchTimeout returns the max time duration before primary closing a batch, Config.BatchTimeout
+	// is used if it's not larger than 0.
+	BatchTimeout() time.Duration
+
+	// Exceeded returns whether a batch with the given number of txs, total size in bytes and
+	// cumulative gas limit exceeds the policy.
+	Exceeded(count uint64, bytes uint64, gas uint64) bool
+}
+
+// BatchLimitPolicy is the default BatchPolicy which limits the number of txs, total size in bytes
+// and cumulative gas limit of a batch, a limit of 0 means no limit.
+type BatchLimitPolicy struct {
+	// MaxCount is the max number of txs in a batch.
+	MaxCount uint64
+
+	// MaxBytes is the max total size of txs in a batch.
+	MaxBytes uint64
+
+	// MaxGas is the max cumulative gas limit of txs in a batch, which is usually the block gas limit.
+	MaxGas uint64
+
+	// Timeout is the max time duration before primary closing a batch.
+	Timeout time.Duration
+}
+
+// BatchTimeout implements BatchPolicy.
+func (p *BatchLimitPolicy) BatchTimeout() time.Duration {
+	return p.Timeout
+}
+
+// Exceeded implements BatchPolicy.
+func (p *BatchLimitPolicy) Exceeded(count uint64, bytes uint64, gas uint64) bool {
+	return (p.MaxCount > 0 && count > p.MaxCount) ||
+		(p.MaxBytes > 0 && bytes > p.MaxBytes) ||
+		(p.MaxGas > 0 && gas > p.MaxGas)
+}
+
+// PolicyBatchGenerator is an optional extension of request pool which enforces the batch policy while
+// generating batches, so that primary doesn't need to cut and reconstruct batches afterwards.
+type PolicyBatchGenerator[T any, Constraint types.TXConstraint[T]] interface {
+	// GenerateRequestBatchWithPolicy generates a batch like GenerateRequestBatch, which is closed before
+	// adding a tx that makes the batch exceed the policy.
+	GenerateRequestBatchWithPolicy(typ int, policy BatchPolicy) (*txpool.RequestHashBatch[T, Constraint], error)
+}
+
+// RequestEvictor is an extension of request pool which removes txs that can never be packed into a batch,
+// i.e. txs exceeding the batch policy on their own, which is required if the batch policy is set.
+type RequestEvictor interface {
+	// RemoveRequests removes the txs with given hashes from request pool.
+	RemoveRequests(txHashes []string) error
+}
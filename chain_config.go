@@ -23,6 +23,27 @@ const (
 const (
 	ProposerElectionTypeWRF              = "wrf"
 	ProposerElectionTypeAbnormalRotation = "abnormal-rotation"
+
+	// ProposerElectionTypeReputation selects proposer like WRF, but weights the voting power of
+	// validators by their reputation, built from recent committed proposals and timeouts as primary.
+	ProposerElectionTypeReputation = "reputation"
+)
+
+const (
+	// VoteAggregationTypeAllToAll broadcasts prepare and commit to all replicas.
+	VoteAggregationTypeAllToAll = "all-to-all"
+
+	// VoteAggregationTypeLinear unicasts signed prepare and commit to the primary, which acts as
+	// the collector and broadcasts the aggregated quorum certificates.
+	VoteAggregationTypeLinear = "linear"
+)
+
+const (
+	// ConsensusEngineTypeRBFT runs the three-phase RBFT protocol.
+	ConsensusEngineTypeRBFT = "rbft"
+
+	// ConsensusEngineTypeChainedHotStuff runs chained HotStuff with a rotating leader per view.
+	ConsensusEngineTypeChainedHotStuff = "chained-hotstuff"
 )
 
 type ValidatorInfo struct {
@@ -33,6 +54,9 @@ type ValidatorInfo struct {
 
 	ConsensusVotingPowerReduced    bool
 	ConsensusVotingPowerReduceView uint64
+
+	// decaying penalty of timeouts as primary, only used by reputation proposer election
+	ReputationPenalty uint64
 }
 
 // NodeInfo node info
@@ -97,6 +121,12 @@ type EpochDerivedData struct {
 	nodeInfoMap map[uint64]NodeInfo
 
 	ValidatorSet map[uint64]int64
+
+	// Vote aggregation type of current epoch.
+	VoteAggregationType string
+
+	// Whether fast path is enabled in current epoch.
+	FastPath bool
 }
 
 type DynamicChainConfig struct {
@@ -117,12 +147,18 @@ type DynamicChainConfig struct {
 	// last checkpoint block hash
 	LastCheckpointExecBlockHash string
 
+	// VRF output of the proposer of last checkpoint block, only set if VRF seed is enabled.
+	LastCheckpointVRFOutput []byte
+
 	LastCheckpointExecBlockHeight uint64
 
 	// Proposer node id of the current View period.
 	PrimaryID uint64
 
 	RecentBlockProcessorTracker *BlockProcessorTracker
+
+	// Reputation of validators built from recent blocks, only used by reputation proposer election.
+	ReputationTracker *ReputationTracker
 }
 
 // BlockProcessorTracker use rings to track recent block proposers
@@ -221,10 +257,27 @@ type ChainConfig struct {
 	logger           common.Logger
 	getNodeInfoFn    func(nodeID uint64) (*NodeInfo, error)
 	getNodeIDByP2PID func(p2pID string) (uint64, error)
+
+	getVoteAggregationTypeFn func(epochInfo *kittypes.EpochInfo) string
+	isFastPathEnabledFn      func(epochInfo *kittypes.EpochInfo) bool
 }
 
+// isProposerElectionTypeWRF returns if proposer is rotated by checkpoint, which is shared by WRF and
+// reputation proposer election.
 func (c *ChainConfig) isProposerElectionTypeWRF() bool {
-	return c.EpochInfo.ConsensusParams.ProposerElectionType == ProposerElectionTypeWRF
+	return c.EpochInfo.ConsensusParams.ProposerElectionType == ProposerElectionTypeWRF || c.isProposerElectionTypeReputation()
+}
+
+func (c *ChainConfig) isProposerElectionTypeReputation() bool {
+	return c.EpochInfo.ConsensusParams.ProposerElectionType == ProposerElectionTypeReputation
+}
+
+func (c *ChainConfig) isLinearVoteAggregation() bool {
+	return c.VoteAggregationType == VoteAggregationTypeLinear
+}
+
+func (c *ChainConfig) isFastPathEnabled() bool {
+	return c.FastPath
 }
 
 func (c *ChainConfig) isValidator() bool {
@@ -284,15 +337,31 @@ func (c *ChainConfig) updateDerivedData(newValidatorSet map[uint64]int64) error
 	c.F = (c.N - 1) / 3
 	c.L = c.EpochInfo.ConsensusParams.CheckpointPeriod * c.EpochInfo.ConsensusParams.HighWatermarkCheckpointPeriod
 
+	// fallback to all-to-all if vote aggregation type is not specified or unknown
+	c.VoteAggregationType = VoteAggregationTypeAllToAll
+	if c.getVoteAggregationTypeFn != nil {
+		if t := c.getVoteAggregationTypeFn(c.EpochInfo); t == VoteAggregationTypeLinear {
+			c.VoteAggregationType = t
+		}
+	}
+	c.FastPath = c.isFastPathEnabledFn != nil && c.isFastPathEnabledFn(c.EpochInfo)
+
 	return nil
 }
 
-func (c *ChainConfig) wrfCalPrimaryIDByView(v uint64, validatorDynamicInfoMap map[uint64]*ValidatorInfo) uint64 {
-	// generate random number by last blockhash + view + epoch
+// wrfSeed generates random seed by last blockhash + view + epoch, the VRF output of last checkpoint
+// block is used instead of blockhash if any.
+func (c *ChainConfig) wrfSeed(v uint64) []byte {
 	var seed = []byte(c.LastCheckpointExecBlockHash)
+	if len(c.LastCheckpointVRFOutput) != 0 {
+		seed = append([]byte(nil), c.LastCheckpointVRFOutput...)
+	}
 	seed = binary.BigEndian.AppendUint64(seed, c.EpochInfo.Epoch)
 	seed = binary.BigEndian.AppendUint64(seed, v)
+	return seed
+}
 
+func (c *ChainConfig) wrfCalPrimaryIDByView(v uint64, validatorDynamicInfoMap map[uint64]*ValidatorInfo) uint64 {
 	nodeID2VotingPower := make(map[uint64]int64)
 	for nodeID, info := range validatorDynamicInfoMap {
 		// exclude nodes that have recently produced blocks
@@ -300,7 +369,7 @@ func (c *ChainConfig) wrfCalPrimaryIDByView(v uint64, validatorDynamicInfoMap ma
 			nodeID2VotingPower[nodeID] = info.ConsensusVotingPower
 		}
 	}
-	return wrfSelectNodeByVotingPower(seed, nodeID2VotingPower)
+	return wrfSelectNodeByVotingPower(c.wrfSeed(v), nodeID2VotingPower)
 }
 
 // primaryID returns the expected primary id with the given view v
@@ -311,6 +380,8 @@ func (c *ChainConfig) calPrimaryIDByView(v uint64, validatorDynamicInfoMap map[u
 		primaryID = c.wrfCalPrimaryIDByView(v, validatorDynamicInfoMap)
 	case ProposerElectionTypeAbnormalRotation:
 		primaryID = v%uint64(c.N) + 1
+	case ProposerElectionTypeReputation:
+		primaryID = c.reputationCalPrimaryIDByView(v, validatorDynamicInfoMap)
 	default:
 		primaryID = c.wrfCalPrimaryIDByView(v, validatorDynamicInfoMap)
 	}
@@ -354,7 +425,7 @@ func formatValidatorDynamicInfo(validatorDynamicInfoMap map[uint64]*ValidatorInf
 	return res
 }
 
-func (c *ChainConfig) ResetRecentBlockNum(lastCheckpointExecBlockHeight uint64) {
+func (c *ChainConfig) ResetRecentBlockNum(lastCheckpointExecBlockHeight uint64) error {
 	validatorSetNum := uint64(len(c.ValidatorDynamicInfoMap))
 	recentBlockNum := validatorSetNum * c.EpochInfo.ConsensusParams.AgainProposeIntervalBlockInValidatorsNumPercentage / 100
 	if recentBlockNum == 0 {
@@ -363,6 +434,10 @@ func (c *ChainConfig) ResetRecentBlockNum(lastCheckpointExecBlockHeight uint64)
 		recentBlockNum = validatorSetNum - 1
 	}
 	c.RecentBlockProcessorTracker.ResetRecentBlockNum(c.EpochInfo.StartBlock, lastCheckpointExecBlockHeight, recentBlockNum)
+	if c.isProposerElectionTypeReputation() {
+		return c.ReputationTracker.Reset(lastCheckpointExecBlockHeight)
+	}
+	return nil
 }
 
 func (c *ChainConfig) CheckValidator(nodeID uint64) bool {
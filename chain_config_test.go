@@ -1,11 +1,13 @@
 package rbft
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/axiomesh/axiom-bft/types"
+	kittypes "github.com/axiomesh/axiom-kit/types"
 )
 
 func TestBlockProcessorTracker_ResetRecentBlockNum(t *testing.T) {
@@ -122,3 +124,94 @@ func TestBlockProcessorTracker_ResetRecentBlockNum(t *testing.T) {
 		<-ch
 	}
 }
+
+func TestReputationTracker_RecoverFromChainData(t *testing.T) {
+	var validatorSetNum uint64 = 4
+	getBlockFunc := func(u uint64) (*types.BlockMeta, error) {
+		return &types.BlockMeta{
+			ProcessorNodeID: u%validatorSetNum + 1,
+			BlockNum:        u,
+		}, nil
+	}
+
+	// track blocks at runtime.
+	tracker := NewReputationTracker(getBlockFunc)
+	tracker.Reset(1)
+	for i := uint64(2); i <= 2*reputationWindowBlockNum; i++ {
+		m, _ := getBlockFunc(i)
+		assert.Nil(t, tracker.AddBlock(*m))
+	}
+	assert.Equal(t, int(reputationWindowBlockNum), len(tracker.BlockProcessors))
+	assert.Equal(t, reputationWindowBlockNum+1, tracker.BlockProcessors[0].BlockNum)
+
+	// rebuild reputation from chain data after restart.
+	recovered := NewReputationTracker(getBlockFunc)
+	recovered.Reset(2 * reputationWindowBlockNum)
+	assert.Equal(t, tracker.ProposalScores, recovered.ProposalScores)
+
+	// the most recent proposer has the highest score.
+	lastProposer := 2*reputationWindowBlockNum%validatorSetNum + 1
+	for id, score := range recovered.ProposalScores {
+		if id != lastProposer {
+			assert.Less(t, score, recovered.ProposalScores[lastProposer])
+		}
+	}
+
+	// blocks skipped between checkpoints are fetched from chain data.
+	m, _ := getBlockFunc(2*reputationWindowBlockNum + 10)
+	assert.Nil(t, tracker.AddBlock(*m))
+	recovered.Reset(2*reputationWindowBlockNum + 10)
+	assert.Equal(t, tracker.ProposalScores, recovered.ProposalScores)
+
+	// the tracked blocks are kept unchanged if failed to get blocks from chain data.
+	failed := NewReputationTracker(func(u uint64) (*types.BlockMeta, error) {
+		return nil, errors.New("block not found")
+	})
+	failed.BlockProcessors = tracker.BlockProcessors
+	assert.NotNil(t, failed.Reset(3*reputationWindowBlockNum))
+	assert.Equal(t, tracker.BlockProcessors, failed.BlockProcessors)
+}
+
+func TestChainConfig_reputationCalPrimaryIDByView(t *testing.T) {
+	getBlockFunc := func(u uint64) (*types.BlockMeta, error) {
+		return &types.BlockMeta{
+			ProcessorNodeID: 1,
+			BlockNum:        u,
+		}, nil
+	}
+	c := &ChainConfig{
+		EpochInfo: &kittypes.EpochInfo{Epoch: 1},
+		DynamicChainConfig: DynamicChainConfig{
+			LastCheckpointExecBlockHash: "hash",
+			RecentBlockProcessorTracker: NewBlockProcessorTracker(getBlockFunc),
+			ReputationTracker:           NewReputationTracker(getBlockFunc),
+		},
+	}
+	validatorDynamicInfoMap := map[uint64]*ValidatorInfo{}
+	for id := uint64(1); id <= 4; id++ {
+		validatorDynamicInfoMap[id] = &ValidatorInfo{ID: id, ConsensusVotingPower: 1000}
+	}
+	// node 4 timed out as primary for several times.
+	validatorDynamicInfoMap[4].ReputationPenalty = 3 * reputationTimeoutPenalty
+
+	countSelected := func(calPrimaryIDByView func(v uint64, validatorDynamicInfoMap map[uint64]*ValidatorInfo) uint64) map[uint64]int {
+		selected := make(map[uint64]int)
+		for v := uint64(0); v < 1000; v++ {
+			selected[calPrimaryIDByView(v, validatorDynamicInfoMap)]++
+		}
+		return selected
+	}
+	wrfSelected := countSelected(c.wrfCalPrimaryIDByView)
+	reputationSelected := countSelected(c.reputationCalPrimaryIDByView)
+	assert.Greater(t, wrfSelected[4], 100)
+	assert.Less(t, reputationSelected[4], 10)
+
+	// node 1 proposed all recent blocks, so it is preferred.
+	c.ReputationTracker.Reset(reputationWindowBlockNum)
+	reputationSelected = countSelected(c.reputationCalPrimaryIDByView)
+	assert.Greater(t, reputationSelected[1], reputationSelected[2])
+	assert.Greater(t, reputationSelected[1], reputationSelected[3])
+
+	// the selection is deterministic.
+	assert.Equal(t, reputationSelected, countSelected(c.reputationCalPrimaryIDByView))
+}
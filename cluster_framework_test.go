@@ -97,6 +97,9 @@ type testNode[T any, Constraint kittypes.TXConstraint[T]] struct {
 	// block storage
 	blocks map[uint64]string
 
+	// timestamps the blocks were executed with
+	blockTimestamps map[uint64]int64
+
 	// ID is the num-identity of the local rbft.peerMgr.localIDde.
 	ID uint64
 
@@ -139,6 +142,9 @@ type testExternal[T any, Constraint kittypes.TXConstraint[T]] struct {
 
 	// config checkpoint record
 	configCheckpointRecord map[uint64]*consensus.EpochChange
+
+	// validateBatch mocks the batch validation of application, accept all batches if nil.
+	validateBatch func(txs []*T, seqNo uint64, timestamp int64, proposer uint64) error
 }
 
 // channelMsg is the form of data in cluster network.
@@ -275,19 +281,20 @@ func (tf *testFramework[T, Constraint]) newTestNode(id uint64, p2pNodeID string,
 	// init new view.
 	n.rbft.vcMgr.latestNewView = initialNewView
 	tn := &testNode[T, Constraint]{
-		Logger:     log,
-		N:          n,
-		n:          n,
-		Router:     peerSet,
-		Epoch:      0,
-		ID:         id,
-		P2PNodeID:  p2pNodeID,
-		normal:     true,
-		online:     true,
-		close:      make(chan bool),
-		recvChan:   make(chan *consensusMessageWrapper),
-		stateStore: make(map[string][]byte),
-		blocks:     make(map[uint64]string),
+		Logger:          log,
+		N:               n,
+		n:               n,
+		Router:          peerSet,
+		Epoch:           0,
+		ID:              id,
+		P2PNodeID:       p2pNodeID,
+		normal:          true,
+		online:          true,
+		close:           make(chan bool),
+		recvChan:        make(chan *consensusMessageWrapper),
+		stateStore:      make(map[string][]byte),
+		blocks:          make(map[uint64]string),
+		blockTimestamps: make(map[uint64]int64),
 	}
 	testExt.testNode = tn
 
@@ -427,7 +434,14 @@ func (ext *testExternal[T, Constraint]) Verify(_ uint64, _ []byte, _ []byte) err
 	return nil
 }
 
-// ServiceOutbound
+// BatchValidator
+func (ext *testExternal[T, Constraint]) ValidateBatch(txs []*T, seqNo uint64, timestamp int64, proposer uint64) error {
+	if ext.validateBatch == nil {
+		return nil
+	}
+	return ext.validateBatch(txs, seqNo, timestamp, proposer)
+}
+
 func (ext *testExternal[T, Constraint]) Execute(requests []*T, _ []bool, seqNo uint64, timestamp int64, _ uint64) {
 	var txHashList []string
 	for _, req := range requests {
@@ -446,6 +460,7 @@ func (ext *testExternal[T, Constraint]) Execute(requests []*T, _ []bool, seqNo u
 		ext.testNode.Applied = state.MetaState.Height
 		ext.testNode.Digest = state.MetaState.Digest
 		ext.testNode.blocks[state.MetaState.Height] = state.MetaState.Digest
+		ext.testNode.blockTimestamps[state.MetaState.Height] = timestamp
 		ext.testNode.n.logger.Debugf("Block Number %d", state.MetaState.Height)
 		ext.testNode.n.logger.Debugf("Block Hash %s", state.MetaState.Digest)
 		// report latest validator set
@@ -460,6 +475,7 @@ func (ext *testExternal[T, Constraint]) StateUpdate(localLowWatermark, seqNo uin
 	for key, val := range ext.tf.TestNode[0].blocks {
 		if key <= seqNo && key > localApplyId {
 			ext.testNode.blocks[key] = val
+			ext.testNode.blockTimestamps[key] = ext.tf.TestNode[0].blockTimestamps[key]
 			ext.testNode.n.logger.Debugf("Block Number %d", key)
 			ext.testNode.n.logger.Debugf("Block Hash %s", val)
 
@@ -575,6 +591,10 @@ func (ext *testExternal[T, Constraint]) ReadEpochState(key string) ([]byte, erro
 	return nil, nil
 }
 
+func (ext *testExternal[T, Constraint]) DelEpochState(key string) error {
+	return nil
+}
+
 func (ext *testExternal[T, Constraint]) GetNodeInfo(nodeID uint64) (*NodeInfo, error) {
 	if nodeID > uint64(len(ext.tf.TestNode)) {
 		return nil, errors.New("invalid node id")
@@ -608,5 +628,6 @@ func (ext *testExternal[T, Constraint]) GetBlockMeta(num uint64) (*types.BlockMe
 	return &types.BlockMeta{
 		ProcessorNodeID: 0,
 		BlockNum:        num,
+		Timestamp:       ext.testNode.blockTimestamps[num],
 	}, nil
 }
@@ -280,6 +280,9 @@ func (rbft *rbftImpl[T, Constraint]) consensusMessagePacker(e consensusEvent) *c
 	case *consensus.ReBroadcastRequestSet:
 		eventType = consensus.Type_REBROADCAST_REQUEST_SET
 		payload, err = et.MarshalVTStrict()
+	case *consensus.DecryptionShare:
+		eventType = consensus.Type_DECRYPTION_SHARE
+		payload, err = et.MarshalVTStrict()
 	case *consensus.SignedCheckpoint:
 		eventType = consensus.Type_SIGNED_CHECKPOINT
 		payload, err = et.MarshalVTStrict()
@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"golang.org/x/crypto/sha3"
@@ -16,6 +17,18 @@ func executeStateEquals(a, b *Checkpoint_ExecuteState) bool {
 	return a.GetHeight() == b.GetHeight() && a.GetDigest() == b.GetDigest()
 }
 
+func validatorKeysEquals(a, b []*ValidatorInfo) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].EqualVT(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // ======================= Checkpoint =======================
 
 // Hash calculates the crypto hash of Checkpoint.
@@ -24,9 +37,11 @@ func (m *Checkpoint) Hash() []byte {
 		return nil
 	}
 	c := &Checkpoint{
-		Epoch:           m.Epoch,
-		ExecuteState:    m.ExecuteState,
-		NeedUpdateEpoch: m.NeedUpdateEpoch,
+		Epoch:               m.Epoch,
+		ExecuteState:        m.ExecuteState,
+		NeedUpdateEpoch:     m.NeedUpdateEpoch,
+		ValidatorKeys:       m.ValidatorKeys,
+		ReputationPenalties: m.ReputationPenalties,
 	}
 	res, jErr := c.MarshalVTStrict()
 	if jErr != nil {
@@ -81,7 +96,8 @@ func (m *Checkpoint) Pretty() string {
 // Equals compares two checkpoint instance and returns whether they are equal
 func (m *Checkpoint) Equals(n *Checkpoint) bool {
 	return m.Epoch == n.Epoch &&
-		executeStateEquals(m.GetExecuteState(), n.GetExecuteState()) && m.NeedUpdateEpoch == n.NeedUpdateEpoch
+		executeStateEquals(m.GetExecuteState(), n.GetExecuteState()) && m.NeedUpdateEpoch == n.NeedUpdateEpoch &&
+		validatorKeysEquals(m.GetValidatorKeys(), n.GetValidatorKeys())
 }
 
 // ======================= SignedCheckpoint =======================
@@ -238,6 +254,41 @@ func (x *Commit) ID() string {
 	return fmt.Sprintf("replica=%d/view=%d/seq=%d/digest=%s", x.ReplicaId, x.View, x.SequenceNumber, x.BatchDigest)
 }
 
+// ======================= HotStuffBlock =======================
+
+// CalculateHash calculates the crypto hash of HotStuffBlock, the hash field itself and the signatures
+// of justify QC are excluded, as different signature sets of the same QC certify the same block.
+func (m *HotStuffBlock) CalculateHash() string {
+	if m == nil {
+		return ""
+	}
+	b := m.CloneVT()
+	b.Hash = ""
+	if b.Justify != nil {
+		b.Justify.Signatures = nil
+	}
+	res, jErr := b.MarshalVTStrict()
+	if jErr != nil {
+		panic(jErr)
+	}
+	hasher := sha3.NewLegacyKeccak256()
+	//nolint
+	hasher.Write(res)
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// IsEmpty returns whether the block carries no transaction.
+func (m *HotStuffBlock) IsEmpty() bool {
+	return m.GetBatchDigest() == ""
+}
+
+func (m *HotStuffBlock) ID() string {
+	if m == nil {
+		return "NIL"
+	}
+	return fmt.Sprintf("proposer=%d/view=%d/height=%d/hash=%s", m.Proposer, m.View, m.Height, m.Hash)
+}
+
 func ValidatorDynamicInfoEqual(a []*NodeDynamicInfo, b []*NodeDynamicInfo) bool {
 	if len(a) != len(b) {
 		return false
@@ -256,4 +307,10 @@ var ConsensusMsgWhiteList = map[Type]bool{
 	Type_FETCH_CHECKPOINT:      true,
 	Type_FETCH_BATCH_REQUEST:   true,
 	Type_SYNC_STATE:            true,
+
+	// snapshots are bound to checkpoint digests rather than epochs
+	Type_SNAPSHOT_MANIFEST_REQUEST:  true,
+	Type_SNAPSHOT_MANIFEST_RESPONSE: true,
+	Type_SNAPSHOT_CHUNK_REQUEST:     true,
+	Type_SNAPSHOT_CHUNK_RESPONSE:    true,
 }
@@ -37,28 +37,41 @@ const (
 type Type int32
 
 const (
-	Type_NULL_REQUEST            Type = 0
-	Type_PRE_PREPARE             Type = 1
-	Type_PREPARE                 Type = 2
-	Type_COMMIT                  Type = 3
-	Type_REBROADCAST_REQUEST_SET Type = 4
-	Type_SIGNED_CHECKPOINT       Type = 5
-	Type_FETCH_CHECKPOINT        Type = 6
-	Type_VIEW_CHANGE             Type = 7
-	Type_QUORUM_VIEW_CHANGE      Type = 8
-	Type_NEW_VIEW                Type = 9
-	Type_FETCH_VIEW              Type = 10
-	Type_RECOVERY_RESPONSE       Type = 11
-	Type_FETCH_BATCH_REQUEST     Type = 12
-	Type_FETCH_BATCH_RESPONSE    Type = 13
-	Type_FETCH_PQC_REQUEST       Type = 14
-	Type_FETCH_PQC_RESPONSE      Type = 15
-	Type_FETCH_MISSING_REQUEST   Type = 16
-	Type_FETCH_MISSING_RESPONSE  Type = 17
-	Type_SYNC_STATE              Type = 18
-	Type_SYNC_STATE_RESPONSE     Type = 19
-	Type_EPOCH_CHANGE_REQUEST    Type = 20
-	Type_EPOCH_CHANGE_PROOF      Type = 21
+	Type_NULL_REQUEST                  Type = 0
+	Type_PRE_PREPARE                   Type = 1
+	Type_PREPARE                       Type = 2
+	Type_COMMIT                        Type = 3
+	Type_REBROADCAST_REQUEST_SET       Type = 4
+	Type_SIGNED_CHECKPOINT             Type = 5
+	Type_FETCH_CHECKPOINT              Type = 6
+	Type_VIEW_CHANGE                   Type = 7
+	Type_QUORUM_VIEW_CHANGE            Type = 8
+	Type_NEW_VIEW                      Type = 9
+	Type_FETCH_VIEW                    Type = 10
+	Type_RECOVERY_RESPONSE             Type = 11
+	Type_FETCH_BATCH_REQUEST           Type = 12
+	Type_FETCH_BATCH_RESPONSE          Type = 13
+	Type_FETCH_PQC_REQUEST             Type = 14
+	Type_FETCH_PQC_RESPONSE            Type = 15
+	Type_FETCH_MISSING_REQUEST         Type = 16
+	Type_FETCH_MISSING_RESPONSE        Type = 17
+	Type_SYNC_STATE                    Type = 18
+	Type_SYNC_STATE_RESPONSE           Type = 19
+	Type_EPOCH_CHANGE_REQUEST          Type = 20
+	Type_EPOCH_CHANGE_PROOF            Type = 21
+	Type_LEADER_TRANSFER               Type = 22
+	Type_AGGREGATED_VOTES              Type = 23
+	Type_HOTSTUFF_PROPOSAL             Type = 24
+	Type_HOTSTUFF_VOTE                 Type = 25
+	Type_HOTSTUFF_NEW_VIEW             Type = 26
+	Type_DECRYPTION_SHARE              Type = 27
+	Type_SNAPSHOT_MANIFEST_REQUEST     Type = 28
+	Type_SNAPSHOT_MANIFEST_RESPONSE    Type = 29
+	Type_SNAPSHOT_CHUNK_REQUEST        Type = 30
+	Type_SNAPSHOT_CHUNK_RESPONSE       Type = 31
+	Type_KEY_ROTATION                  Type = 32
+	Type_HOTSTUFF_FETCH_BLOCK          Type = 33
+	Type_HOTSTUFF_FETCH_BLOCK_RESPONSE Type = 34
 )
 
 // Enum value maps for Type.
@@ -86,30 +99,56 @@ var (
 		19: "SYNC_STATE_RESPONSE",
 		20: "EPOCH_CHANGE_REQUEST",
 		21: "EPOCH_CHANGE_PROOF",
+		22: "LEADER_TRANSFER",
+		23: "AGGREGATED_VOTES",
+		24: "HOTSTUFF_PROPOSAL",
+		25: "HOTSTUFF_VOTE",
+		26: "HOTSTUFF_NEW_VIEW",
+		27: "DECRYPTION_SHARE",
+		28: "SNAPSHOT_MANIFEST_REQUEST",
+		29: "SNAPSHOT_MANIFEST_RESPONSE",
+		30: "SNAPSHOT_CHUNK_REQUEST",
+		31: "SNAPSHOT_CHUNK_RESPONSE",
+		32: "KEY_ROTATION",
+		33: "HOTSTUFF_FETCH_BLOCK",
+		34: "HOTSTUFF_FETCH_BLOCK_RESPONSE",
 	}
 	Type_value = map[string]int32{
-		"NULL_REQUEST":            0,
-		"PRE_PREPARE":             1,
-		"PREPARE":                 2,
-		"COMMIT":                  3,
-		"REBROADCAST_REQUEST_SET": 4,
-		"SIGNED_CHECKPOINT":       5,
-		"FETCH_CHECKPOINT":        6,
-		"VIEW_CHANGE":             7,
-		"QUORUM_VIEW_CHANGE":      8,
-		"NEW_VIEW":                9,
-		"FETCH_VIEW":              10,
-		"RECOVERY_RESPONSE":       11,
-		"FETCH_BATCH_REQUEST":     12,
-		"FETCH_BATCH_RESPONSE":    13,
-		"FETCH_PQC_REQUEST":       14,
-		"FETCH_PQC_RESPONSE":      15,
-		"FETCH_MISSING_REQUEST":   16,
-		"FETCH_MISSING_RESPONSE":  17,
-		"SYNC_STATE":              18,
-		"SYNC_STATE_RESPONSE":     19,
-		"EPOCH_CHANGE_REQUEST":    20,
-		"EPOCH_CHANGE_PROOF":      21,
+		"NULL_REQUEST":                  0,
+		"PRE_PREPARE":                   1,
+		"PREPARE":                       2,
+		"COMMIT":                        3,
+		"REBROADCAST_REQUEST_SET":       4,
+		"SIGNED_CHECKPOINT":             5,
+		"FETCH_CHECKPOINT":              6,
+		"VIEW_CHANGE":                   7,
+		"QUORUM_VIEW_CHANGE":            8,
+		"NEW_VIEW":                      9,
+		"FETCH_VIEW":                    10,
+		"RECOVERY_RESPONSE":             11,
+		"FETCH_BATCH_REQUEST":           12,
+		"FETCH_BATCH_RESPONSE":          13,
+		"FETCH_PQC_REQUEST":             14,
+		"FETCH_PQC_RESPONSE":            15,
+		"FETCH_MISSING_REQUEST":         16,
+		"FETCH_MISSING_RESPONSE":        17,
+		"SYNC_STATE":                    18,
+		"SYNC_STATE_RESPONSE":           19,
+		"EPOCH_CHANGE_REQUEST":          20,
+		"EPOCH_CHANGE_PROOF":            21,
+		"LEADER_TRANSFER":               22,
+		"AGGREGATED_VOTES":              23,
+		"HOTSTUFF_PROPOSAL":             24,
+		"HOTSTUFF_VOTE":                 25,
+		"HOTSTUFF_NEW_VIEW":             26,
+		"DECRYPTION_SHARE":              27,
+		"SNAPSHOT_MANIFEST_REQUEST":     28,
+		"SNAPSHOT_MANIFEST_RESPONSE":    29,
+		"SNAPSHOT_CHUNK_REQUEST":        30,
+		"SNAPSHOT_CHUNK_RESPONSE":       31,
+		"KEY_ROTATION":                  32,
+		"HOTSTUFF_FETCH_BLOCK":          33,
+		"HOTSTUFF_FETCH_BLOCK_RESPONSE": 34,
 	}
 )
 
@@ -140,6 +179,59 @@ func (Type) EnumDescriptor() ([]byte, []int) {
 	return file_rbft_proto_rawDescGZIP(), []int{0}
 }
 
+// CompressionType is the algorithm used to compress the payload of consensus message.
+type CompressionType int32
+
+const (
+	CompressionType_NONE   CompressionType = 0
+	CompressionType_ZSTD   CompressionType = 1
+	CompressionType_SNAPPY CompressionType = 2
+	CompressionType_FLATE  CompressionType = 3
+)
+
+// Enum value maps for CompressionType.
+var (
+	CompressionType_name = map[int32]string{
+		0: "NONE",
+		1: "ZSTD",
+		2: "SNAPPY",
+		3: "FLATE",
+	}
+	CompressionType_value = map[string]int32{
+		"NONE":   0,
+		"ZSTD":   1,
+		"SNAPPY": 2,
+		"FLATE":  3,
+	}
+)
+
+func (x CompressionType) Enum() *CompressionType {
+	p := new(CompressionType)
+	*p = x
+	return p
+}
+
+func (x CompressionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompressionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rbft_proto_enumTypes[1].Descriptor()
+}
+
+func (CompressionType) Type() protoreflect.EnumType {
+	return &file_rbft_proto_enumTypes[1]
+}
+
+func (x CompressionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompressionType.Descriptor instead.
+func (CompressionType) EnumDescriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{1}
+}
+
 type FetchMissingResponse_Status int32
 
 const (
@@ -170,11 +262,11 @@ func (x FetchMissingResponse_Status) String() string {
 }
 
 func (FetchMissingResponse_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_rbft_proto_enumTypes[1].Descriptor()
+	return file_rbft_proto_enumTypes[2].Descriptor()
 }
 
 func (FetchMissingResponse_Status) Type() protoreflect.EnumType {
-	return &file_rbft_proto_enumTypes[1]
+	return &file_rbft_proto_enumTypes[2]
 }
 
 func (x FetchMissingResponse_Status) Number() protoreflect.EnumNumber {
@@ -183,7 +275,7 @@ func (x FetchMissingResponse_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FetchMissingResponse_Status.Descriptor instead.
 func (FetchMissingResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{21, 0}
+	return file_rbft_proto_rawDescGZIP(), []int{33, 0}
 }
 
 type ConsensusMessage struct {
@@ -191,13 +283,18 @@ type ConsensusMessage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    Type   `protobuf:"varint,1,opt,name=type,proto3,enum=consensus.Type" json:"type,omitempty"`
-	From    uint64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
-	To      uint64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
-	Epoch   uint64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	View    uint64 `protobuf:"varint,5,opt,name=view,proto3" json:"view,omitempty"`
-	Payload []byte `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
-	Nonce   int64  `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Type        Type            `protobuf:"varint,1,opt,name=type,proto3,enum=consensus.Type" json:"type,omitempty"`
+	From        uint64          `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          uint64          `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	Epoch       uint64          `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	View        uint64          `protobuf:"varint,5,opt,name=view,proto3" json:"view,omitempty"`
+	Payload     []byte          `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	Nonce       int64           `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Compression CompressionType `protobuf:"varint,8,opt,name=compression,proto3,enum=consensus.CompressionType" json:"compression,omitempty"`
+	// compression type the sender can decompress, peers only compress messages to it with this type.
+	AcceptCompression CompressionType `protobuf:"varint,9,opt,name=accept_compression,json=acceptCompression,proto3,enum=consensus.CompressionType" json:"accept_compression,omitempty"`
+	// signature of the sender on the envelope digest, which binds the nonce to the message.
+	Signature []byte `protobuf:"bytes,10,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *ConsensusMessage) Reset() {
@@ -281,6 +378,27 @@ func (x *ConsensusMessage) GetNonce() int64 {
 	return 0
 }
 
+func (x *ConsensusMessage) GetCompression() CompressionType {
+	if x != nil {
+		return x.Compression
+	}
+	return CompressionType_NONE
+}
+
+func (x *ConsensusMessage) GetAcceptCompression() CompressionType {
+	if x != nil {
+		return x.AcceptCompression
+	}
+	return CompressionType_NONE
+}
+
+func (x *ConsensusMessage) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type NullRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -338,6 +456,9 @@ type PrePrepare struct {
 	SequenceNumber uint64     `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
 	BatchDigest    string     `protobuf:"bytes,4,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
 	HashBatch      *HashBatch `protobuf:"bytes,5,opt,name=hash_batch,json=hashBatch,proto3" json:"hash_batch,omitempty"`
+	// signed commits of a previous batch which the BFT time of this batch is the median timestamp of,
+	// only set if BFT time is enabled.
+	BftTimeCommits []*Commit `protobuf:"bytes,6,rep,name=bft_time_commits,json=bftTimeCommits,proto3" json:"bft_time_commits,omitempty"`
 }
 
 func (x *PrePrepare) Reset() {
@@ -407,6 +528,13 @@ func (x *PrePrepare) GetHashBatch() *HashBatch {
 	return nil
 }
 
+func (x *PrePrepare) GetBftTimeCommits() []*Commit {
+	if x != nil {
+		return x.BftTimeCommits
+	}
+	return nil
+}
+
 type Prepare struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -416,6 +544,8 @@ type Prepare struct {
 	View           uint64 `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
 	SequenceNumber uint64 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
 	BatchDigest    string `protobuf:"bytes,4,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
+	// only signed in linear vote aggregation mode
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (x *Prepare) Reset() {
@@ -478,6 +608,13 @@ func (x *Prepare) GetBatchDigest() string {
 	return ""
 }
 
+func (x *Prepare) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type Commit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -487,6 +624,10 @@ type Commit struct {
 	View           uint64 `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
 	SequenceNumber uint64 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
 	BatchDigest    string `protobuf:"bytes,4,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
+	// only signed in linear vote aggregation mode
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// local time of voter in nanoseconds, only set when BFT time is enabled
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (x *Commit) Reset() {
@@ -549,17 +690,34 @@ func (x *Commit) GetBatchDigest() string {
 	return ""
 }
 
-type ReBroadcastRequestSet struct {
+func (x *Commit) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *Commit) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// AggregatedVotes is broadcast by the collector (primary) in linear vote aggregation mode,
+// which contains a quorum of signed prepares or commits for the same batch.
+type AggregatedVotes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaId uint64   `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	Requests  [][]byte `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	ReplicaId uint64     `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	Prepares  []*Prepare `protobuf:"bytes,2,rep,name=prepares,proto3" json:"prepares,omitempty"`
+	Commits   []*Commit  `protobuf:"bytes,3,rep,name=commits,proto3" json:"commits,omitempty"`
 }
 
-func (x *ReBroadcastRequestSet) Reset() {
-	*x = ReBroadcastRequestSet{}
+func (x *AggregatedVotes) Reset() {
+	*x = AggregatedVotes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rbft_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,13 +725,13 @@ func (x *ReBroadcastRequestSet) Reset() {
 	}
 }
 
-func (x *ReBroadcastRequestSet) String() string {
+func (x *AggregatedVotes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReBroadcastRequestSet) ProtoMessage() {}
+func (*AggregatedVotes) ProtoMessage() {}
 
-func (x *ReBroadcastRequestSet) ProtoReflect() protoreflect.Message {
+func (x *AggregatedVotes) ProtoReflect() protoreflect.Message {
 	mi := &file_rbft_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,38 +743,47 @@ func (x *ReBroadcastRequestSet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReBroadcastRequestSet.ProtoReflect.Descriptor instead.
-func (*ReBroadcastRequestSet) Descriptor() ([]byte, []int) {
+// Deprecated: Use AggregatedVotes.ProtoReflect.Descriptor instead.
+func (*AggregatedVotes) Descriptor() ([]byte, []int) {
 	return file_rbft_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ReBroadcastRequestSet) GetReplicaId() uint64 {
+func (x *AggregatedVotes) GetReplicaId() uint64 {
 	if x != nil {
 		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *ReBroadcastRequestSet) GetRequests() [][]byte {
+func (x *AggregatedVotes) GetPrepares() []*Prepare {
 	if x != nil {
-		return x.Requests
+		return x.Prepares
 	}
 	return nil
 }
 
-type HashBatch struct {
+func (x *AggregatedVotes) GetCommits() []*Commit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+// DecryptionShare is broadcast by validators after a batch of threshold-encrypted txs is committed,
+// which contains the decryption share of the sender for the batch.
+type DecryptionShare struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestHashList            []string `protobuf:"bytes,1,rep,name=request_hash_list,json=requestHashList,proto3" json:"request_hash_list,omitempty"`
-	DeDuplicateRequestHashList []string `protobuf:"bytes,2,rep,name=de_duplicate_request_hash_list,json=deDuplicateRequestHashList,proto3" json:"de_duplicate_request_hash_list,omitempty"`
-	Timestamp                  int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Proposer                   uint64   `protobuf:"varint,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	ReplicaId      uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	SequenceNumber uint64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	BatchDigest    string `protobuf:"bytes,3,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
+	Share          []byte `protobuf:"bytes,4,opt,name=share,proto3" json:"share,omitempty"`
 }
 
-func (x *HashBatch) Reset() {
-	*x = HashBatch{}
+func (x *DecryptionShare) Reset() {
+	*x = DecryptionShare{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rbft_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -624,13 +791,13 @@ func (x *HashBatch) Reset() {
 	}
 }
 
-func (x *HashBatch) String() string {
+func (x *DecryptionShare) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HashBatch) ProtoMessage() {}
+func (*DecryptionShare) ProtoMessage() {}
 
-func (x *HashBatch) ProtoReflect() protoreflect.Message {
+func (x *DecryptionShare) ProtoReflect() protoreflect.Message {
 	mi := &file_rbft_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -642,50 +809,53 @@ func (x *HashBatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HashBatch.ProtoReflect.Descriptor instead.
-func (*HashBatch) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecryptionShare.ProtoReflect.Descriptor instead.
+func (*DecryptionShare) Descriptor() ([]byte, []int) {
 	return file_rbft_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *HashBatch) GetRequestHashList() []string {
+func (x *DecryptionShare) GetReplicaId() uint64 {
 	if x != nil {
-		return x.RequestHashList
+		return x.ReplicaId
 	}
-	return nil
+	return 0
 }
 
-func (x *HashBatch) GetDeDuplicateRequestHashList() []string {
+func (x *DecryptionShare) GetSequenceNumber() uint64 {
 	if x != nil {
-		return x.DeDuplicateRequestHashList
+		return x.SequenceNumber
 	}
-	return nil
+	return 0
 }
 
-func (x *HashBatch) GetTimestamp() int64 {
+func (x *DecryptionShare) GetBatchDigest() string {
 	if x != nil {
-		return x.Timestamp
+		return x.BatchDigest
 	}
-	return 0
+	return ""
 }
 
-func (x *HashBatch) GetProposer() uint64 {
+func (x *DecryptionShare) GetShare() []byte {
 	if x != nil {
-		return x.Proposer
+		return x.Share
 	}
-	return 0
+	return nil
 }
 
-type FetchCheckpoint struct {
+// HotStuffQC is a quorum certificate of votes on one HotStuff block.
+type HotStuffQC struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaId      uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	SequenceNumber uint64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	View       uint64            `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
+	Height     uint64            `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash  string            `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Signatures map[uint64][]byte `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *FetchCheckpoint) Reset() {
-	*x = FetchCheckpoint{}
+func (x *HotStuffQC) Reset() {
+	*x = HotStuffQC{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rbft_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -693,13 +863,13 @@ func (x *FetchCheckpoint) Reset() {
 	}
 }
 
-func (x *FetchCheckpoint) String() string {
+func (x *HotStuffQC) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchCheckpoint) ProtoMessage() {}
+func (*HotStuffQC) ProtoMessage() {}
 
-func (x *FetchCheckpoint) ProtoReflect() protoreflect.Message {
+func (x *HotStuffQC) ProtoReflect() protoreflect.Message {
 	mi := &file_rbft_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -711,38 +881,58 @@ func (x *FetchCheckpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchCheckpoint.ProtoReflect.Descriptor instead.
-func (*FetchCheckpoint) Descriptor() ([]byte, []int) {
+// Deprecated: Use HotStuffQC.ProtoReflect.Descriptor instead.
+func (*HotStuffQC) Descriptor() ([]byte, []int) {
 	return file_rbft_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *FetchCheckpoint) GetReplicaId() uint64 {
+func (x *HotStuffQC) GetView() uint64 {
 	if x != nil {
-		return x.ReplicaId
+		return x.View
 	}
 	return 0
 }
 
-func (x *FetchCheckpoint) GetSequenceNumber() uint64 {
+func (x *HotStuffQC) GetHeight() uint64 {
 	if x != nil {
-		return x.SequenceNumber
+		return x.Height
 	}
 	return 0
 }
 
-type ViewChange struct {
+func (x *HotStuffQC) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *HotStuffQC) GetSignatures() map[uint64][]byte {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// HotStuffBlock is a node of the chained HotStuff block tree. Blocks without
+// transactions keep the height of their parent and are never executed.
+type HotStuffBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Basis     *VcBasis `protobuf:"bytes,1,opt,name=basis,proto3" json:"basis,omitempty"`
-	Signature []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	Timestamp int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Recovery  bool     `protobuf:"varint,4,opt,name=recovery,proto3" json:"recovery,omitempty"`
+	View        uint64      `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
+	Height      uint64      `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	ParentHash  string      `protobuf:"bytes,3,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	Justify     *HotStuffQC `protobuf:"bytes,4,opt,name=justify,proto3" json:"justify,omitempty"`
+	BatchDigest string      `protobuf:"bytes,5,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
+	HashBatch   *HashBatch  `protobuf:"bytes,6,opt,name=hash_batch,json=hashBatch,proto3" json:"hash_batch,omitempty"`
+	Proposer    uint64      `protobuf:"varint,7,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Hash        string      `protobuf:"bytes,8,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *ViewChange) Reset() {
-	*x = ViewChange{}
+func (x *HotStuffBlock) Reset() {
+	*x = HotStuffBlock{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rbft_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -750,13 +940,13 @@ func (x *ViewChange) Reset() {
 	}
 }
 
-func (x *ViewChange) String() string {
+func (x *HotStuffBlock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ViewChange) ProtoMessage() {}
+func (*HotStuffBlock) ProtoMessage() {}
 
-func (x *ViewChange) ProtoReflect() protoreflect.Message {
+func (x *HotStuffBlock) ProtoReflect() protoreflect.Message {
 	mi := &file_rbft_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -768,49 +958,78 @@ func (x *ViewChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ViewChange.ProtoReflect.Descriptor instead.
-func (*ViewChange) Descriptor() ([]byte, []int) {
+// Deprecated: Use HotStuffBlock.ProtoReflect.Descriptor instead.
+func (*HotStuffBlock) Descriptor() ([]byte, []int) {
 	return file_rbft_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ViewChange) GetBasis() *VcBasis {
+func (x *HotStuffBlock) GetView() uint64 {
 	if x != nil {
-		return x.Basis
+		return x.View
+	}
+	return 0
+}
+
+func (x *HotStuffBlock) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *HotStuffBlock) GetParentHash() string {
+	if x != nil {
+		return x.ParentHash
+	}
+	return ""
+}
+
+func (x *HotStuffBlock) GetJustify() *HotStuffQC {
+	if x != nil {
+		return x.Justify
 	}
 	return nil
 }
 
-func (x *ViewChange) GetSignature() []byte {
+func (x *HotStuffBlock) GetBatchDigest() string {
 	if x != nil {
-		return x.Signature
+		return x.BatchDigest
+	}
+	return ""
+}
+
+func (x *HotStuffBlock) GetHashBatch() *HashBatch {
+	if x != nil {
+		return x.HashBatch
 	}
 	return nil
 }
 
-func (x *ViewChange) GetTimestamp() int64 {
+func (x *HotStuffBlock) GetProposer() uint64 {
 	if x != nil {
-		return x.Timestamp
+		return x.Proposer
 	}
 	return 0
 }
 
-func (x *ViewChange) GetRecovery() bool {
+func (x *HotStuffBlock) GetHash() string {
 	if x != nil {
-		return x.Recovery
+		return x.Hash
 	}
-	return false
+	return ""
 }
 
-type ValidatorDynamicInfo struct {
+type HotStuffProposal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Info []*NodeDynamicInfo `protobuf:"bytes,1,rep,name=info,proto3" json:"info,omitempty"`
+	ReplicaId uint64         `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	Block     *HotStuffBlock `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (x *ValidatorDynamicInfo) Reset() {
-	*x = ValidatorDynamicInfo{}
+func (x *HotStuffProposal) Reset() {
+	*x = HotStuffProposal{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rbft_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -818,13 +1037,13 @@ func (x *ValidatorDynamicInfo) Reset() {
 	}
 }
 
-func (x *ValidatorDynamicInfo) String() string {
+func (x *HotStuffProposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorDynamicInfo) ProtoMessage() {}
+func (*HotStuffProposal) ProtoMessage() {}
 
-func (x *ValidatorDynamicInfo) ProtoReflect() protoreflect.Message {
+func (x *HotStuffProposal) ProtoReflect() protoreflect.Message {
 	mi := &file_rbft_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -836,35 +1055,39 @@ func (x *ValidatorDynamicInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorDynamicInfo.ProtoReflect.Descriptor instead.
-func (*ValidatorDynamicInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use HotStuffProposal.ProtoReflect.Descriptor instead.
+func (*HotStuffProposal) Descriptor() ([]byte, []int) {
 	return file_rbft_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ValidatorDynamicInfo) GetInfo() []*NodeDynamicInfo {
+func (x *HotStuffProposal) GetReplicaId() uint64 {
 	if x != nil {
-		return x.Info
+		return x.ReplicaId
+	}
+	return 0
+}
+
+func (x *HotStuffProposal) GetBlock() *HotStuffBlock {
+	if x != nil {
+		return x.Block
 	}
 	return nil
 }
 
-type VcBasis struct {
+type HotStuffVote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaId                        uint64              `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	View                             uint64              `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
-	H                                uint64              `protobuf:"varint,3,opt,name=h,proto3" json:"h,omitempty"`
-	Pset                             []*VcPq             `protobuf:"bytes,4,rep,name=pset,proto3" json:"pset,omitempty"`
-	Qset                             []*VcPq             `protobuf:"bytes,5,rep,name=qset,proto3" json:"qset,omitempty"`
-	Cset                             []*SignedCheckpoint `protobuf:"bytes,6,rep,name=cset,proto3" json:"cset,omitempty"`
-	IfNotRecoverValidatorDynamicInfo []*NodeDynamicInfo  `protobuf:"bytes,7,rep,name=if_not_recover_validator_dynamic_info,json=ifNotRecoverValidatorDynamicInfo,proto3" json:"if_not_recover_validator_dynamic_info,omitempty"`
-	IfRecoverValidatorDynamicInfo    []*NodeDynamicInfo  `protobuf:"bytes,8,rep,name=if_recover_validator_dynamic_info,json=ifRecoverValidatorDynamicInfo,proto3" json:"if_recover_validator_dynamic_info,omitempty"`
+	ReplicaId uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	View      uint64 `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+	Height    uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *VcBasis) Reset() {
-	*x = VcBasis{}
+func (x *HotStuffVote) Reset() {
+	*x = HotStuffVote{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rbft_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -872,13 +1095,13 @@ func (x *VcBasis) Reset() {
 	}
 }
 
-func (x *VcBasis) String() string {
+func (x *HotStuffVote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VcBasis) ProtoMessage() {}
+func (*HotStuffVote) ProtoMessage() {}
 
-func (x *VcBasis) ProtoReflect() protoreflect.Message {
+func (x *HotStuffVote) ProtoReflect() protoreflect.Message {
 	mi := &file_rbft_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -890,93 +1113,73 @@ func (x *VcBasis) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VcBasis.ProtoReflect.Descriptor instead.
-func (*VcBasis) Descriptor() ([]byte, []int) {
+// Deprecated: Use HotStuffVote.ProtoReflect.Descriptor instead.
+func (*HotStuffVote) Descriptor() ([]byte, []int) {
 	return file_rbft_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *VcBasis) GetReplicaId() uint64 {
+func (x *HotStuffVote) GetReplicaId() uint64 {
 	if x != nil {
 		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *VcBasis) GetView() uint64 {
+func (x *HotStuffVote) GetView() uint64 {
 	if x != nil {
 		return x.View
 	}
 	return 0
 }
 
-func (x *VcBasis) GetH() uint64 {
+func (x *HotStuffVote) GetHeight() uint64 {
 	if x != nil {
-		return x.H
+		return x.Height
 	}
 	return 0
 }
 
-func (x *VcBasis) GetPset() []*VcPq {
+func (x *HotStuffVote) GetBlockHash() string {
 	if x != nil {
-		return x.Pset
+		return x.BlockHash
 	}
-	return nil
+	return ""
 }
 
-func (x *VcBasis) GetQset() []*VcPq {
+func (x *HotStuffVote) GetSignature() []byte {
 	if x != nil {
-		return x.Qset
+		return x.Signature
 	}
 	return nil
 }
 
-func (x *VcBasis) GetCset() []*SignedCheckpoint {
-	if x != nil {
-		return x.Cset
-	}
-	return nil
-}
+// HotStuffNewView is sent to the leader of next view after a view timeout.
+type HotStuffNewView struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *VcBasis) GetIfNotRecoverValidatorDynamicInfo() []*NodeDynamicInfo {
-	if x != nil {
-		return x.IfNotRecoverValidatorDynamicInfo
-	}
-	return nil
+	ReplicaId uint64      `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	View      uint64      `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+	HighQc    *HotStuffQC `protobuf:"bytes,3,opt,name=high_qc,json=highQc,proto3" json:"high_qc,omitempty"`
 }
 
-func (x *VcBasis) GetIfRecoverValidatorDynamicInfo() []*NodeDynamicInfo {
-	if x != nil {
-		return x.IfRecoverValidatorDynamicInfo
+func (x *HotStuffNewView) Reset() {
+	*x = HotStuffNewView{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type VcPq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SequenceNumber uint64 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
-	BatchDigest    string `protobuf:"bytes,2,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
-	View           uint64 `protobuf:"varint,3,opt,name=view,proto3" json:"view,omitempty"`
-}
-
-func (x *VcPq) Reset() {
-	*x = VcPq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *VcPq) String() string {
+func (x *HotStuffNewView) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VcPq) ProtoMessage() {}
+func (*HotStuffNewView) ProtoMessage() {}
 
-func (x *VcPq) ProtoReflect() protoreflect.Message {
+func (x *HotStuffNewView) ProtoReflect() protoreflect.Message {
 	mi := &file_rbft_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -988,43 +1191,44 @@ func (x *VcPq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VcPq.ProtoReflect.Descriptor instead.
-func (*VcPq) Descriptor() ([]byte, []int) {
+// Deprecated: Use HotStuffNewView.ProtoReflect.Descriptor instead.
+func (*HotStuffNewView) Descriptor() ([]byte, []int) {
 	return file_rbft_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *VcPq) GetSequenceNumber() uint64 {
+func (x *HotStuffNewView) GetReplicaId() uint64 {
 	if x != nil {
-		return x.SequenceNumber
+		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *VcPq) GetBatchDigest() string {
+func (x *HotStuffNewView) GetView() uint64 {
 	if x != nil {
-		return x.BatchDigest
+		return x.View
 	}
-	return ""
+	return 0
 }
 
-func (x *VcPq) GetView() uint64 {
+func (x *HotStuffNewView) GetHighQc() *HotStuffQC {
 	if x != nil {
-		return x.View
+		return x.HighQc
 	}
-	return 0
+	return nil
 }
 
-type QuorumViewChange struct {
+// HotStuffFetchBlock fetches a missing ancestor of received proposals by block hash.
+type HotStuffFetchBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaId   uint64        `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	ViewChanges []*ViewChange `protobuf:"bytes,2,rep,name=view_changes,json=viewChanges,proto3" json:"view_changes,omitempty"`
+	ReplicaId uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
-func (x *QuorumViewChange) Reset() {
-	*x = QuorumViewChange{}
+func (x *HotStuffFetchBlock) Reset() {
+	*x = HotStuffFetchBlock{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rbft_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1032,13 +1236,13 @@ func (x *QuorumViewChange) Reset() {
 	}
 }
 
-func (x *QuorumViewChange) String() string {
+func (x *HotStuffFetchBlock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuorumViewChange) ProtoMessage() {}
+func (*HotStuffFetchBlock) ProtoMessage() {}
 
-func (x *QuorumViewChange) ProtoReflect() protoreflect.Message {
+func (x *HotStuffFetchBlock) ProtoReflect() protoreflect.Message {
 	mi := &file_rbft_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1050,38 +1254,36 @@ func (x *QuorumViewChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuorumViewChange.ProtoReflect.Descriptor instead.
-func (*QuorumViewChange) Descriptor() ([]byte, []int) {
+// Deprecated: Use HotStuffFetchBlock.ProtoReflect.Descriptor instead.
+func (*HotStuffFetchBlock) Descriptor() ([]byte, []int) {
 	return file_rbft_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QuorumViewChange) GetReplicaId() uint64 {
+func (x *HotStuffFetchBlock) GetReplicaId() uint64 {
 	if x != nil {
 		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *QuorumViewChange) GetViewChanges() []*ViewChange {
+func (x *HotStuffFetchBlock) GetBlockHash() string {
 	if x != nil {
-		return x.ViewChanges
+		return x.BlockHash
 	}
-	return nil
+	return ""
 }
 
-type NodeDynamicInfo struct {
+type HotStuffFetchBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ConsensusVotingPower           int64  `protobuf:"varint,2,opt,name=consensus_voting_power,json=consensusVotingPower,proto3" json:"consensus_voting_power,omitempty"`
-	ConsensusVotingPowerReduced    bool   `protobuf:"varint,3,opt,name=consensus_voting_power_reduced,json=consensusVotingPowerReduced,proto3" json:"consensus_voting_power_reduced,omitempty"`
-	ConsensusVotingPowerReduceView uint64 `protobuf:"varint,4,opt,name=consensus_voting_power_reduce_view,json=consensusVotingPowerReduceView,proto3" json:"consensus_voting_power_reduce_view,omitempty"`
+	ReplicaId uint64         `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	Block     *HotStuffBlock `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (x *NodeDynamicInfo) Reset() {
-	*x = NodeDynamicInfo{}
+func (x *HotStuffFetchBlockResponse) Reset() {
+	*x = HotStuffFetchBlockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rbft_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1089,13 +1291,13 @@ func (x *NodeDynamicInfo) Reset() {
 	}
 }
 
-func (x *NodeDynamicInfo) String() string {
+func (x *HotStuffFetchBlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeDynamicInfo) ProtoMessage() {}
+func (*HotStuffFetchBlockResponse) ProtoMessage() {}
 
-func (x *NodeDynamicInfo) ProtoReflect() protoreflect.Message {
+func (x *HotStuffFetchBlockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rbft_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1107,59 +1309,36 @@ func (x *NodeDynamicInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeDynamicInfo.ProtoReflect.Descriptor instead.
-func (*NodeDynamicInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use HotStuffFetchBlockResponse.ProtoReflect.Descriptor instead.
+func (*HotStuffFetchBlockResponse) Descriptor() ([]byte, []int) {
 	return file_rbft_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *NodeDynamicInfo) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *NodeDynamicInfo) GetConsensusVotingPower() int64 {
+func (x *HotStuffFetchBlockResponse) GetReplicaId() uint64 {
 	if x != nil {
-		return x.ConsensusVotingPower
+		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *NodeDynamicInfo) GetConsensusVotingPowerReduced() bool {
-	if x != nil {
-		return x.ConsensusVotingPowerReduced
-	}
-	return false
-}
-
-func (x *NodeDynamicInfo) GetConsensusVotingPowerReduceView() uint64 {
+func (x *HotStuffFetchBlockResponse) GetBlock() *HotStuffBlock {
 	if x != nil {
-		return x.ConsensusVotingPowerReduceView
+		return x.Block
 	}
-	return 0
+	return nil
 }
 
-type NewView struct {
+type ReBroadcastRequestSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// new primary id
-	ReplicaId        uint64            `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	View             uint64            `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
-	Xset             []*VcPq           `protobuf:"bytes,3,rep,name=xset,proto3" json:"xset,omitempty"`
-	ViewChangeSet    *QuorumViewChange `protobuf:"bytes,4,opt,name=view_change_set,json=viewChangeSet,proto3" json:"view_change_set,omitempty"`
-	QuorumCheckpoint *QuorumCheckpoint `protobuf:"bytes,5,opt,name=quorum_checkpoint,json=quorumCheckpoint,proto3" json:"quorum_checkpoint,omitempty"`
-	Signature        []byte            `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
-	// signed from replica id(for wrf check)
-	FromId               uint64             `protobuf:"varint,7,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
-	AutoTermUpdate       bool               `protobuf:"varint,8,opt,name=auto_term_update,json=autoTermUpdate,proto3" json:"auto_term_update,omitempty"`
-	ValidatorDynamicInfo []*NodeDynamicInfo `protobuf:"bytes,9,rep,name=validator_dynamic_info,json=validatorDynamicInfo,proto3" json:"validator_dynamic_info,omitempty"`
+	ReplicaId uint64   `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	Requests  [][]byte `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *NewView) Reset() {
-	*x = NewView{}
+func (x *ReBroadcastRequestSet) Reset() {
+	*x = ReBroadcastRequestSet{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rbft_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1167,13 +1346,13 @@ func (x *NewView) Reset() {
 	}
 }
 
-func (x *NewView) String() string {
+func (x *ReBroadcastRequestSet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewView) ProtoMessage() {}
+func (*ReBroadcastRequestSet) ProtoMessage() {}
 
-func (x *NewView) ProtoReflect() protoreflect.Message {
+func (x *ReBroadcastRequestSet) ProtoReflect() protoreflect.Message {
 	mi := &file_rbft_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1185,100 +1364,158 @@ func (x *NewView) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewView.ProtoReflect.Descriptor instead.
-func (*NewView) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReBroadcastRequestSet.ProtoReflect.Descriptor instead.
+func (*ReBroadcastRequestSet) Descriptor() ([]byte, []int) {
 	return file_rbft_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *NewView) GetReplicaId() uint64 {
+func (x *ReBroadcastRequestSet) GetReplicaId() uint64 {
 	if x != nil {
 		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *NewView) GetView() uint64 {
+func (x *ReBroadcastRequestSet) GetRequests() [][]byte {
 	if x != nil {
-		return x.View
+		return x.Requests
 	}
-	return 0
+	return nil
 }
 
-func (x *NewView) GetXset() []*VcPq {
+type HashBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestHashList            []string `protobuf:"bytes,1,rep,name=request_hash_list,json=requestHashList,proto3" json:"request_hash_list,omitempty"`
+	DeDuplicateRequestHashList []string `protobuf:"bytes,2,rep,name=de_duplicate_request_hash_list,json=deDuplicateRequestHashList,proto3" json:"de_duplicate_request_hash_list,omitempty"`
+	Timestamp                  int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Proposer                   uint64   `protobuf:"varint,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// VRF proof of proposer for the proposer seed, only set if VRF seed is enabled.
+	VrfProof []byte `protobuf:"bytes,5,opt,name=vrf_proof,json=vrfProof,proto3" json:"vrf_proof,omitempty"`
+	// key rotations announced by validators and ordered by this batch, only set if key rotation is enabled.
+	KeyRotations []*KeyRotation `protobuf:"bytes,6,rep,name=key_rotations,json=keyRotations,proto3" json:"key_rotations,omitempty"`
+	// BFT time the batch is executed with, only set if BFT time is enabled.
+	BftTime int64 `protobuf:"varint,7,opt,name=bft_time,json=bftTime,proto3" json:"bft_time,omitempty"`
+	// receive times of txs reported by primary, only set if the ordering policy orders txs by receive time.
+	ReceiveTimes []int64 `protobuf:"varint,8,rep,packed,name=receive_times,json=receiveTimes,proto3" json:"receive_times,omitempty"`
+}
+
+func (x *HashBatch) Reset() {
+	*x = HashBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashBatch) ProtoMessage() {}
+
+func (x *HashBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashBatch.ProtoReflect.Descriptor instead.
+func (*HashBatch) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HashBatch) GetRequestHashList() []string {
 	if x != nil {
-		return x.Xset
+		return x.RequestHashList
 	}
 	return nil
 }
 
-func (x *NewView) GetViewChangeSet() *QuorumViewChange {
+func (x *HashBatch) GetDeDuplicateRequestHashList() []string {
 	if x != nil {
-		return x.ViewChangeSet
+		return x.DeDuplicateRequestHashList
 	}
 	return nil
 }
 
-func (x *NewView) GetQuorumCheckpoint() *QuorumCheckpoint {
+func (x *HashBatch) GetTimestamp() int64 {
 	if x != nil {
-		return x.QuorumCheckpoint
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-func (x *NewView) GetSignature() []byte {
+func (x *HashBatch) GetProposer() uint64 {
 	if x != nil {
-		return x.Signature
+		return x.Proposer
+	}
+	return 0
+}
+
+func (x *HashBatch) GetVrfProof() []byte {
+	if x != nil {
+		return x.VrfProof
 	}
 	return nil
 }
 
-func (x *NewView) GetFromId() uint64 {
+func (x *HashBatch) GetKeyRotations() []*KeyRotation {
 	if x != nil {
-		return x.FromId
+		return x.KeyRotations
 	}
-	return 0
+	return nil
 }
 
-func (x *NewView) GetAutoTermUpdate() bool {
+func (x *HashBatch) GetBftTime() int64 {
 	if x != nil {
-		return x.AutoTermUpdate
+		return x.BftTime
 	}
-	return false
+	return 0
 }
 
-func (x *NewView) GetValidatorDynamicInfo() []*NodeDynamicInfo {
+func (x *HashBatch) GetReceiveTimes() []int64 {
 	if x != nil {
-		return x.ValidatorDynamicInfo
+		return x.ReceiveTimes
 	}
 	return nil
 }
 
-type FetchView struct {
+type FetchCheckpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaId uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	View      uint64 `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+	ReplicaId      uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	SequenceNumber uint64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
 }
 
-func (x *FetchView) Reset() {
-	*x = FetchView{}
+func (x *FetchCheckpoint) Reset() {
+	*x = FetchCheckpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[15]
+		mi := &file_rbft_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FetchView) String() string {
+func (x *FetchCheckpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchView) ProtoMessage() {}
+func (*FetchCheckpoint) ProtoMessage() {}
 
-func (x *FetchView) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[15]
+func (x *FetchCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1289,53 +1526,54 @@ func (x *FetchView) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchView.ProtoReflect.Descriptor instead.
-func (*FetchView) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use FetchCheckpoint.ProtoReflect.Descriptor instead.
+func (*FetchCheckpoint) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *FetchView) GetReplicaId() uint64 {
+func (x *FetchCheckpoint) GetReplicaId() uint64 {
 	if x != nil {
 		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *FetchView) GetView() uint64 {
+func (x *FetchCheckpoint) GetSequenceNumber() uint64 {
 	if x != nil {
-		return x.View
+		return x.SequenceNumber
 	}
 	return 0
 }
 
-type RecoveryResponse struct {
+type ViewChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewView           *NewView          `protobuf:"bytes,1,opt,name=new_view,json=newView,proto3" json:"new_view,omitempty"`
-	InitialCheckpoint *SignedCheckpoint `protobuf:"bytes,2,opt,name=initial_checkpoint,json=initialCheckpoint,proto3" json:"initial_checkpoint,omitempty"`
-	// Help remote check genesis block digest
-	GenesisBlockDigest string `protobuf:"bytes,3,opt,name=genesis_block_digest,json=genesisBlockDigest,proto3" json:"genesis_block_digest,omitempty"`
+	Basis          *VcBasis `protobuf:"bytes,1,opt,name=basis,proto3" json:"basis,omitempty"`
+	Signature      []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Timestamp      int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Recovery       bool     `protobuf:"varint,4,opt,name=recovery,proto3" json:"recovery,omitempty"`
+	LeaderTransfer bool     `protobuf:"varint,5,opt,name=leader_transfer,json=leaderTransfer,proto3" json:"leader_transfer,omitempty"`
 }
 
-func (x *RecoveryResponse) Reset() {
-	*x = RecoveryResponse{}
+func (x *ViewChange) Reset() {
+	*x = ViewChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[16]
+		mi := &file_rbft_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecoveryResponse) String() string {
+func (x *ViewChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecoveryResponse) ProtoMessage() {}
+func (*ViewChange) ProtoMessage() {}
 
-func (x *RecoveryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[16]
+func (x *ViewChange) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,58 +1584,76 @@ func (x *RecoveryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoveryResponse.ProtoReflect.Descriptor instead.
-func (*RecoveryResponse) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ViewChange.ProtoReflect.Descriptor instead.
+func (*ViewChange) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *RecoveryResponse) GetNewView() *NewView {
+func (x *ViewChange) GetBasis() *VcBasis {
 	if x != nil {
-		return x.NewView
+		return x.Basis
 	}
 	return nil
 }
 
-func (x *RecoveryResponse) GetInitialCheckpoint() *SignedCheckpoint {
+func (x *ViewChange) GetSignature() []byte {
 	if x != nil {
-		return x.InitialCheckpoint
+		return x.Signature
 	}
 	return nil
 }
 
-func (x *RecoveryResponse) GetGenesisBlockDigest() string {
+func (x *ViewChange) GetTimestamp() int64 {
 	if x != nil {
-		return x.GenesisBlockDigest
+		return x.Timestamp
 	}
-	return ""
+	return 0
 }
 
-type FetchBatchRequest struct {
+func (x *ViewChange) GetRecovery() bool {
+	if x != nil {
+		return x.Recovery
+	}
+	return false
+}
+
+func (x *ViewChange) GetLeaderTransfer() bool {
+	if x != nil {
+		return x.LeaderTransfer
+	}
+	return false
+}
+
+// LeaderTransfer is broadcast by current primary to hand over its leadership
+// after all its in-flight batches have been committed.
+type LeaderTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaId   uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	BatchDigest string `protobuf:"bytes,2,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
+	ReplicaId      uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	View           uint64 `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+	SequenceNumber uint64 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	Signature      []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *FetchBatchRequest) Reset() {
-	*x = FetchBatchRequest{}
+func (x *LeaderTransfer) Reset() {
+	*x = LeaderTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[17]
+		mi := &file_rbft_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FetchBatchRequest) String() string {
+func (x *LeaderTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchBatchRequest) ProtoMessage() {}
+func (*LeaderTransfer) ProtoMessage() {}
 
-func (x *FetchBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[17]
+func (x *LeaderTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1408,52 +1664,68 @@ func (x *FetchBatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchBatchRequest.ProtoReflect.Descriptor instead.
-func (*FetchBatchRequest) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use LeaderTransfer.ProtoReflect.Descriptor instead.
+func (*LeaderTransfer) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *FetchBatchRequest) GetReplicaId() uint64 {
+func (x *LeaderTransfer) GetReplicaId() uint64 {
 	if x != nil {
 		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *FetchBatchRequest) GetBatchDigest() string {
+func (x *LeaderTransfer) GetView() uint64 {
 	if x != nil {
-		return x.BatchDigest
+		return x.View
 	}
-	return ""
+	return 0
 }
 
-type FetchBatchResponse struct {
+func (x *LeaderTransfer) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *LeaderTransfer) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// KeyRotation announces a new consensus key of a validator, which is signed by its current key.
+type KeyRotation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaId   uint64        `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	Batch       *RequestBatch `protobuf:"bytes,2,opt,name=batch,proto3" json:"batch,omitempty"`
-	BatchDigest string        `protobuf:"bytes,3,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
+	ReplicaId  uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	KeyVersion uint64 `protobuf:"varint,2,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	PubKey     []byte `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Signature  []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *FetchBatchResponse) Reset() {
-	*x = FetchBatchResponse{}
+func (x *KeyRotation) Reset() {
+	*x = KeyRotation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[18]
+		mi := &file_rbft_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FetchBatchResponse) String() string {
+func (x *KeyRotation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchBatchResponse) ProtoMessage() {}
+func (*KeyRotation) ProtoMessage() {}
 
-func (x *FetchBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[18]
+func (x *KeyRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,63 +1736,72 @@ func (x *FetchBatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchBatchResponse.ProtoReflect.Descriptor instead.
-func (*FetchBatchResponse) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use KeyRotation.ProtoReflect.Descriptor instead.
+func (*KeyRotation) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *FetchBatchResponse) GetReplicaId() uint64 {
+func (x *KeyRotation) GetReplicaId() uint64 {
 	if x != nil {
 		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *FetchBatchResponse) GetBatch() *RequestBatch {
+func (x *KeyRotation) GetKeyVersion() uint64 {
 	if x != nil {
-		return x.Batch
+		return x.KeyVersion
+	}
+	return 0
+}
+
+func (x *KeyRotation) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
 	}
 	return nil
 }
 
-func (x *FetchBatchResponse) GetBatchDigest() string {
+func (x *KeyRotation) GetSignature() []byte {
 	if x != nil {
-		return x.BatchDigest
+		return x.Signature
 	}
-	return ""
+	return nil
 }
 
-type RequestBatch struct {
+// ValidatorKeyState is the consensus key state of a validator, the previous key is still accepted
+// until prev_until during the overlap window after a rotation. pending is the key rotation ordered
+// by an executed batch, which is activated by the next stable checkpoint.
+type ValidatorKeyState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestHashList []string `protobuf:"bytes,1,rep,name=request_hash_list,json=requestHashList,proto3" json:"request_hash_list,omitempty"`
-	RequestList     [][]byte `protobuf:"bytes,2,rep,name=request_list,json=requestList,proto3" json:"request_list,omitempty"`
-	Timestamp       int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	SeqNo           uint64   `protobuf:"varint,4,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
-	LocalList       []bool   `protobuf:"varint,5,rep,packed,name=local_list,json=localList,proto3" json:"local_list,omitempty"`
-	BatchHash       string   `protobuf:"bytes,6,opt,name=batch_hash,json=batchHash,proto3" json:"batch_hash,omitempty"`
-	Proposer        uint64   `protobuf:"varint,7,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	KeyVersion     uint64       `protobuf:"varint,1,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	PubKey         []byte       `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	PrevKeyVersion uint64       `protobuf:"varint,3,opt,name=prev_key_version,json=prevKeyVersion,proto3" json:"prev_key_version,omitempty"`
+	PrevPubKey     []byte       `protobuf:"bytes,4,opt,name=prev_pub_key,json=prevPubKey,proto3" json:"prev_pub_key,omitempty"`
+	PrevUntil      uint64       `protobuf:"varint,5,opt,name=prev_until,json=prevUntil,proto3" json:"prev_until,omitempty"`
+	Pending        *KeyRotation `protobuf:"bytes,6,opt,name=pending,proto3" json:"pending,omitempty"`
 }
 
-func (x *RequestBatch) Reset() {
-	*x = RequestBatch{}
+func (x *ValidatorKeyState) Reset() {
+	*x = ValidatorKeyState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[19]
+		mi := &file_rbft_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RequestBatch) String() string {
+func (x *ValidatorKeyState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestBatch) ProtoMessage() {}
+func (*ValidatorKeyState) ProtoMessage() {}
 
-func (x *RequestBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[19]
+func (x *ValidatorKeyState) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,89 +1812,132 @@ func (x *RequestBatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestBatch.ProtoReflect.Descriptor instead.
-func (*RequestBatch) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ValidatorKeyState.ProtoReflect.Descriptor instead.
+func (*ValidatorKeyState) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *RequestBatch) GetRequestHashList() []string {
+func (x *ValidatorKeyState) GetKeyVersion() uint64 {
 	if x != nil {
-		return x.RequestHashList
+		return x.KeyVersion
 	}
-	return nil
+	return 0
 }
 
-func (x *RequestBatch) GetRequestList() [][]byte {
+func (x *ValidatorKeyState) GetPubKey() []byte {
 	if x != nil {
-		return x.RequestList
+		return x.PubKey
 	}
 	return nil
 }
 
-func (x *RequestBatch) GetTimestamp() int64 {
+func (x *ValidatorKeyState) GetPrevKeyVersion() uint64 {
 	if x != nil {
-		return x.Timestamp
+		return x.PrevKeyVersion
 	}
 	return 0
 }
 
-func (x *RequestBatch) GetSeqNo() uint64 {
+func (x *ValidatorKeyState) GetPrevPubKey() []byte {
 	if x != nil {
-		return x.SeqNo
+		return x.PrevPubKey
+	}
+	return nil
+}
+
+func (x *ValidatorKeyState) GetPrevUntil() uint64 {
+	if x != nil {
+		return x.PrevUntil
 	}
 	return 0
 }
 
-func (x *RequestBatch) GetLocalList() []bool {
+func (x *ValidatorKeyState) GetPending() *KeyRotation {
 	if x != nil {
-		return x.LocalList
+		return x.Pending
 	}
 	return nil
 }
 
-func (x *RequestBatch) GetBatchHash() string {
-	if x != nil {
-		return x.BatchHash
+type ValidatorDynamicInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info []*NodeDynamicInfo `protobuf:"bytes,1,rep,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *ValidatorDynamicInfo) Reset() {
+	*x = ValidatorDynamicInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *RequestBatch) GetProposer() uint64 {
+func (x *ValidatorDynamicInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorDynamicInfo) ProtoMessage() {}
+
+func (x *ValidatorDynamicInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorDynamicInfo.ProtoReflect.Descriptor instead.
+func (*ValidatorDynamicInfo) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ValidatorDynamicInfo) GetInfo() []*NodeDynamicInfo {
 	if x != nil {
-		return x.Proposer
+		return x.Info
 	}
-	return 0
+	return nil
 }
 
-type FetchMissingRequest struct {
+type VcBasis struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaId            uint64            `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	View                 uint64            `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
-	SequenceNumber       uint64            `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
-	BatchDigest          string            `protobuf:"bytes,4,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
-	MissingRequestHashes map[uint64]string `protobuf:"bytes,5,rep,name=missing_request_hashes,json=missingRequestHashes,proto3" json:"missing_request_hashes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ReplicaId                        uint64              `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	View                             uint64              `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+	H                                uint64              `protobuf:"varint,3,opt,name=h,proto3" json:"h,omitempty"`
+	Pset                             []*VcPq             `protobuf:"bytes,4,rep,name=pset,proto3" json:"pset,omitempty"`
+	Qset                             []*VcPq             `protobuf:"bytes,5,rep,name=qset,proto3" json:"qset,omitempty"`
+	Cset                             []*SignedCheckpoint `protobuf:"bytes,6,rep,name=cset,proto3" json:"cset,omitempty"`
+	IfNotRecoverValidatorDynamicInfo []*NodeDynamicInfo  `protobuf:"bytes,7,rep,name=if_not_recover_validator_dynamic_info,json=ifNotRecoverValidatorDynamicInfo,proto3" json:"if_not_recover_validator_dynamic_info,omitempty"`
+	IfRecoverValidatorDynamicInfo    []*NodeDynamicInfo  `protobuf:"bytes,8,rep,name=if_recover_validator_dynamic_info,json=ifRecoverValidatorDynamicInfo,proto3" json:"if_recover_validator_dynamic_info,omitempty"`
 }
 
-func (x *FetchMissingRequest) Reset() {
-	*x = FetchMissingRequest{}
+func (x *VcBasis) Reset() {
+	*x = VcBasis{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[20]
+		mi := &file_rbft_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FetchMissingRequest) String() string {
+func (x *VcBasis) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchMissingRequest) ProtoMessage() {}
+func (*VcBasis) ProtoMessage() {}
 
-func (x *FetchMissingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[20]
+func (x *VcBasis) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1624,77 +1948,1185 @@ func (x *FetchMissingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchMissingRequest.ProtoReflect.Descriptor instead.
-func (*FetchMissingRequest) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use VcBasis.ProtoReflect.Descriptor instead.
+func (*VcBasis) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *FetchMissingRequest) GetReplicaId() uint64 {
+func (x *VcBasis) GetReplicaId() uint64 {
 	if x != nil {
 		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *FetchMissingRequest) GetView() uint64 {
+func (x *VcBasis) GetView() uint64 {
 	if x != nil {
 		return x.View
 	}
 	return 0
 }
 
-func (x *FetchMissingRequest) GetSequenceNumber() uint64 {
+func (x *VcBasis) GetH() uint64 {
 	if x != nil {
-		return x.SequenceNumber
+		return x.H
 	}
 	return 0
 }
 
-func (x *FetchMissingRequest) GetBatchDigest() string {
+func (x *VcBasis) GetPset() []*VcPq {
 	if x != nil {
-		return x.BatchDigest
+		return x.Pset
 	}
-	return ""
+	return nil
+}
+
+func (x *VcBasis) GetQset() []*VcPq {
+	if x != nil {
+		return x.Qset
+	}
+	return nil
+}
+
+func (x *VcBasis) GetCset() []*SignedCheckpoint {
+	if x != nil {
+		return x.Cset
+	}
+	return nil
+}
+
+func (x *VcBasis) GetIfNotRecoverValidatorDynamicInfo() []*NodeDynamicInfo {
+	if x != nil {
+		return x.IfNotRecoverValidatorDynamicInfo
+	}
+	return nil
+}
+
+func (x *VcBasis) GetIfRecoverValidatorDynamicInfo() []*NodeDynamicInfo {
+	if x != nil {
+		return x.IfRecoverValidatorDynamicInfo
+	}
+	return nil
+}
+
+type VcPq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SequenceNumber uint64 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	BatchDigest    string `protobuf:"bytes,2,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
+	View           uint64 `protobuf:"varint,3,opt,name=view,proto3" json:"view,omitempty"`
+}
+
+func (x *VcPq) Reset() {
+	*x = VcPq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VcPq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VcPq) ProtoMessage() {}
+
+func (x *VcPq) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VcPq.ProtoReflect.Descriptor instead.
+func (*VcPq) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VcPq) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *VcPq) GetBatchDigest() string {
+	if x != nil {
+		return x.BatchDigest
+	}
+	return ""
+}
+
+func (x *VcPq) GetView() uint64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+type QuorumViewChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplicaId   uint64        `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	ViewChanges []*ViewChange `protobuf:"bytes,2,rep,name=view_changes,json=viewChanges,proto3" json:"view_changes,omitempty"`
+}
+
+func (x *QuorumViewChange) Reset() {
+	*x = QuorumViewChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuorumViewChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuorumViewChange) ProtoMessage() {}
+
+func (x *QuorumViewChange) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuorumViewChange.ProtoReflect.Descriptor instead.
+func (*QuorumViewChange) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QuorumViewChange) GetReplicaId() uint64 {
+	if x != nil {
+		return x.ReplicaId
+	}
+	return 0
+}
+
+func (x *QuorumViewChange) GetViewChanges() []*ViewChange {
+	if x != nil {
+		return x.ViewChanges
+	}
+	return nil
+}
+
+type NodeDynamicInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConsensusVotingPower           int64  `protobuf:"varint,2,opt,name=consensus_voting_power,json=consensusVotingPower,proto3" json:"consensus_voting_power,omitempty"`
+	ConsensusVotingPowerReduced    bool   `protobuf:"varint,3,opt,name=consensus_voting_power_reduced,json=consensusVotingPowerReduced,proto3" json:"consensus_voting_power_reduced,omitempty"`
+	ConsensusVotingPowerReduceView uint64 `protobuf:"varint,4,opt,name=consensus_voting_power_reduce_view,json=consensusVotingPowerReduceView,proto3" json:"consensus_voting_power_reduce_view,omitempty"`
+	// decaying penalty of timeouts as primary, only used by reputation proposer election.
+	ReputationPenalty uint64 `protobuf:"varint,5,opt,name=reputation_penalty,json=reputationPenalty,proto3" json:"reputation_penalty,omitempty"`
+}
+
+func (x *NodeDynamicInfo) Reset() {
+	*x = NodeDynamicInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeDynamicInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDynamicInfo) ProtoMessage() {}
+
+func (x *NodeDynamicInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDynamicInfo.ProtoReflect.Descriptor instead.
+func (*NodeDynamicInfo) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *NodeDynamicInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NodeDynamicInfo) GetConsensusVotingPower() int64 {
+	if x != nil {
+		return x.ConsensusVotingPower
+	}
+	return 0
+}
+
+func (x *NodeDynamicInfo) GetConsensusVotingPowerReduced() bool {
+	if x != nil {
+		return x.ConsensusVotingPowerReduced
+	}
+	return false
+}
+
+func (x *NodeDynamicInfo) GetConsensusVotingPowerReduceView() uint64 {
+	if x != nil {
+		return x.ConsensusVotingPowerReduceView
+	}
+	return 0
+}
+
+func (x *NodeDynamicInfo) GetReputationPenalty() uint64 {
+	if x != nil {
+		return x.ReputationPenalty
+	}
+	return 0
+}
+
+type NewView struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// new primary id
+	ReplicaId        uint64            `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	View             uint64            `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+	Xset             []*VcPq           `protobuf:"bytes,3,rep,name=xset,proto3" json:"xset,omitempty"`
+	ViewChangeSet    *QuorumViewChange `protobuf:"bytes,4,opt,name=view_change_set,json=viewChangeSet,proto3" json:"view_change_set,omitempty"`
+	QuorumCheckpoint *QuorumCheckpoint `protobuf:"bytes,5,opt,name=quorum_checkpoint,json=quorumCheckpoint,proto3" json:"quorum_checkpoint,omitempty"`
+	Signature        []byte            `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	// signed from replica id(for wrf check)
+	FromId               uint64             `protobuf:"varint,7,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	AutoTermUpdate       bool               `protobuf:"varint,8,opt,name=auto_term_update,json=autoTermUpdate,proto3" json:"auto_term_update,omitempty"`
+	ValidatorDynamicInfo []*NodeDynamicInfo `protobuf:"bytes,9,rep,name=validator_dynamic_info,json=validatorDynamicInfo,proto3" json:"validator_dynamic_info,omitempty"`
+}
+
+func (x *NewView) Reset() {
+	*x = NewView{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewView) ProtoMessage() {}
+
+func (x *NewView) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewView.ProtoReflect.Descriptor instead.
+func (*NewView) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *NewView) GetReplicaId() uint64 {
+	if x != nil {
+		return x.ReplicaId
+	}
+	return 0
+}
+
+func (x *NewView) GetView() uint64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+func (x *NewView) GetXset() []*VcPq {
+	if x != nil {
+		return x.Xset
+	}
+	return nil
+}
+
+func (x *NewView) GetViewChangeSet() *QuorumViewChange {
+	if x != nil {
+		return x.ViewChangeSet
+	}
+	return nil
+}
+
+func (x *NewView) GetQuorumCheckpoint() *QuorumCheckpoint {
+	if x != nil {
+		return x.QuorumCheckpoint
+	}
+	return nil
+}
+
+func (x *NewView) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *NewView) GetFromId() uint64 {
+	if x != nil {
+		return x.FromId
+	}
+	return 0
+}
+
+func (x *NewView) GetAutoTermUpdate() bool {
+	if x != nil {
+		return x.AutoTermUpdate
+	}
+	return false
+}
+
+func (x *NewView) GetValidatorDynamicInfo() []*NodeDynamicInfo {
+	if x != nil {
+		return x.ValidatorDynamicInfo
+	}
+	return nil
+}
+
+type FetchView struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplicaId uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	View      uint64 `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+}
+
+func (x *FetchView) Reset() {
+	*x = FetchView{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchView) ProtoMessage() {}
+
+func (x *FetchView) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchView.ProtoReflect.Descriptor instead.
+func (*FetchView) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FetchView) GetReplicaId() uint64 {
+	if x != nil {
+		return x.ReplicaId
+	}
+	return 0
+}
+
+func (x *FetchView) GetView() uint64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+type RecoveryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewView           *NewView          `protobuf:"bytes,1,opt,name=new_view,json=newView,proto3" json:"new_view,omitempty"`
+	InitialCheckpoint *SignedCheckpoint `protobuf:"bytes,2,opt,name=initial_checkpoint,json=initialCheckpoint,proto3" json:"initial_checkpoint,omitempty"`
+	// Help remote check genesis block digest
+	GenesisBlockDigest string `protobuf:"bytes,3,opt,name=genesis_block_digest,json=genesisBlockDigest,proto3" json:"genesis_block_digest,omitempty"`
+}
+
+func (x *RecoveryResponse) Reset() {
+	*x = RecoveryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryResponse) ProtoMessage() {}
+
+func (x *RecoveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryResponse.ProtoReflect.Descriptor instead.
+func (*RecoveryResponse) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RecoveryResponse) GetNewView() *NewView {
+	if x != nil {
+		return x.NewView
+	}
+	return nil
+}
+
+func (x *RecoveryResponse) GetInitialCheckpoint() *SignedCheckpoint {
+	if x != nil {
+		return x.InitialCheckpoint
+	}
+	return nil
+}
+
+func (x *RecoveryResponse) GetGenesisBlockDigest() string {
+	if x != nil {
+		return x.GenesisBlockDigest
+	}
+	return ""
+}
+
+type FetchBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplicaId   uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	BatchDigest string `protobuf:"bytes,2,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
+}
+
+func (x *FetchBatchRequest) Reset() {
+	*x = FetchBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchBatchRequest) ProtoMessage() {}
+
+func (x *FetchBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchBatchRequest.ProtoReflect.Descriptor instead.
+func (*FetchBatchRequest) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FetchBatchRequest) GetReplicaId() uint64 {
+	if x != nil {
+		return x.ReplicaId
+	}
+	return 0
+}
+
+func (x *FetchBatchRequest) GetBatchDigest() string {
+	if x != nil {
+		return x.BatchDigest
+	}
+	return ""
+}
+
+type FetchBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplicaId   uint64        `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	Batch       *RequestBatch `protobuf:"bytes,2,opt,name=batch,proto3" json:"batch,omitempty"`
+	BatchDigest string        `protobuf:"bytes,3,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
+}
+
+func (x *FetchBatchResponse) Reset() {
+	*x = FetchBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchBatchResponse) ProtoMessage() {}
+
+func (x *FetchBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchBatchResponse.ProtoReflect.Descriptor instead.
+func (*FetchBatchResponse) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FetchBatchResponse) GetReplicaId() uint64 {
+	if x != nil {
+		return x.ReplicaId
+	}
+	return 0
+}
+
+func (x *FetchBatchResponse) GetBatch() *RequestBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *FetchBatchResponse) GetBatchDigest() string {
+	if x != nil {
+		return x.BatchDigest
+	}
+	return ""
+}
+
+type RequestBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestHashList []string `protobuf:"bytes,1,rep,name=request_hash_list,json=requestHashList,proto3" json:"request_hash_list,omitempty"`
+	RequestList     [][]byte `protobuf:"bytes,2,rep,name=request_list,json=requestList,proto3" json:"request_list,omitempty"`
+	Timestamp       int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SeqNo           uint64   `protobuf:"varint,4,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
+	LocalList       []bool   `protobuf:"varint,5,rep,packed,name=local_list,json=localList,proto3" json:"local_list,omitempty"`
+	BatchHash       string   `protobuf:"bytes,6,opt,name=batch_hash,json=batchHash,proto3" json:"batch_hash,omitempty"`
+	Proposer        uint64   `protobuf:"varint,7,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	VrfProof        []byte   `protobuf:"bytes,8,opt,name=vrf_proof,json=vrfProof,proto3" json:"vrf_proof,omitempty"`
+	// VRF output verified from vrf_proof, persisted with the batch and never trusted from peers.
+	VrfOutput    []byte         `protobuf:"bytes,9,opt,name=vrf_output,json=vrfOutput,proto3" json:"vrf_output,omitempty"`
+	KeyRotations []*KeyRotation `protobuf:"bytes,10,rep,name=key_rotations,json=keyRotations,proto3" json:"key_rotations,omitempty"`
+	BftTime      int64          `protobuf:"varint,11,opt,name=bft_time,json=bftTime,proto3" json:"bft_time,omitempty"`
+}
+
+func (x *RequestBatch) Reset() {
+	*x = RequestBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestBatch) ProtoMessage() {}
+
+func (x *RequestBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestBatch.ProtoReflect.Descriptor instead.
+func (*RequestBatch) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RequestBatch) GetRequestHashList() []string {
+	if x != nil {
+		return x.RequestHashList
+	}
+	return nil
+}
+
+func (x *RequestBatch) GetRequestList() [][]byte {
+	if x != nil {
+		return x.RequestList
+	}
+	return nil
+}
+
+func (x *RequestBatch) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RequestBatch) GetSeqNo() uint64 {
+	if x != nil {
+		return x.SeqNo
+	}
+	return 0
+}
+
+func (x *RequestBatch) GetLocalList() []bool {
+	if x != nil {
+		return x.LocalList
+	}
+	return nil
+}
+
+func (x *RequestBatch) GetBatchHash() string {
+	if x != nil {
+		return x.BatchHash
+	}
+	return ""
+}
+
+func (x *RequestBatch) GetProposer() uint64 {
+	if x != nil {
+		return x.Proposer
+	}
+	return 0
+}
+
+func (x *RequestBatch) GetVrfProof() []byte {
+	if x != nil {
+		return x.VrfProof
+	}
+	return nil
+}
+
+func (x *RequestBatch) GetVrfOutput() []byte {
+	if x != nil {
+		return x.VrfOutput
+	}
+	return nil
+}
+
+func (x *RequestBatch) GetKeyRotations() []*KeyRotation {
+	if x != nil {
+		return x.KeyRotations
+	}
+	return nil
+}
+
+func (x *RequestBatch) GetBftTime() int64 {
+	if x != nil {
+		return x.BftTime
+	}
+	return 0
+}
+
+type FetchMissingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplicaId            uint64            `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	View                 uint64            `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+	SequenceNumber       uint64            `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	BatchDigest          string            `protobuf:"bytes,4,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
+	MissingRequestHashes map[uint64]string `protobuf:"bytes,5,rep,name=missing_request_hashes,json=missingRequestHashes,proto3" json:"missing_request_hashes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *FetchMissingRequest) Reset() {
+	*x = FetchMissingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchMissingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchMissingRequest) ProtoMessage() {}
+
+func (x *FetchMissingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchMissingRequest.ProtoReflect.Descriptor instead.
+func (*FetchMissingRequest) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FetchMissingRequest) GetReplicaId() uint64 {
+	if x != nil {
+		return x.ReplicaId
+	}
+	return 0
+}
+
+func (x *FetchMissingRequest) GetView() uint64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+func (x *FetchMissingRequest) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *FetchMissingRequest) GetBatchDigest() string {
+	if x != nil {
+		return x.BatchDigest
+	}
+	return ""
+}
+
+func (x *FetchMissingRequest) GetMissingRequestHashes() map[uint64]string {
+	if x != nil {
+		return x.MissingRequestHashes
+	}
+	return nil
+}
+
+type FetchMissingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplicaId            uint64                      `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	View                 uint64                      `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+	SequenceNumber       uint64                      `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	BatchDigest          string                      `protobuf:"bytes,4,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
+	MissingRequestHashes map[uint64]string           `protobuf:"bytes,5,rep,name=missing_request_hashes,json=missingRequestHashes,proto3" json:"missing_request_hashes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MissingRequests      map[uint64][]byte           `protobuf:"bytes,6,rep,name=missing_requests,json=missingRequests,proto3" json:"missing_requests,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Status               FetchMissingResponse_Status `protobuf:"varint,7,opt,name=status,proto3,enum=consensus.FetchMissingResponse_Status" json:"status,omitempty"`
+}
+
+func (x *FetchMissingResponse) Reset() {
+	*x = FetchMissingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchMissingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchMissingResponse) ProtoMessage() {}
+
+func (x *FetchMissingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchMissingResponse.ProtoReflect.Descriptor instead.
+func (*FetchMissingResponse) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FetchMissingResponse) GetReplicaId() uint64 {
+	if x != nil {
+		return x.ReplicaId
+	}
+	return 0
+}
+
+func (x *FetchMissingResponse) GetView() uint64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
+}
+
+func (x *FetchMissingResponse) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *FetchMissingResponse) GetBatchDigest() string {
+	if x != nil {
+		return x.BatchDigest
+	}
+	return ""
+}
+
+func (x *FetchMissingResponse) GetMissingRequestHashes() map[uint64]string {
+	if x != nil {
+		return x.MissingRequestHashes
+	}
+	return nil
+}
+
+func (x *FetchMissingResponse) GetMissingRequests() map[uint64][]byte {
+	if x != nil {
+		return x.MissingRequests
+	}
+	return nil
+}
+
+func (x *FetchMissingResponse) GetStatus() FetchMissingResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return FetchMissingResponse_Success
+}
+
+type FetchPQCRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplicaId uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	H         uint64 `protobuf:"varint,2,opt,name=h,proto3" json:"h,omitempty"`
+}
+
+func (x *FetchPQCRequest) Reset() {
+	*x = FetchPQCRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchPQCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchPQCRequest) ProtoMessage() {}
+
+func (x *FetchPQCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchPQCRequest.ProtoReflect.Descriptor instead.
+func (*FetchPQCRequest) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FetchPQCRequest) GetReplicaId() uint64 {
+	if x != nil {
+		return x.ReplicaId
+	}
+	return 0
+}
+
+func (x *FetchPQCRequest) GetH() uint64 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+type FetchPQCResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplicaId uint64        `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	PrepreSet []*PrePrepare `protobuf:"bytes,2,rep,name=prepre_set,json=prepreSet,proto3" json:"prepre_set,omitempty"`
+	PreSet    []*Prepare    `protobuf:"bytes,3,rep,name=pre_set,json=preSet,proto3" json:"pre_set,omitempty"`
+	CmtSet    []*Commit     `protobuf:"bytes,4,rep,name=cmt_set,json=cmtSet,proto3" json:"cmt_set,omitempty"`
+}
+
+func (x *FetchPQCResponse) Reset() {
+	*x = FetchPQCResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchPQCResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchPQCResponse) ProtoMessage() {}
+
+func (x *FetchPQCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchPQCResponse.ProtoReflect.Descriptor instead.
+func (*FetchPQCResponse) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FetchPQCResponse) GetReplicaId() uint64 {
+	if x != nil {
+		return x.ReplicaId
+	}
+	return 0
+}
+
+func (x *FetchPQCResponse) GetPrepreSet() []*PrePrepare {
+	if x != nil {
+		return x.PrepreSet
+	}
+	return nil
+}
+
+func (x *FetchPQCResponse) GetPreSet() []*Prepare {
+	if x != nil {
+		return x.PreSet
+	}
+	return nil
+}
+
+func (x *FetchPQCResponse) GetCmtSet() []*Commit {
+	if x != nil {
+		return x.CmtSet
+	}
+	return nil
+}
+
+type SyncState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorP2PNodeId string `protobuf:"bytes,1,opt,name=author_p2p_node_id,json=authorP2pNodeId,proto3" json:"author_p2p_node_id,omitempty"`
+}
+
+func (x *SyncState) Reset() {
+	*x = SyncState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncState) ProtoMessage() {}
+
+func (x *SyncState) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncState.ProtoReflect.Descriptor instead.
+func (*SyncState) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SyncState) GetAuthorP2PNodeId() string {
+	if x != nil {
+		return x.AuthorP2PNodeId
+	}
+	return ""
+}
+
+type SyncStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplicaId        uint64            `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	View             uint64            `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+	SignedCheckpoint *SignedCheckpoint `protobuf:"bytes,3,opt,name=signed_checkpoint,json=signedCheckpoint,proto3" json:"signed_checkpoint,omitempty"`
+}
+
+func (x *SyncStateResponse) Reset() {
+	*x = SyncStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rbft_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStateResponse) ProtoMessage() {}
+
+func (x *SyncStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStateResponse.ProtoReflect.Descriptor instead.
+func (*SyncStateResponse) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SyncStateResponse) GetReplicaId() uint64 {
+	if x != nil {
+		return x.ReplicaId
+	}
+	return 0
+}
+
+func (x *SyncStateResponse) GetView() uint64 {
+	if x != nil {
+		return x.View
+	}
+	return 0
 }
 
-func (x *FetchMissingRequest) GetMissingRequestHashes() map[uint64]string {
+func (x *SyncStateResponse) GetSignedCheckpoint() *SignedCheckpoint {
 	if x != nil {
-		return x.MissingRequestHashes
+		return x.SignedCheckpoint
 	}
 	return nil
 }
 
-type FetchMissingResponse struct {
+// SnapshotManifest describes the state snapshot at a stable checkpoint, which is split into chunks
+// to download in state sync.
+type SnapshotManifest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaId            uint64                      `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	View                 uint64                      `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
-	SequenceNumber       uint64                      `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
-	BatchDigest          string                      `protobuf:"bytes,4,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
-	MissingRequestHashes map[uint64]string           `protobuf:"bytes,5,rep,name=missing_request_hashes,json=missingRequestHashes,proto3" json:"missing_request_hashes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MissingRequests      map[uint64][]byte           `protobuf:"bytes,6,rep,name=missing_requests,json=missingRequests,proto3" json:"missing_requests,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Status               FetchMissingResponse_Status `protobuf:"varint,7,opt,name=status,proto3,enum=consensus.FetchMissingResponse_Status" json:"status,omitempty"`
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Digest string `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	// sha256 hash of each chunk in order.
+	ChunkHashes [][]byte `protobuf:"bytes,3,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// opaque data of application to bind the manifest to the checkpoint, such as state root.
+	Metadata []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *FetchMissingResponse) Reset() {
-	*x = FetchMissingResponse{}
+func (x *SnapshotManifest) Reset() {
+	*x = SnapshotManifest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[21]
+		mi := &file_rbft_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FetchMissingResponse) String() string {
+func (x *SnapshotManifest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchMissingResponse) ProtoMessage() {}
+func (*SnapshotManifest) ProtoMessage() {}
 
-func (x *FetchMissingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[21]
+func (x *SnapshotManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1705,86 +3137,66 @@ func (x *FetchMissingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchMissingResponse.ProtoReflect.Descriptor instead.
-func (*FetchMissingResponse) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *FetchMissingResponse) GetReplicaId() uint64 {
-	if x != nil {
-		return x.ReplicaId
-	}
-	return 0
-}
-
-func (x *FetchMissingResponse) GetView() uint64 {
-	if x != nil {
-		return x.View
-	}
-	return 0
+// Deprecated: Use SnapshotManifest.ProtoReflect.Descriptor instead.
+func (*SnapshotManifest) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *FetchMissingResponse) GetSequenceNumber() uint64 {
+func (x *SnapshotManifest) GetHeight() uint64 {
 	if x != nil {
-		return x.SequenceNumber
+		return x.Height
 	}
 	return 0
 }
 
-func (x *FetchMissingResponse) GetBatchDigest() string {
+func (x *SnapshotManifest) GetDigest() string {
 	if x != nil {
-		return x.BatchDigest
+		return x.Digest
 	}
 	return ""
 }
 
-func (x *FetchMissingResponse) GetMissingRequestHashes() map[uint64]string {
+func (x *SnapshotManifest) GetChunkHashes() [][]byte {
 	if x != nil {
-		return x.MissingRequestHashes
+		return x.ChunkHashes
 	}
 	return nil
 }
 
-func (x *FetchMissingResponse) GetMissingRequests() map[uint64][]byte {
+func (x *SnapshotManifest) GetMetadata() []byte {
 	if x != nil {
-		return x.MissingRequests
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *FetchMissingResponse) GetStatus() FetchMissingResponse_Status {
-	if x != nil {
-		return x.Status
-	}
-	return FetchMissingResponse_Success
-}
-
-type FetchPQCRequest struct {
+type SnapshotManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ReplicaId uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	H         uint64 `protobuf:"varint,2,opt,name=h,proto3" json:"h,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Digest    string `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
 }
 
-func (x *FetchPQCRequest) Reset() {
-	*x = FetchPQCRequest{}
+func (x *SnapshotManifestRequest) Reset() {
+	*x = SnapshotManifestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[22]
+		mi := &file_rbft_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FetchPQCRequest) String() string {
+func (x *SnapshotManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchPQCRequest) ProtoMessage() {}
+func (*SnapshotManifestRequest) ProtoMessage() {}
 
-func (x *FetchPQCRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[22]
+func (x *SnapshotManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1795,53 +3207,58 @@ func (x *FetchPQCRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchPQCRequest.ProtoReflect.Descriptor instead.
-func (*FetchPQCRequest) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use SnapshotManifestRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotManifestRequest) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *FetchPQCRequest) GetReplicaId() uint64 {
+func (x *SnapshotManifestRequest) GetReplicaId() uint64 {
 	if x != nil {
 		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *FetchPQCRequest) GetH() uint64 {
+func (x *SnapshotManifestRequest) GetHeight() uint64 {
 	if x != nil {
-		return x.H
+		return x.Height
 	}
 	return 0
 }
 
-type FetchPQCResponse struct {
+func (x *SnapshotManifestRequest) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+type SnapshotManifestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaId uint64        `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	PrepreSet []*PrePrepare `protobuf:"bytes,2,rep,name=prepre_set,json=prepreSet,proto3" json:"prepre_set,omitempty"`
-	PreSet    []*Prepare    `protobuf:"bytes,3,rep,name=pre_set,json=preSet,proto3" json:"pre_set,omitempty"`
-	CmtSet    []*Commit     `protobuf:"bytes,4,rep,name=cmt_set,json=cmtSet,proto3" json:"cmt_set,omitempty"`
+	ReplicaId uint64            `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	Manifest  *SnapshotManifest `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
 }
 
-func (x *FetchPQCResponse) Reset() {
-	*x = FetchPQCResponse{}
+func (x *SnapshotManifestResponse) Reset() {
+	*x = SnapshotManifestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[23]
+		mi := &file_rbft_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FetchPQCResponse) String() string {
+func (x *SnapshotManifestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchPQCResponse) ProtoMessage() {}
+func (*SnapshotManifestResponse) ProtoMessage() {}
 
-func (x *FetchPQCResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[23]
+func (x *SnapshotManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1852,64 +3269,53 @@ func (x *FetchPQCResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchPQCResponse.ProtoReflect.Descriptor instead.
-func (*FetchPQCResponse) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SnapshotManifestResponse.ProtoReflect.Descriptor instead.
+func (*SnapshotManifestResponse) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *FetchPQCResponse) GetReplicaId() uint64 {
+func (x *SnapshotManifestResponse) GetReplicaId() uint64 {
 	if x != nil {
 		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *FetchPQCResponse) GetPrepreSet() []*PrePrepare {
-	if x != nil {
-		return x.PrepreSet
-	}
-	return nil
-}
-
-func (x *FetchPQCResponse) GetPreSet() []*Prepare {
-	if x != nil {
-		return x.PreSet
-	}
-	return nil
-}
-
-func (x *FetchPQCResponse) GetCmtSet() []*Commit {
+func (x *SnapshotManifestResponse) GetManifest() *SnapshotManifest {
 	if x != nil {
-		return x.CmtSet
+		return x.Manifest
 	}
 	return nil
 }
 
-type SyncState struct {
+type SnapshotChunkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthorP2PNodeId string `protobuf:"bytes,1,opt,name=author_p2p_node_id,json=authorP2pNodeId,proto3" json:"author_p2p_node_id,omitempty"`
+	ReplicaId uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Digest    string `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	Index     uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *SyncState) Reset() {
-	*x = SyncState{}
+func (x *SnapshotChunkRequest) Reset() {
+	*x = SnapshotChunkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[24]
+		mi := &file_rbft_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncState) String() string {
+func (x *SnapshotChunkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncState) ProtoMessage() {}
+func (*SnapshotChunkRequest) ProtoMessage() {}
 
-func (x *SyncState) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[24]
+func (x *SnapshotChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1920,45 +3326,68 @@ func (x *SyncState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncState.ProtoReflect.Descriptor instead.
-func (*SyncState) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SnapshotChunkRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotChunkRequest) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SyncState) GetAuthorP2PNodeId() string {
+func (x *SnapshotChunkRequest) GetReplicaId() uint64 {
 	if x != nil {
-		return x.AuthorP2PNodeId
+		return x.ReplicaId
+	}
+	return 0
+}
+
+func (x *SnapshotChunkRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SnapshotChunkRequest) GetDigest() string {
+	if x != nil {
+		return x.Digest
 	}
 	return ""
 }
 
-type SyncStateResponse struct {
+func (x *SnapshotChunkRequest) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type SnapshotChunkResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaId        uint64            `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
-	View             uint64            `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
-	SignedCheckpoint *SignedCheckpoint `protobuf:"bytes,3,opt,name=signed_checkpoint,json=signedCheckpoint,proto3" json:"signed_checkpoint,omitempty"`
+	ReplicaId uint64 `protobuf:"varint,1,opt,name=replica_id,json=replicaId,proto3" json:"replica_id,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Digest    string `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	Index     uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Chunk     []byte `protobuf:"bytes,5,opt,name=chunk,proto3" json:"chunk,omitempty"`
 }
 
-func (x *SyncStateResponse) Reset() {
-	*x = SyncStateResponse{}
+func (x *SnapshotChunkResponse) Reset() {
+	*x = SnapshotChunkResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[25]
+		mi := &file_rbft_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncStateResponse) String() string {
+func (x *SnapshotChunkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncStateResponse) ProtoMessage() {}
+func (*SnapshotChunkResponse) ProtoMessage() {}
 
-func (x *SyncStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[25]
+func (x *SnapshotChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rbft_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1969,28 +3398,42 @@ func (x *SyncStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncStateResponse.ProtoReflect.Descriptor instead.
-func (*SyncStateResponse) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SnapshotChunkResponse.ProtoReflect.Descriptor instead.
+func (*SnapshotChunkResponse) Descriptor() ([]byte, []int) {
+	return file_rbft_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SyncStateResponse) GetReplicaId() uint64 {
+func (x *SnapshotChunkResponse) GetReplicaId() uint64 {
 	if x != nil {
 		return x.ReplicaId
 	}
 	return 0
 }
 
-func (x *SyncStateResponse) GetView() uint64 {
+func (x *SnapshotChunkResponse) GetHeight() uint64 {
 	if x != nil {
-		return x.View
+		return x.Height
 	}
 	return 0
 }
 
-func (x *SyncStateResponse) GetSignedCheckpoint() *SignedCheckpoint {
+func (x *SnapshotChunkResponse) GetDigest() string {
 	if x != nil {
-		return x.SignedCheckpoint
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *SnapshotChunkResponse) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SnapshotChunkResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
 	}
 	return nil
 }
@@ -2013,7 +3456,7 @@ type EpochChangeRequest struct {
 func (x *EpochChangeRequest) Reset() {
 	*x = EpochChangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[26]
+		mi := &file_rbft_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2026,7 +3469,7 @@ func (x *EpochChangeRequest) String() string {
 func (*EpochChangeRequest) ProtoMessage() {}
 
 func (x *EpochChangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[26]
+	mi := &file_rbft_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2039,7 +3482,7 @@ func (x *EpochChangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpochChangeRequest.ProtoReflect.Descriptor instead.
 func (*EpochChangeRequest) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{26}
+	return file_rbft_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *EpochChangeRequest) GetAuthor() uint64 {
@@ -2081,7 +3524,7 @@ type Pset struct {
 func (x *Pset) Reset() {
 	*x = Pset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[27]
+		mi := &file_rbft_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2094,7 +3537,7 @@ func (x *Pset) String() string {
 func (*Pset) ProtoMessage() {}
 
 func (x *Pset) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[27]
+	mi := &file_rbft_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2107,7 +3550,7 @@ func (x *Pset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pset.ProtoReflect.Descriptor instead.
 func (*Pset) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{27}
+	return file_rbft_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *Pset) GetSet() []*Prepare {
@@ -2128,7 +3571,7 @@ type Cset struct {
 func (x *Cset) Reset() {
 	*x = Cset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[28]
+		mi := &file_rbft_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2141,7 +3584,7 @@ func (x *Cset) String() string {
 func (*Cset) ProtoMessage() {}
 
 func (x *Cset) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[28]
+	mi := &file_rbft_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2154,7 +3597,7 @@ func (x *Cset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cset.ProtoReflect.Descriptor instead.
 func (*Cset) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{28}
+	return file_rbft_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *Cset) GetSet() []*Commit {
@@ -2173,12 +3616,18 @@ type Checkpoint struct {
 	ExecuteState    *Checkpoint_ExecuteState `protobuf:"bytes,2,opt,name=execute_state,json=executeState,proto3" json:"execute_state,omitempty"`
 	NeedUpdateEpoch bool                     `protobuf:"varint,3,opt,name=need_update_epoch,json=needUpdateEpoch,proto3" json:"need_update_epoch,omitempty"`
 	ViewChange      *ViewChange              `protobuf:"bytes,4,opt,name=view_change,json=viewChange,proto3" json:"view_change,omitempty"`
+	// consensus keys of validators which have rotated keys, including rotations ordered before this
+	// checkpoint, which take effect once this checkpoint becomes stable.
+	ValidatorKeys []*ValidatorInfo `protobuf:"bytes,5,rep,name=validator_keys,json=validatorKeys,proto3" json:"validator_keys,omitempty"`
+	// timeout penalties of validators carried into the next epoch, only set in the checkpoint of epoch
+	// change if reputation proposer election is used.
+	ReputationPenalties []*NodeDynamicInfo `protobuf:"bytes,6,rep,name=reputation_penalties,json=reputationPenalties,proto3" json:"reputation_penalties,omitempty"`
 }
 
 func (x *Checkpoint) Reset() {
 	*x = Checkpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[29]
+		mi := &file_rbft_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2191,7 +3640,7 @@ func (x *Checkpoint) String() string {
 func (*Checkpoint) ProtoMessage() {}
 
 func (x *Checkpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[29]
+	mi := &file_rbft_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2204,7 +3653,7 @@ func (x *Checkpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Checkpoint.ProtoReflect.Descriptor instead.
 func (*Checkpoint) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{29}
+	return file_rbft_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *Checkpoint) GetEpoch() uint64 {
@@ -2235,6 +3684,20 @@ func (x *Checkpoint) GetViewChange() *ViewChange {
 	return nil
 }
 
+func (x *Checkpoint) GetValidatorKeys() []*ValidatorInfo {
+	if x != nil {
+		return x.ValidatorKeys
+	}
+	return nil
+}
+
+func (x *Checkpoint) GetReputationPenalties() []*NodeDynamicInfo {
+	if x != nil {
+		return x.ReputationPenalties
+	}
+	return nil
+}
+
 // SignedCheckpoint contains the actual checkpoint with signature
 type SignedCheckpoint struct {
 	state         protoimpl.MessageState
@@ -2252,7 +3715,7 @@ type SignedCheckpoint struct {
 func (x *SignedCheckpoint) Reset() {
 	*x = SignedCheckpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[30]
+		mi := &file_rbft_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2265,7 +3728,7 @@ func (x *SignedCheckpoint) String() string {
 func (*SignedCheckpoint) ProtoMessage() {}
 
 func (x *SignedCheckpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[30]
+	mi := &file_rbft_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2278,7 +3741,7 @@ func (x *SignedCheckpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignedCheckpoint.ProtoReflect.Descriptor instead.
 func (*SignedCheckpoint) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{30}
+	return file_rbft_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *SignedCheckpoint) GetCheckpoint() *Checkpoint {
@@ -2309,12 +3772,16 @@ type ValidatorInfo struct {
 
 	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	P2PId string `protobuf:"bytes,2,opt,name=p2p_id,json=p2pId,proto3" json:"p2p_id,omitempty"`
+	// version of the consensus key, 0 is the initial key verified by Crypto.Verify
+	KeyVersion uint64 `protobuf:"varint,3,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	// public key of key_version, empty for the initial key
+	PubKey []byte `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 }
 
 func (x *ValidatorInfo) Reset() {
 	*x = ValidatorInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[31]
+		mi := &file_rbft_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2327,7 +3794,7 @@ func (x *ValidatorInfo) String() string {
 func (*ValidatorInfo) ProtoMessage() {}
 
 func (x *ValidatorInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[31]
+	mi := &file_rbft_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2340,7 +3807,7 @@ func (x *ValidatorInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidatorInfo.ProtoReflect.Descriptor instead.
 func (*ValidatorInfo) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{31}
+	return file_rbft_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ValidatorInfo) GetId() uint64 {
@@ -2357,6 +3824,20 @@ func (x *ValidatorInfo) GetP2PId() string {
 	return ""
 }
 
+func (x *ValidatorInfo) GetKeyVersion() uint64 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+func (x *ValidatorInfo) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
 // QuorumCheckpoint contains the actual checkpoint with signatures
 // by different quorum validators
 type QuorumCheckpoint struct {
@@ -2374,7 +3855,7 @@ type QuorumCheckpoint struct {
 func (x *QuorumCheckpoint) Reset() {
 	*x = QuorumCheckpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[32]
+		mi := &file_rbft_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2387,7 +3868,7 @@ func (x *QuorumCheckpoint) String() string {
 func (*QuorumCheckpoint) ProtoMessage() {}
 
 func (x *QuorumCheckpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[32]
+	mi := &file_rbft_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2400,7 +3881,7 @@ func (x *QuorumCheckpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuorumCheckpoint.ProtoReflect.Descriptor instead.
 func (*QuorumCheckpoint) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{32}
+	return file_rbft_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *QuorumCheckpoint) GetCheckpoint() *Checkpoint {
@@ -2443,7 +3924,7 @@ type EpochChangeProof struct {
 func (x *EpochChangeProof) Reset() {
 	*x = EpochChangeProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[33]
+		mi := &file_rbft_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2456,7 +3937,7 @@ func (x *EpochChangeProof) String() string {
 func (*EpochChangeProof) ProtoMessage() {}
 
 func (x *EpochChangeProof) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[33]
+	mi := &file_rbft_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2469,7 +3950,7 @@ func (x *EpochChangeProof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpochChangeProof.ProtoReflect.Descriptor instead.
 func (*EpochChangeProof) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{33}
+	return file_rbft_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *EpochChangeProof) GetEpochChanges() []*EpochChange {
@@ -2516,7 +3997,7 @@ type EpochChange struct {
 func (x *EpochChange) Reset() {
 	*x = EpochChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[34]
+		mi := &file_rbft_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2529,7 +4010,7 @@ func (x *EpochChange) String() string {
 func (*EpochChange) ProtoMessage() {}
 
 func (x *EpochChange) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[34]
+	mi := &file_rbft_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2542,7 +4023,7 @@ func (x *EpochChange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpochChange.ProtoReflect.Descriptor instead.
 func (*EpochChange) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{34}
+	return file_rbft_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *EpochChange) GetCheckpoint() *QuorumCheckpoint {
@@ -2577,7 +4058,7 @@ type QuorumValidators struct {
 func (x *QuorumValidators) Reset() {
 	*x = QuorumValidators{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[35]
+		mi := &file_rbft_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2590,7 +4071,7 @@ func (x *QuorumValidators) String() string {
 func (*QuorumValidators) ProtoMessage() {}
 
 func (x *QuorumValidators) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[35]
+	mi := &file_rbft_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2603,7 +4084,7 @@ func (x *QuorumValidators) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuorumValidators.ProtoReflect.Descriptor instead.
 func (*QuorumValidators) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{35}
+	return file_rbft_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *QuorumValidators) GetValidators() []*QuorumValidator {
@@ -2625,7 +4106,7 @@ type QuorumValidator struct {
 func (x *QuorumValidator) Reset() {
 	*x = QuorumValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[36]
+		mi := &file_rbft_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2638,7 +4119,7 @@ func (x *QuorumValidator) String() string {
 func (*QuorumValidator) ProtoMessage() {}
 
 func (x *QuorumValidator) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[36]
+	mi := &file_rbft_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2651,7 +4132,7 @@ func (x *QuorumValidator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuorumValidator.ProtoReflect.Descriptor instead.
 func (*QuorumValidator) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{36}
+	return file_rbft_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *QuorumValidator) GetId() uint64 {
@@ -2677,12 +4158,14 @@ type Checkpoint_ExecuteState struct {
 	Height      uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Digest      string `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
 	BatchDigest string `protobuf:"bytes,3,opt,name=batch_digest,json=batchDigest,proto3" json:"batch_digest,omitempty"`
+	// VRF output of the proposer of executed block, only set if VRF seed is enabled.
+	VrfOutput []byte `protobuf:"bytes,4,opt,name=vrf_output,json=vrfOutput,proto3" json:"vrf_output,omitempty"`
 }
 
 func (x *Checkpoint_ExecuteState) Reset() {
 	*x = Checkpoint_ExecuteState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rbft_proto_msgTypes[40]
+		mi := &file_rbft_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2695,7 +4178,7 @@ func (x *Checkpoint_ExecuteState) String() string {
 func (*Checkpoint_ExecuteState) ProtoMessage() {}
 
 func (x *Checkpoint_ExecuteState) ProtoReflect() protoreflect.Message {
-	mi := &file_rbft_proto_msgTypes[40]
+	mi := &file_rbft_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2708,7 +4191,7 @@ func (x *Checkpoint_ExecuteState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Checkpoint_ExecuteState.ProtoReflect.Descriptor instead.
 func (*Checkpoint_ExecuteState) Descriptor() ([]byte, []int) {
-	return file_rbft_proto_rawDescGZIP(), []int{29, 0}
+	return file_rbft_proto_rawDescGZIP(), []int{46, 0}
 }
 
 func (x *Checkpoint_ExecuteState) GetHeight() uint64 {
@@ -2732,11 +4215,18 @@ func (x *Checkpoint_ExecuteState) GetBatchDigest() string {
 	return ""
 }
 
+func (x *Checkpoint_ExecuteState) GetVrfOutput() []byte {
+	if x != nil {
+		return x.VrfOutput
+	}
+	return nil
+}
+
 var File_rbft_proto protoreflect.FileDescriptor
 
 var file_rbft_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x72, 0x62, 0x66, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x63, 0x6f,
-	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x73,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x22, 0xdc, 0x02, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x73,
 	0x65, 0x6e, 0x73, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x04,
 	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6e,
 	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
@@ -2747,422 +4237,661 @@ var file_rbft_proto_rawDesc = []byte{
 	0x69, 0x65, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12,
 	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
 	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22,
-	0x2c, 0x0a, 0x0b, 0x4e, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x22, 0xc0, 0x01,
-	0x0a, 0x0a, 0x50, 0x72, 0x65, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a,
+	0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2c, 0x0a, 0x0b, 0x4e, 0x75, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x49, 0x64, 0x22, 0xfd, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x09, 0x68, 0x61,
+	0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3b, 0x0a, 0x10, 0x62, 0x66, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0e, 0x62, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xc3, 0x01,
+	0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x8d, 0x01, 0x0a, 0x0f, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x08, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x0a, 0x48, 0x6f, 0x74,
+	0x53, 0x74, 0x75, 0x66, 0x66, 0x51, 0x43, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x45, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x2e, 0x48, 0x6f, 0x74, 0x53, 0x74, 0x75, 0x66, 0x66, 0x51, 0x43, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x95, 0x02, 0x0a, 0x0d, 0x48, 0x6f, 0x74,
+	0x53, 0x74, 0x75, 0x66, 0x66, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x07, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x2e, 0x48, 0x6f, 0x74, 0x53, 0x74, 0x75, 0x66, 0x66, 0x51, 0x43, 0x52,
+	0x07, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
 	0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x68,
-	0x61, 0x73, 0x68, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x61, 0x73, 0x68, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68,
 	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x09, 0x68, 0x61, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x22, 0x88, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x06,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67, 0x65,
-	0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44,
-	0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x15, 0x52, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64,
-	0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52,
-	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x09, 0x48, 0x61,
-	0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x1e, 0x64, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1a, 0x64, 0x65, 0x44,
-	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
-	0x61, 0x73, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x72, 0x22, 0x59, 0x0a, 0x0f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x22, 0x61, 0x0a, 0x10, 0x48, 0x6f, 0x74, 0x53, 0x74, 0x75, 0x66, 0x66, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x48,
+	0x6f, 0x74, 0x53, 0x74, 0x75, 0x66, 0x66, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x22, 0x96, 0x01, 0x0a, 0x0c, 0x48, 0x6f, 0x74, 0x53, 0x74, 0x75, 0x66, 0x66,
+	0x56, 0x6f, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x74, 0x0a, 0x0f,
+	0x48, 0x6f, 0x74, 0x53, 0x74, 0x75, 0x66, 0x66, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x2e, 0x0a, 0x07, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x71, 0x63, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e,
+	0x48, 0x6f, 0x74, 0x53, 0x74, 0x75, 0x66, 0x66, 0x51, 0x43, 0x52, 0x06, 0x68, 0x69, 0x67, 0x68,
+	0x51, 0x63, 0x22, 0x52, 0x0a, 0x12, 0x48, 0x6f, 0x74, 0x53, 0x74, 0x75, 0x66, 0x66, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x6b, 0x0a, 0x1a, 0x48, 0x6f, 0x74, 0x53, 0x74, 0x75,
+	0x66, 0x66, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x8e, 0x01, 0x0a,
-	0x0a, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x62,
-	0x61, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e,
-	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x56, 0x63, 0x42, 0x61, 0x73, 0x69, 0x73, 0x52, 0x05,
-	0x62, 0x61, 0x73, 0x69, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x22, 0x46, 0x0a,
-	0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69,
-	0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x98, 0x03, 0x0a, 0x07, 0x56, 0x63, 0x42, 0x61, 0x73, 0x69,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18,
+	0x61, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x48,
+	0x6f, 0x74, 0x53, 0x74, 0x75, 0x66, 0x66, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0a, 0x15, 0x52, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0xcf, 0x02, 0x0a, 0x09, 0x48, 0x61, 0x73, 0x68,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x42, 0x0a, 0x1e, 0x64, 0x65, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1a, 0x64, 0x65, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12,
+	0x1b, 0x0a, 0x09, 0x76, 0x72, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x3b, 0x0a, 0x0d,
+	0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e,
+	0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6b, 0x65, 0x79,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x66, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x66, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x0f, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x22, 0xb7, 0x01, 0x0a, 0x0a, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x56,
+	0x63, 0x42, 0x61, 0x73, 0x69, 0x73, 0x52, 0x05, 0x62, 0x61, 0x73, 0x69, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x8a,
+	0x01, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64,
 	0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
-	0x76, 0x69, 0x65, 0x77, 0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x01, 0x68, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x56, 0x63, 0x50,
-	0x71, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x71, 0x73, 0x65, 0x74, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
-	0x73, 0x2e, 0x56, 0x63, 0x50, 0x71, 0x52, 0x04, 0x71, 0x73, 0x65, 0x74, 0x12, 0x2f, 0x0a, 0x04,
-	0x63, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e,
-	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x63, 0x73, 0x65, 0x74, 0x12, 0x6b, 0x0a,
-	0x25, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
-	0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x79, 0x6e,
-	0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x20, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x52,
-	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44,
-	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x64, 0x0a, 0x21, 0x69, 0x66,
-	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0b,
+	0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65,
+	0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x6b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0xea, 0x01, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6b,
+	0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72,
+	0x65, 0x76, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c,
+	0x70, 0x72, 0x65, 0x76, 0x5f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x65, 0x76, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x30, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22,
+	0x46, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
 	0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x1d, 0x69, 0x66, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
-	0x22, 0x66, 0x0a, 0x04, 0x56, 0x63, 0x50, 0x71, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69,
-	0x67, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x6b, 0x0a, 0x10, 0x51, 0x75, 0x6f, 0x72,
-	0x75, 0x6d, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x0c, 0x76,
-	0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x56, 0x69,
-	0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x76, 0x69, 0x65, 0x77, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x79,
-	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f, 0x6e,
-	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65,
-	0x6e, 0x73, 0x75, 0x73, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12,
-	0x43, 0x0a, 0x1e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x76, 0x6f, 0x74,
-	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x64, 0x75, 0x63, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
-	0x75, 0x73, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x64,
-	0x75, 0x63, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x22, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
-	0x73, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x72,
-	0x65, 0x64, 0x75, 0x63, 0x65, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x1e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x56, 0x6f, 0x74, 0x69, 0x6e,
-	0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x56, 0x69, 0x65, 0x77,
-	0x22, 0xa3, 0x03, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x12, 0x1d, 0x0a, 0x0a,
-	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x23, 0x0a, 0x04, 0x78, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x56, 0x63, 0x50, 0x71, 0x52, 0x04,
-	0x78, 0x73, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d,
-	0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0d, 0x76, 0x69, 0x65, 0x77,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x74, 0x12, 0x48, 0x0a, 0x11, 0x71, 0x75, 0x6f,
-	0x72, 0x75, 0x6d, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x10, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75,
-	0x74, 0x6f, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x54, 0x65, 0x72, 0x6d, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x50, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x79, 0x6e, 0x61, 0x6d,
-	0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3e, 0x0a, 0x09, 0x46, 0x65, 0x74, 0x63, 0x68, 0x56,
-	0x69, 0x65, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69,
+	0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x98, 0x03, 0x0a, 0x07, 0x56, 0x63, 0x42, 0x61,
+	0x73, 0x69, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
 	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0xbf, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x6e,
-	0x65, 0x77, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65,
-	0x77, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x12, 0x4a, 0x0a, 0x12, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
-	0x75, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63,
-	0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x01, 0x68, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x56,
+	0x63, 0x50, 0x71, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x71, 0x73, 0x65,
+	0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x2e, 0x56, 0x63, 0x50, 0x71, 0x52, 0x04, 0x71, 0x73, 0x65, 0x74, 0x12, 0x2f,
+	0x0a, 0x04, 0x63, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x63, 0x73, 0x65, 0x74, 0x12,
+	0x6b, 0x0a, 0x25, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x20, 0x69, 0x66, 0x4e, 0x6f,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x64, 0x0a, 0x21,
+	0x69, 0x66, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x1d, 0x69, 0x66, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x66, 0x0a, 0x04, 0x56, 0x63, 0x50, 0x71, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x6b, 0x0a, 0x10, 0x51, 0x75,
+	0x6f, 0x72, 0x75, 0x6d, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x38, 0x0a,
+	0x0c, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e,
+	0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x76, 0x69, 0x65, 0x77,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x97, 0x02, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65,
+	0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x43, 0x0a, 0x1e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x64, 0x75,
+	0x63, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52,
+	0x65, 0x64, 0x75, 0x63, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x22, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x64, 0x75, 0x63, 0x65, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x1e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x56, 0x6f, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x56, 0x69,
+	0x65, 0x77, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x22, 0xa3, 0x03, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x12, 0x1d, 0x0a,
 	0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22,
-	0x85, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69,
-	0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x6f, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x65, 0x71, 0x4e, 0x6f, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x08,
-	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x22, 0xcd, 0x02, 0x0a, 0x13, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x23, 0x0a, 0x04, 0x78, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x56, 0x63, 0x50, 0x71, 0x52,
+	0x04, 0x78, 0x73, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0d, 0x76, 0x69, 0x65,
+	0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x74, 0x12, 0x48, 0x0a, 0x11, 0x71, 0x75,
+	0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x10, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x61,
+	0x75, 0x74, 0x6f, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x54, 0x65, 0x72, 0x6d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x50, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3e, 0x0a, 0x09, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x56, 0x69, 0x65, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0xbf, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08,
+	0x6e, 0x65, 0x77, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x12, 0x4a, 0x0a, 0x12, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x11, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
 	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65,
-	0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61,
-	0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x6e, 0x0a,
-	0x16, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e,
-	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d,
-	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x69,
+	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x22, 0x85, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x80, 0x03, 0x0a, 0x0c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x65, 0x71, 0x4e, 0x6f, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x08, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x72, 0x66, 0x5f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x72, 0x66, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x72, 0x66, 0x5f, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x76, 0x72, 0x66, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x62, 0x66, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x62, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xcd, 0x02, 0x0a, 0x13,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x12, 0x6e, 0x0a, 0x16, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x69,
 	0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x1a, 0x47, 0x0a,
-	0x19, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
-	0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd8, 0x04, 0x0a, 0x14, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69,
-	0x65, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x6f,
-	0x0a, 0x16, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39,
-	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61,
-	0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
-	0x5f, 0x0a, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x73, 0x73, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x46, 0x65, 0x74,
-	0x63, 0x68, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x4d, 0x69, 0x73,
-	0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x22, 0x0a,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x10,
-	0x01, 0x22, 0x3e, 0x0a, 0x0f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x51, 0x43, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x49, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x01,
-	0x68, 0x22, 0xc0, 0x01, 0x0a, 0x10, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x51, 0x43, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x72, 0x65, 0x5f,
-	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
-	0x52, 0x09, 0x70, 0x72, 0x65, 0x70, 0x72, 0x65, 0x53, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x70,
-	0x72, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
-	0x52, 0x06, 0x70, 0x72, 0x65, 0x53, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6d, 0x74, 0x5f,
-	0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6d,
-	0x74, 0x53, 0x65, 0x74, 0x22, 0x38, 0x0a, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x32, 0x70, 0x5f,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x50, 0x32, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x90,
-	0x01, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x65, 0x73, 0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd8, 0x04, 0x0a, 0x14,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
 	0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x48, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x10, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x22, 0x9d, 0x01, 0x0a, 0x12, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x70,
-	0x32, 0x70, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x50, 0x32, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x49,
-	0x64, 0x22, 0x2c, 0x0a, 0x04, 0x50, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x73, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
-	0x75, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x03, 0x73, 0x65, 0x74, 0x22,
-	0x2b, 0x0a, 0x04, 0x43, 0x73, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x03, 0x73, 0x65, 0x74, 0x22, 0xb2, 0x02, 0x0a,
-	0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x12, 0x47, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65,
-	0x6e, 0x73, 0x75, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65,
-	0x65, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e, 0x65, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x0b, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f,
-	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x61,
-	0x0a, 0x0c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x12, 0x6f, 0x0a, 0x16, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42,
+	0x0a, 0x14, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x22, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x10, 0x01, 0x22, 0x3e, 0x0a, 0x0f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50,
+	0x51, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x01, 0x68, 0x22, 0xc0, 0x01, 0x0a, 0x10, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x50, 0x51, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x72,
+	0x65, 0x70, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x09, 0x70, 0x72, 0x65, 0x70, 0x72, 0x65, 0x53, 0x65, 0x74,
+	0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x06, 0x70, 0x72, 0x65, 0x53, 0x65, 0x74, 0x12, 0x2a, 0x0a,
+	0x07, 0x63, 0x6d, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x06, 0x63, 0x6d, 0x74, 0x53, 0x65, 0x74, 0x22, 0x38, 0x0a, 0x09, 0x53, 0x79, 0x6e,
+	0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x5f, 0x70, 0x32, 0x70, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x50, 0x32, 0x70, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x48, 0x0a, 0x11,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x68, 0x0a, 0x17, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x22, 0x72, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12,
+	0x37, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x08,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x14, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x92, 0x01, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
 	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67, 0x65, 0x73,
-	0x74, 0x22, 0x7f, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0x36, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x32, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x32, 0x70, 0x49, 0x64, 0x22, 0x84, 0x03, 0x0a, 0x10, 0x51,
-	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
-	0x35, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6e,
-	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x5f, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6e,
-	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x1a, 0x3d, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x59, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x3b, 0x0a, 0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
-	0x30, 0x0a, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x67,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69, 0x67, 0x65, 0x73,
-	0x74, 0x22, 0xac, 0x01, 0x0a, 0x0b, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
-	0x73, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3b,
-	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x51,
-	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52,
-	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c,
-	0x6f, 0x77, 0x5f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x6c, 0x6f, 0x77, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b,
-	0x22, 0x4e, 0x0a, 0x10, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65,
-	0x6e, 0x73, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
-	0x22, 0x39, 0x0a, 0x0f, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x2a, 0xde, 0x03, 0x0a, 0x04,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x51,
-	0x55, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x45, 0x5f, 0x50, 0x52,
-	0x45, 0x50, 0x41, 0x52, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x45, 0x50, 0x41,
-	0x52, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x03,
-	0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f,
-	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x04, 0x12, 0x15, 0x0a,
-	0x11, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49,
-	0x4e, 0x54, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x49,
-	0x45, 0x57, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x51,
-	0x55, 0x4f, 0x52, 0x55, 0x4d, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
-	0x45, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x45, 0x57, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10,
-	0x09, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10,
-	0x0a, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x52, 0x45,
-	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x0b, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x45, 0x54, 0x43,
-	0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10,
-	0x0c, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48,
-	0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x0d, 0x12, 0x15, 0x0a, 0x11, 0x46,
-	0x45, 0x54, 0x43, 0x48, 0x5f, 0x50, 0x51, 0x43, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
-	0x10, 0x0e, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x50, 0x51, 0x43, 0x5f,
-	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x0f, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x45,
-	0x54, 0x43, 0x48, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55,
-	0x45, 0x53, 0x54, 0x10, 0x10, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x4d,
-	0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10,
-	0x11, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10,
-	0x12, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x13, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x50,
-	0x4f, 0x43, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
-	0x53, 0x54, 0x10, 0x14, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x5f, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x10, 0x15, 0x42, 0x0e, 0x5a, 0x0c,
-	0x2e, 0x2e, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x9d, 0x01, 0x0a, 0x12, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2b, 0x0a,
+	0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x32, 0x70, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x50, 0x32, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x04, 0x50, 0x73,
+	0x65, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x52, 0x03, 0x73, 0x65, 0x74, 0x22, 0x2b, 0x0a, 0x04, 0x43, 0x73, 0x65, 0x74,
+	0x12, 0x23, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x03, 0x73, 0x65, 0x74, 0x22, 0xe2, 0x03, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x47, 0x0a, 0x0d, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x6e, 0x65, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x36, 0x0a, 0x0b, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x2e, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x76, 0x69, 0x65,
+	0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x4d, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x13, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x80, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76,
+	0x72, 0x66, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x76, 0x72, 0x66, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x7f, 0x0a, 0x10, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x35,
+	0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x70, 0x0a, 0x0d, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x70, 0x32, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x32,
+	0x70, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x84, 0x03,
+	0x0a, 0x10, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x1a, 0x3d, 0x0a, 0x0f, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x59, 0x0a, 0x11, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x3b, 0x0a, 0x0d, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x0b, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x6c, 0x6f, 0x77, 0x5f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x6f, 0x77, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d,
+	0x61, 0x72, 0x6b, 0x22, 0x4e, 0x0a, 0x10, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x22, 0x39, 0x0a, 0x0f, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x2a, 0xa7,
+	0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x55, 0x4c, 0x4c, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x45,
+	0x5f, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52,
+	0x45, 0x50, 0x41, 0x52, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x49,
+	0x54, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41,
+	0x53, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x04,
+	0x12, 0x15, 0x0a, 0x11, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x45, 0x54, 0x43, 0x48,
+	0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x0f, 0x0a,
+	0x0b, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x07, 0x12, 0x16,
+	0x0a, 0x12, 0x51, 0x55, 0x4f, 0x52, 0x55, 0x4d, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x45, 0x57, 0x5f, 0x56, 0x49,
+	0x45, 0x57, 0x10, 0x09, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x56, 0x49,
+	0x45, 0x57, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59,
+	0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x0b, 0x12, 0x17, 0x0a, 0x13, 0x46,
+	0x45, 0x54, 0x43, 0x48, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x10, 0x0c, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x42, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x0d, 0x12, 0x15,
+	0x0a, 0x11, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x50, 0x51, 0x43, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x10, 0x0e, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x50,
+	0x51, 0x43, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x0f, 0x12, 0x19, 0x0a,
+	0x15, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x10, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x45, 0x54, 0x43,
+	0x48, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x53, 0x45, 0x10, 0x11, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x10, 0x12, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x13, 0x12, 0x18, 0x0a,
+	0x14, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x14, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x50, 0x4f, 0x43, 0x48,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x10, 0x15, 0x12,
+	0x13, 0x0a, 0x0f, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x45, 0x52, 0x10, 0x16, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54,
+	0x45, 0x44, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x53, 0x10, 0x17, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x4f,
+	0x54, 0x53, 0x54, 0x55, 0x46, 0x46, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x10,
+	0x18, 0x12, 0x11, 0x0a, 0x0d, 0x48, 0x4f, 0x54, 0x53, 0x54, 0x55, 0x46, 0x46, 0x5f, 0x56, 0x4f,
+	0x54, 0x45, 0x10, 0x19, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x4f, 0x54, 0x53, 0x54, 0x55, 0x46, 0x46,
+	0x5f, 0x4e, 0x45, 0x57, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x1a, 0x12, 0x14, 0x0a, 0x10, 0x44,
+	0x45, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x10,
+	0x1b, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x4d, 0x41,
+	0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x1c,
+	0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x4d, 0x41, 0x4e,
+	0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x1d,
+	0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x43, 0x48, 0x55,
+	0x4e, 0x4b, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x1e, 0x12, 0x1b, 0x0a, 0x17,
+	0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x5f, 0x52,
+	0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x1f, 0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x45, 0x59,
+	0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x20, 0x12, 0x18, 0x0a, 0x14, 0x48,
+	0x4f, 0x54, 0x53, 0x54, 0x55, 0x46, 0x46, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x42, 0x4c,
+	0x4f, 0x43, 0x4b, 0x10, 0x21, 0x12, 0x21, 0x0a, 0x1d, 0x48, 0x4f, 0x54, 0x53, 0x54, 0x55, 0x46,
+	0x46, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x22, 0x2a, 0x3c, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x53, 0x54, 0x44, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x4e, 0x41, 0x50, 0x50, 0x59, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x46,
+	0x4c, 0x41, 0x54, 0x45, 0x10, 0x03, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2e, 0x2f, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3177,99 +4906,135 @@ func file_rbft_proto_rawDescGZIP() []byte {
 	return file_rbft_proto_rawDescData
 }
 
-var file_rbft_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_rbft_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_rbft_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_rbft_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_rbft_proto_goTypes = []interface{}{
-	(Type)(0),                        // 0: consensus.Type
-	(FetchMissingResponse_Status)(0), // 1: consensus.FetchMissingResponse.Status
-	(*ConsensusMessage)(nil),         // 2: consensus.ConsensusMessage
-	(*NullRequest)(nil),              // 3: consensus.NullRequest
-	(*PrePrepare)(nil),               // 4: consensus.PrePrepare
-	(*Prepare)(nil),                  // 5: consensus.Prepare
-	(*Commit)(nil),                   // 6: consensus.Commit
-	(*ReBroadcastRequestSet)(nil),    // 7: consensus.ReBroadcastRequestSet
-	(*HashBatch)(nil),                // 8: consensus.HashBatch
-	(*FetchCheckpoint)(nil),          // 9: consensus.FetchCheckpoint
-	(*ViewChange)(nil),               // 10: consensus.ViewChange
-	(*ValidatorDynamicInfo)(nil),     // 11: consensus.ValidatorDynamicInfo
-	(*VcBasis)(nil),                  // 12: consensus.VcBasis
-	(*VcPq)(nil),                     // 13: consensus.VcPq
-	(*QuorumViewChange)(nil),         // 14: consensus.QuorumViewChange
-	(*NodeDynamicInfo)(nil),          // 15: consensus.NodeDynamicInfo
-	(*NewView)(nil),                  // 16: consensus.NewView
-	(*FetchView)(nil),                // 17: consensus.FetchView
-	(*RecoveryResponse)(nil),         // 18: consensus.RecoveryResponse
-	(*FetchBatchRequest)(nil),        // 19: consensus.FetchBatchRequest
-	(*FetchBatchResponse)(nil),       // 20: consensus.FetchBatchResponse
-	(*RequestBatch)(nil),             // 21: consensus.RequestBatch
-	(*FetchMissingRequest)(nil),      // 22: consensus.FetchMissingRequest
-	(*FetchMissingResponse)(nil),     // 23: consensus.FetchMissingResponse
-	(*FetchPQCRequest)(nil),          // 24: consensus.FetchPQCRequest
-	(*FetchPQCResponse)(nil),         // 25: consensus.FetchPQCResponse
-	(*SyncState)(nil),                // 26: consensus.SyncState
-	(*SyncStateResponse)(nil),        // 27: consensus.SyncStateResponse
-	(*EpochChangeRequest)(nil),       // 28: consensus.EpochChangeRequest
-	(*Pset)(nil),                     // 29: consensus.Pset
-	(*Cset)(nil),                     // 30: consensus.Cset
-	(*Checkpoint)(nil),               // 31: consensus.Checkpoint
-	(*SignedCheckpoint)(nil),         // 32: consensus.SignedCheckpoint
-	(*ValidatorInfo)(nil),            // 33: consensus.ValidatorInfo
-	(*QuorumCheckpoint)(nil),         // 34: consensus.QuorumCheckpoint
-	(*EpochChangeProof)(nil),         // 35: consensus.EpochChangeProof
-	(*EpochChange)(nil),              // 36: consensus.EpochChange
-	(*QuorumValidators)(nil),         // 37: consensus.QuorumValidators
-	(*QuorumValidator)(nil),          // 38: consensus.QuorumValidator
-	nil,                              // 39: consensus.FetchMissingRequest.MissingRequestHashesEntry
-	nil,                              // 40: consensus.FetchMissingResponse.MissingRequestHashesEntry
-	nil,                              // 41: consensus.FetchMissingResponse.MissingRequestsEntry
-	(*Checkpoint_ExecuteState)(nil),  // 42: consensus.Checkpoint.ExecuteState
-	nil,                              // 43: consensus.QuorumCheckpoint.SignaturesEntry
-	nil,                              // 44: consensus.QuorumCheckpoint.ValidatorSetEntry
+	(Type)(0),                          // 0: consensus.Type
+	(CompressionType)(0),               // 1: consensus.CompressionType
+	(FetchMissingResponse_Status)(0),   // 2: consensus.FetchMissingResponse.Status
+	(*ConsensusMessage)(nil),           // 3: consensus.ConsensusMessage
+	(*NullRequest)(nil),                // 4: consensus.NullRequest
+	(*PrePrepare)(nil),                 // 5: consensus.PrePrepare
+	(*Prepare)(nil),                    // 6: consensus.Prepare
+	(*Commit)(nil),                     // 7: consensus.Commit
+	(*AggregatedVotes)(nil),            // 8: consensus.AggregatedVotes
+	(*DecryptionShare)(nil),            // 9: consensus.DecryptionShare
+	(*HotStuffQC)(nil),                 // 10: consensus.HotStuffQC
+	(*HotStuffBlock)(nil),              // 11: consensus.HotStuffBlock
+	(*HotStuffProposal)(nil),           // 12: consensus.HotStuffProposal
+	(*HotStuffVote)(nil),               // 13: consensus.HotStuffVote
+	(*HotStuffNewView)(nil),            // 14: consensus.HotStuffNewView
+	(*HotStuffFetchBlock)(nil),         // 15: consensus.HotStuffFetchBlock
+	(*HotStuffFetchBlockResponse)(nil), // 16: consensus.HotStuffFetchBlockResponse
+	(*ReBroadcastRequestSet)(nil),      // 17: consensus.ReBroadcastRequestSet
+	(*HashBatch)(nil),                  // 18: consensus.HashBatch
+	(*FetchCheckpoint)(nil),            // 19: consensus.FetchCheckpoint
+	(*ViewChange)(nil),                 // 20: consensus.ViewChange
+	(*LeaderTransfer)(nil),             // 21: consensus.LeaderTransfer
+	(*KeyRotation)(nil),                // 22: consensus.KeyRotation
+	(*ValidatorKeyState)(nil),          // 23: consensus.ValidatorKeyState
+	(*ValidatorDynamicInfo)(nil),       // 24: consensus.ValidatorDynamicInfo
+	(*VcBasis)(nil),                    // 25: consensus.VcBasis
+	(*VcPq)(nil),                       // 26: consensus.VcPq
+	(*QuorumViewChange)(nil),           // 27: consensus.QuorumViewChange
+	(*NodeDynamicInfo)(nil),            // 28: consensus.NodeDynamicInfo
+	(*NewView)(nil),                    // 29: consensus.NewView
+	(*FetchView)(nil),                  // 30: consensus.FetchView
+	(*RecoveryResponse)(nil),           // 31: consensus.RecoveryResponse
+	(*FetchBatchRequest)(nil),          // 32: consensus.FetchBatchRequest
+	(*FetchBatchResponse)(nil),         // 33: consensus.FetchBatchResponse
+	(*RequestBatch)(nil),               // 34: consensus.RequestBatch
+	(*FetchMissingRequest)(nil),        // 35: consensus.FetchMissingRequest
+	(*FetchMissingResponse)(nil),       // 36: consensus.FetchMissingResponse
+	(*FetchPQCRequest)(nil),            // 37: consensus.FetchPQCRequest
+	(*FetchPQCResponse)(nil),           // 38: consensus.FetchPQCResponse
+	(*SyncState)(nil),                  // 39: consensus.SyncState
+	(*SyncStateResponse)(nil),          // 40: consensus.SyncStateResponse
+	(*SnapshotManifest)(nil),           // 41: consensus.SnapshotManifest
+	(*SnapshotManifestRequest)(nil),    // 42: consensus.SnapshotManifestRequest
+	(*SnapshotManifestResponse)(nil),   // 43: consensus.SnapshotManifestResponse
+	(*SnapshotChunkRequest)(nil),       // 44: consensus.SnapshotChunkRequest
+	(*SnapshotChunkResponse)(nil),      // 45: consensus.SnapshotChunkResponse
+	(*EpochChangeRequest)(nil),         // 46: consensus.EpochChangeRequest
+	(*Pset)(nil),                       // 47: consensus.Pset
+	(*Cset)(nil),                       // 48: consensus.Cset
+	(*Checkpoint)(nil),                 // 49: consensus.Checkpoint
+	(*SignedCheckpoint)(nil),           // 50: consensus.SignedCheckpoint
+	(*ValidatorInfo)(nil),              // 51: consensus.ValidatorInfo
+	(*QuorumCheckpoint)(nil),           // 52: consensus.QuorumCheckpoint
+	(*EpochChangeProof)(nil),           // 53: consensus.EpochChangeProof
+	(*EpochChange)(nil),                // 54: consensus.EpochChange
+	(*QuorumValidators)(nil),           // 55: consensus.QuorumValidators
+	(*QuorumValidator)(nil),            // 56: consensus.QuorumValidator
+	nil,                                // 57: consensus.HotStuffQC.SignaturesEntry
+	nil,                                // 58: consensus.FetchMissingRequest.MissingRequestHashesEntry
+	nil,                                // 59: consensus.FetchMissingResponse.MissingRequestHashesEntry
+	nil,                                // 60: consensus.FetchMissingResponse.MissingRequestsEntry
+	(*Checkpoint_ExecuteState)(nil),    // 61: consensus.Checkpoint.ExecuteState
+	nil,                                // 62: consensus.QuorumCheckpoint.SignaturesEntry
+	nil,                                // 63: consensus.QuorumCheckpoint.ValidatorSetEntry
 }
 var file_rbft_proto_depIdxs = []int32{
 	0,  // 0: consensus.ConsensusMessage.type:type_name -> consensus.Type
-	8,  // 1: consensus.PrePrepare.hash_batch:type_name -> consensus.HashBatch
-	12, // 2: consensus.ViewChange.basis:type_name -> consensus.VcBasis
-	15, // 3: consensus.ValidatorDynamicInfo.info:type_name -> consensus.NodeDynamicInfo
-	13, // 4: consensus.VcBasis.pset:type_name -> consensus.VcPq
-	13, // 5: consensus.VcBasis.qset:type_name -> consensus.VcPq
-	32, // 6: consensus.VcBasis.cset:type_name -> consensus.SignedCheckpoint
-	15, // 7: consensus.VcBasis.if_not_recover_validator_dynamic_info:type_name -> consensus.NodeDynamicInfo
-	15, // 8: consensus.VcBasis.if_recover_validator_dynamic_info:type_name -> consensus.NodeDynamicInfo
-	10, // 9: consensus.QuorumViewChange.view_changes:type_name -> consensus.ViewChange
-	13, // 10: consensus.NewView.xset:type_name -> consensus.VcPq
-	14, // 11: consensus.NewView.view_change_set:type_name -> consensus.QuorumViewChange
-	34, // 12: consensus.NewView.quorum_checkpoint:type_name -> consensus.QuorumCheckpoint
-	15, // 13: consensus.NewView.validator_dynamic_info:type_name -> consensus.NodeDynamicInfo
-	16, // 14: consensus.RecoveryResponse.new_view:type_name -> consensus.NewView
-	32, // 15: consensus.RecoveryResponse.initial_checkpoint:type_name -> consensus.SignedCheckpoint
-	21, // 16: consensus.FetchBatchResponse.batch:type_name -> consensus.RequestBatch
-	39, // 17: consensus.FetchMissingRequest.missing_request_hashes:type_name -> consensus.FetchMissingRequest.MissingRequestHashesEntry
-	40, // 18: consensus.FetchMissingResponse.missing_request_hashes:type_name -> consensus.FetchMissingResponse.MissingRequestHashesEntry
-	41, // 19: consensus.FetchMissingResponse.missing_requests:type_name -> consensus.FetchMissingResponse.MissingRequestsEntry
-	1,  // 20: consensus.FetchMissingResponse.status:type_name -> consensus.FetchMissingResponse.Status
-	4,  // 21: consensus.FetchPQCResponse.prepre_set:type_name -> consensus.PrePrepare
-	5,  // 22: consensus.FetchPQCResponse.pre_set:type_name -> consensus.Prepare
-	6,  // 23: consensus.FetchPQCResponse.cmt_set:type_name -> consensus.Commit
-	32, // 24: consensus.SyncStateResponse.signed_checkpoint:type_name -> consensus.SignedCheckpoint
-	5,  // 25: consensus.Pset.set:type_name -> consensus.Prepare
-	6,  // 26: consensus.Cset.set:type_name -> consensus.Commit
-	42, // 27: consensus.Checkpoint.execute_state:type_name -> consensus.Checkpoint.ExecuteState
-	10, // 28: consensus.Checkpoint.view_change:type_name -> consensus.ViewChange
-	31, // 29: consensus.SignedCheckpoint.checkpoint:type_name -> consensus.Checkpoint
-	31, // 30: consensus.QuorumCheckpoint.checkpoint:type_name -> consensus.Checkpoint
-	43, // 31: consensus.QuorumCheckpoint.signatures:type_name -> consensus.QuorumCheckpoint.SignaturesEntry
-	44, // 32: consensus.QuorumCheckpoint.validator_set:type_name -> consensus.QuorumCheckpoint.ValidatorSetEntry
-	36, // 33: consensus.EpochChangeProof.epoch_changes:type_name -> consensus.EpochChange
-	34, // 34: consensus.EpochChange.checkpoint:type_name -> consensus.QuorumCheckpoint
-	37, // 35: consensus.EpochChange.validators:type_name -> consensus.QuorumValidators
-	38, // 36: consensus.QuorumValidators.validators:type_name -> consensus.QuorumValidator
-	33, // 37: consensus.QuorumCheckpoint.ValidatorSetEntry.value:type_name -> consensus.ValidatorInfo
-	38, // [38:38] is the sub-list for method output_type
-	38, // [38:38] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
+	1,  // 1: consensus.ConsensusMessage.compression:type_name -> consensus.CompressionType
+	1,  // 2: consensus.ConsensusMessage.accept_compression:type_name -> consensus.CompressionType
+	18, // 3: consensus.PrePrepare.hash_batch:type_name -> consensus.HashBatch
+	7,  // 4: consensus.PrePrepare.bft_time_commits:type_name -> consensus.Commit
+	6,  // 5: consensus.AggregatedVotes.prepares:type_name -> consensus.Prepare
+	7,  // 6: consensus.AggregatedVotes.commits:type_name -> consensus.Commit
+	57, // 7: consensus.HotStuffQC.signatures:type_name -> consensus.HotStuffQC.SignaturesEntry
+	10, // 8: consensus.HotStuffBlock.justify:type_name -> consensus.HotStuffQC
+	18, // 9: consensus.HotStuffBlock.hash_batch:type_name -> consensus.HashBatch
+	11, // 10: consensus.HotStuffProposal.block:type_name -> consensus.HotStuffBlock
+	10, // 11: consensus.HotStuffNewView.high_qc:type_name -> consensus.HotStuffQC
+	11, // 12: consensus.HotStuffFetchBlockResponse.block:type_name -> consensus.HotStuffBlock
+	22, // 13: consensus.HashBatch.key_rotations:type_name -> consensus.KeyRotation
+	25, // 14: consensus.ViewChange.basis:type_name -> consensus.VcBasis
+	22, // 15: consensus.ValidatorKeyState.pending:type_name -> consensus.KeyRotation
+	28, // 16: consensus.ValidatorDynamicInfo.info:type_name -> consensus.NodeDynamicInfo
+	26, // 17: consensus.VcBasis.pset:type_name -> consensus.VcPq
+	26, // 18: consensus.VcBasis.qset:type_name -> consensus.VcPq
+	50, // 19: consensus.VcBasis.cset:type_name -> consensus.SignedCheckpoint
+	28, // 20: consensus.VcBasis.if_not_recover_validator_dynamic_info:type_name -> consensus.NodeDynamicInfo
+	28, // 21: consensus.VcBasis.if_recover_validator_dynamic_info:type_name -> consensus.NodeDynamicInfo
+	20, // 22: consensus.QuorumViewChange.view_changes:type_name -> consensus.ViewChange
+	26, // 23: consensus.NewView.xset:type_name -> consensus.VcPq
+	27, // 24: consensus.NewView.view_change_set:type_name -> consensus.QuorumViewChange
+	52, // 25: consensus.NewView.quorum_checkpoint:type_name -> consensus.QuorumCheckpoint
+	28, // 26: consensus.NewView.validator_dynamic_info:type_name -> consensus.NodeDynamicInfo
+	29, // 27: consensus.RecoveryResponse.new_view:type_name -> consensus.NewView
+	50, // 28: consensus.RecoveryResponse.initial_checkpoint:type_name -> consensus.SignedCheckpoint
+	34, // 29: consensus.FetchBatchResponse.batch:type_name -> consensus.RequestBatch
+	22, // 30: consensus.RequestBatch.key_rotations:type_name -> consensus.KeyRotation
+	58, // 31: consensus.FetchMissingRequest.missing_request_hashes:type_name -> consensus.FetchMissingRequest.MissingRequestHashesEntry
+	59, // 32: consensus.FetchMissingResponse.missing_request_hashes:type_name -> consensus.FetchMissingResponse.MissingRequestHashesEntry
+	60, // 33: consensus.FetchMissingResponse.missing_requests:type_name -> consensus.FetchMissingResponse.MissingRequestsEntry
+	2,  // 34: consensus.FetchMissingResponse.status:type_name -> consensus.FetchMissingResponse.Status
+	5,  // 35: consensus.FetchPQCResponse.prepre_set:type_name -> consensus.PrePrepare
+	6,  // 36: consensus.FetchPQCResponse.pre_set:type_name -> consensus.Prepare
+	7,  // 37: consensus.FetchPQCResponse.cmt_set:type_name -> consensus.Commit
+	50, // 38: consensus.SyncStateResponse.signed_checkpoint:type_name -> consensus.SignedCheckpoint
+	41, // 39: consensus.SnapshotManifestResponse.manifest:type_name -> consensus.SnapshotManifest
+	6,  // 40: consensus.Pset.set:type_name -> consensus.Prepare
+	7,  // 41: consensus.Cset.set:type_name -> consensus.Commit
+	61, // 42: consensus.Checkpoint.execute_state:type_name -> consensus.Checkpoint.ExecuteState
+	20, // 43: consensus.Checkpoint.view_change:type_name -> consensus.ViewChange
+	51, // 44: consensus.Checkpoint.validator_keys:type_name -> consensus.ValidatorInfo
+	28, // 45: consensus.Checkpoint.reputation_penalties:type_name -> consensus.NodeDynamicInfo
+	49, // 46: consensus.SignedCheckpoint.checkpoint:type_name -> consensus.Checkpoint
+	49, // 47: consensus.QuorumCheckpoint.checkpoint:type_name -> consensus.Checkpoint
+	62, // 48: consensus.QuorumCheckpoint.signatures:type_name -> consensus.QuorumCheckpoint.SignaturesEntry
+	63, // 49: consensus.QuorumCheckpoint.validator_set:type_name -> consensus.QuorumCheckpoint.ValidatorSetEntry
+	54, // 50: consensus.EpochChangeProof.epoch_changes:type_name -> consensus.EpochChange
+	52, // 51: consensus.EpochChange.checkpoint:type_name -> consensus.QuorumCheckpoint
+	55, // 52: consensus.EpochChange.validators:type_name -> consensus.QuorumValidators
+	56, // 53: consensus.QuorumValidators.validators:type_name -> consensus.QuorumValidator
+	51, // 54: consensus.QuorumCheckpoint.ValidatorSetEntry.value:type_name -> consensus.ValidatorInfo
+	55, // [55:55] is the sub-list for method output_type
+	55, // [55:55] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_rbft_proto_init() }
@@ -3339,7 +5104,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReBroadcastRequestSet); i {
+			switch v := v.(*AggregatedVotes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3351,7 +5116,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HashBatch); i {
+			switch v := v.(*DecryptionShare); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3363,7 +5128,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchCheckpoint); i {
+			switch v := v.(*HotStuffQC); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3375,7 +5140,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ViewChange); i {
+			switch v := v.(*HotStuffBlock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3387,7 +5152,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorDynamicInfo); i {
+			switch v := v.(*HotStuffProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3399,7 +5164,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VcBasis); i {
+			switch v := v.(*HotStuffVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3411,7 +5176,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VcPq); i {
+			switch v := v.(*HotStuffNewView); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3423,7 +5188,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuorumViewChange); i {
+			switch v := v.(*HotStuffFetchBlock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3435,7 +5200,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeDynamicInfo); i {
+			switch v := v.(*HotStuffFetchBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3447,7 +5212,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewView); i {
+			switch v := v.(*ReBroadcastRequestSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3459,7 +5224,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchView); i {
+			switch v := v.(*HashBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3471,7 +5236,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoveryResponse); i {
+			switch v := v.(*FetchCheckpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3483,7 +5248,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchBatchRequest); i {
+			switch v := v.(*ViewChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3495,7 +5260,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchBatchResponse); i {
+			switch v := v.(*LeaderTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3507,7 +5272,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestBatch); i {
+			switch v := v.(*KeyRotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3519,7 +5284,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchMissingRequest); i {
+			switch v := v.(*ValidatorKeyState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3531,7 +5296,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchMissingResponse); i {
+			switch v := v.(*ValidatorDynamicInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3543,7 +5308,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchPQCRequest); i {
+			switch v := v.(*VcBasis); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3555,7 +5320,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchPQCResponse); i {
+			switch v := v.(*VcPq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3567,7 +5332,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncState); i {
+			switch v := v.(*QuorumViewChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3579,7 +5344,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncStateResponse); i {
+			switch v := v.(*NodeDynamicInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3591,7 +5356,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochChangeRequest); i {
+			switch v := v.(*NewView); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3603,7 +5368,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pset); i {
+			switch v := v.(*FetchView); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3615,7 +5380,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Cset); i {
+			switch v := v.(*RecoveryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3627,7 +5392,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Checkpoint); i {
+			switch v := v.(*FetchBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3639,7 +5404,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedCheckpoint); i {
+			switch v := v.(*FetchBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3651,7 +5416,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorInfo); i {
+			switch v := v.(*RequestBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3663,7 +5428,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuorumCheckpoint); i {
+			switch v := v.(*FetchMissingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3675,7 +5440,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochChangeProof); i {
+			switch v := v.(*FetchMissingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3687,7 +5452,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochChange); i {
+			switch v := v.(*FetchPQCRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3699,7 +5464,7 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuorumValidators); i {
+			switch v := v.(*FetchPQCResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3711,7 +5476,43 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuorumValidator); i {
+			switch v := v.(*SyncState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotManifestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3723,6 +5524,174 @@ func file_rbft_proto_init() {
 			}
 		}
 		file_rbft_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotManifestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotChunkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotChunkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Checkpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuorumCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochChangeProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuorumValidators); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuorumValidator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rbft_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Checkpoint_ExecuteState); i {
 			case 0:
 				return &v.state
@@ -3740,8 +5709,8 @@ func file_rbft_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rbft_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   43,
+			NumEnums:      3,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
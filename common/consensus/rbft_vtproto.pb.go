@@ -23,18 +23,25 @@ func (m *ConsensusMessage) CloneVT() *ConsensusMessage {
 		return (*ConsensusMessage)(nil)
 	}
 	r := &ConsensusMessage{
-		Type:  m.Type,
-		From:  m.From,
-		To:    m.To,
-		Epoch: m.Epoch,
-		View:  m.View,
-		Nonce: m.Nonce,
+		Type:              m.Type,
+		From:              m.From,
+		To:                m.To,
+		Epoch:             m.Epoch,
+		View:              m.View,
+		Nonce:             m.Nonce,
+		Compression:       m.Compression,
+		AcceptCompression: m.AcceptCompression,
 	}
 	if rhs := m.Payload; rhs != nil {
 		tmpBytes := make([]byte, len(rhs))
 		copy(tmpBytes, rhs)
 		r.Payload = tmpBytes
 	}
+	if rhs := m.Signature; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.Signature = tmpBytes
+	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -75,6 +82,13 @@ func (m *PrePrepare) CloneVT() *PrePrepare {
 		BatchDigest:    m.BatchDigest,
 		HashBatch:      m.HashBatch.CloneVT(),
 	}
+	if rhs := m.BftTimeCommits; rhs != nil {
+		tmpContainer := make([]*Commit, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.BftTimeCommits = tmpContainer
+	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -96,6 +110,11 @@ func (m *Prepare) CloneVT() *Prepare {
 		SequenceNumber: m.SequenceNumber,
 		BatchDigest:    m.BatchDigest,
 	}
+	if rhs := m.Signature; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.Signature = tmpBytes
+	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -116,6 +135,12 @@ func (m *Commit) CloneVT() *Commit {
 		View:           m.View,
 		SequenceNumber: m.SequenceNumber,
 		BatchDigest:    m.BatchDigest,
+		Timestamp:      m.Timestamp,
+	}
+	if rhs := m.Signature; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.Signature = tmpBytes
 	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
@@ -128,6 +153,220 @@ func (m *Commit) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *AggregatedVotes) CloneVT() *AggregatedVotes {
+	if m == nil {
+		return (*AggregatedVotes)(nil)
+	}
+	r := &AggregatedVotes{
+		ReplicaId: m.ReplicaId,
+	}
+	if rhs := m.Prepares; rhs != nil {
+		tmpContainer := make([]*Prepare, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.Prepares = tmpContainer
+	}
+	if rhs := m.Commits; rhs != nil {
+		tmpContainer := make([]*Commit, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.Commits = tmpContainer
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *AggregatedVotes) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *DecryptionShare) CloneVT() *DecryptionShare {
+	if m == nil {
+		return (*DecryptionShare)(nil)
+	}
+	r := &DecryptionShare{
+		ReplicaId:      m.ReplicaId,
+		SequenceNumber: m.SequenceNumber,
+		BatchDigest:    m.BatchDigest,
+	}
+	if rhs := m.Share; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.Share = tmpBytes
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *DecryptionShare) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *HotStuffQC) CloneVT() *HotStuffQC {
+	if m == nil {
+		return (*HotStuffQC)(nil)
+	}
+	r := &HotStuffQC{
+		View:      m.View,
+		Height:    m.Height,
+		BlockHash: m.BlockHash,
+	}
+	if rhs := m.Signatures; rhs != nil {
+		tmpContainer := make(map[uint64][]byte, len(rhs))
+		for k, v := range rhs {
+			tmpBytes := make([]byte, len(v))
+			copy(tmpBytes, v)
+			tmpContainer[k] = tmpBytes
+		}
+		r.Signatures = tmpContainer
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *HotStuffQC) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *HotStuffBlock) CloneVT() *HotStuffBlock {
+	if m == nil {
+		return (*HotStuffBlock)(nil)
+	}
+	r := &HotStuffBlock{
+		View:        m.View,
+		Height:      m.Height,
+		ParentHash:  m.ParentHash,
+		Justify:     m.Justify.CloneVT(),
+		BatchDigest: m.BatchDigest,
+		HashBatch:   m.HashBatch.CloneVT(),
+		Proposer:    m.Proposer,
+		Hash:        m.Hash,
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *HotStuffBlock) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *HotStuffProposal) CloneVT() *HotStuffProposal {
+	if m == nil {
+		return (*HotStuffProposal)(nil)
+	}
+	r := &HotStuffProposal{
+		ReplicaId: m.ReplicaId,
+		Block:     m.Block.CloneVT(),
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *HotStuffProposal) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *HotStuffVote) CloneVT() *HotStuffVote {
+	if m == nil {
+		return (*HotStuffVote)(nil)
+	}
+	r := &HotStuffVote{
+		ReplicaId: m.ReplicaId,
+		View:      m.View,
+		Height:    m.Height,
+		BlockHash: m.BlockHash,
+	}
+	if rhs := m.Signature; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.Signature = tmpBytes
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *HotStuffVote) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *HotStuffNewView) CloneVT() *HotStuffNewView {
+	if m == nil {
+		return (*HotStuffNewView)(nil)
+	}
+	r := &HotStuffNewView{
+		ReplicaId: m.ReplicaId,
+		View:      m.View,
+		HighQc:    m.HighQc.CloneVT(),
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *HotStuffNewView) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *HotStuffFetchBlock) CloneVT() *HotStuffFetchBlock {
+	if m == nil {
+		return (*HotStuffFetchBlock)(nil)
+	}
+	r := &HotStuffFetchBlock{
+		ReplicaId: m.ReplicaId,
+		BlockHash: m.BlockHash,
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *HotStuffFetchBlock) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *HotStuffFetchBlockResponse) CloneVT() *HotStuffFetchBlockResponse {
+	if m == nil {
+		return (*HotStuffFetchBlockResponse)(nil)
+	}
+	r := &HotStuffFetchBlockResponse{
+		ReplicaId: m.ReplicaId,
+		Block:     m.Block.CloneVT(),
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *HotStuffFetchBlockResponse) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
 func (m *ReBroadcastRequestSet) CloneVT() *ReBroadcastRequestSet {
 	if m == nil {
 		return (*ReBroadcastRequestSet)(nil)
@@ -162,6 +401,7 @@ func (m *HashBatch) CloneVT() *HashBatch {
 	r := &HashBatch{
 		Timestamp: m.Timestamp,
 		Proposer:  m.Proposer,
+		BftTime:   m.BftTime,
 	}
 	if rhs := m.RequestHashList; rhs != nil {
 		tmpContainer := make([]string, len(rhs))
@@ -173,6 +413,23 @@ func (m *HashBatch) CloneVT() *HashBatch {
 		copy(tmpContainer, rhs)
 		r.DeDuplicateRequestHashList = tmpContainer
 	}
+	if rhs := m.VrfProof; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.VrfProof = tmpBytes
+	}
+	if rhs := m.KeyRotations; rhs != nil {
+		tmpContainer := make([]*KeyRotation, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.KeyRotations = tmpContainer
+	}
+	if rhs := m.ReceiveTimes; rhs != nil {
+		tmpContainer := make([]int64, len(rhs))
+		copy(tmpContainer, rhs)
+		r.ReceiveTimes = tmpContainer
+	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -208,9 +465,10 @@ func (m *ViewChange) CloneVT() *ViewChange {
 		return (*ViewChange)(nil)
 	}
 	r := &ViewChange{
-		Basis:     m.Basis.CloneVT(),
-		Timestamp: m.Timestamp,
-		Recovery:  m.Recovery,
+		Basis:          m.Basis.CloneVT(),
+		Timestamp:      m.Timestamp,
+		Recovery:       m.Recovery,
+		LeaderTransfer: m.LeaderTransfer,
 	}
 	if rhs := m.Signature; rhs != nil {
 		tmpBytes := make([]byte, len(rhs))
@@ -228,6 +486,91 @@ func (m *ViewChange) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *LeaderTransfer) CloneVT() *LeaderTransfer {
+	if m == nil {
+		return (*LeaderTransfer)(nil)
+	}
+	r := &LeaderTransfer{
+		ReplicaId:      m.ReplicaId,
+		View:           m.View,
+		SequenceNumber: m.SequenceNumber,
+	}
+	if rhs := m.Signature; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.Signature = tmpBytes
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *LeaderTransfer) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *KeyRotation) CloneVT() *KeyRotation {
+	if m == nil {
+		return (*KeyRotation)(nil)
+	}
+	r := &KeyRotation{
+		ReplicaId:  m.ReplicaId,
+		KeyVersion: m.KeyVersion,
+	}
+	if rhs := m.PubKey; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.PubKey = tmpBytes
+	}
+	if rhs := m.Signature; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.Signature = tmpBytes
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *KeyRotation) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *ValidatorKeyState) CloneVT() *ValidatorKeyState {
+	if m == nil {
+		return (*ValidatorKeyState)(nil)
+	}
+	r := &ValidatorKeyState{
+		KeyVersion:     m.KeyVersion,
+		PrevKeyVersion: m.PrevKeyVersion,
+		PrevUntil:      m.PrevUntil,
+		Pending:        m.Pending.CloneVT(),
+	}
+	if rhs := m.PubKey; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.PubKey = tmpBytes
+	}
+	if rhs := m.PrevPubKey; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.PrevPubKey = tmpBytes
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *ValidatorKeyState) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
 func (m *ValidatorDynamicInfo) CloneVT() *ValidatorDynamicInfo {
 	if m == nil {
 		return (*ValidatorDynamicInfo)(nil)
@@ -360,6 +703,7 @@ func (m *NodeDynamicInfo) CloneVT() *NodeDynamicInfo {
 		ConsensusVotingPower:           m.ConsensusVotingPower,
 		ConsensusVotingPowerReduced:    m.ConsensusVotingPowerReduced,
 		ConsensusVotingPowerReduceView: m.ConsensusVotingPowerReduceView,
+		ReputationPenalty:              m.ReputationPenalty,
 	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
@@ -501,6 +845,7 @@ func (m *RequestBatch) CloneVT() *RequestBatch {
 		SeqNo:     m.SeqNo,
 		BatchHash: m.BatchHash,
 		Proposer:  m.Proposer,
+		BftTime:   m.BftTime,
 	}
 	if rhs := m.RequestHashList; rhs != nil {
 		tmpContainer := make([]string, len(rhs))
@@ -521,12 +866,29 @@ func (m *RequestBatch) CloneVT() *RequestBatch {
 		copy(tmpContainer, rhs)
 		r.LocalList = tmpContainer
 	}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
+	if rhs := m.VrfProof; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.VrfProof = tmpBytes
 	}
-	return r
-}
+	if rhs := m.VrfOutput; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.VrfOutput = tmpBytes
+	}
+	if rhs := m.KeyRotations; rhs != nil {
+		tmpContainer := make([]*KeyRotation, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.KeyRotations = tmpContainer
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
 
 func (m *RequestBatch) CloneMessageVT() proto.Message {
 	return m.CloneVT()
@@ -694,6 +1056,125 @@ func (m *SyncStateResponse) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *SnapshotManifest) CloneVT() *SnapshotManifest {
+	if m == nil {
+		return (*SnapshotManifest)(nil)
+	}
+	r := &SnapshotManifest{
+		Height: m.Height,
+		Digest: m.Digest,
+	}
+	if rhs := m.ChunkHashes; rhs != nil {
+		tmpContainer := make([][]byte, len(rhs))
+		for k, v := range rhs {
+			tmpBytes := make([]byte, len(v))
+			copy(tmpBytes, v)
+			tmpContainer[k] = tmpBytes
+		}
+		r.ChunkHashes = tmpContainer
+	}
+	if rhs := m.Metadata; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.Metadata = tmpBytes
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *SnapshotManifest) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *SnapshotManifestRequest) CloneVT() *SnapshotManifestRequest {
+	if m == nil {
+		return (*SnapshotManifestRequest)(nil)
+	}
+	r := &SnapshotManifestRequest{
+		ReplicaId: m.ReplicaId,
+		Height:    m.Height,
+		Digest:    m.Digest,
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *SnapshotManifestRequest) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *SnapshotManifestResponse) CloneVT() *SnapshotManifestResponse {
+	if m == nil {
+		return (*SnapshotManifestResponse)(nil)
+	}
+	r := &SnapshotManifestResponse{
+		ReplicaId: m.ReplicaId,
+		Manifest:  m.Manifest.CloneVT(),
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *SnapshotManifestResponse) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *SnapshotChunkRequest) CloneVT() *SnapshotChunkRequest {
+	if m == nil {
+		return (*SnapshotChunkRequest)(nil)
+	}
+	r := &SnapshotChunkRequest{
+		ReplicaId: m.ReplicaId,
+		Height:    m.Height,
+		Digest:    m.Digest,
+		Index:     m.Index,
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *SnapshotChunkRequest) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *SnapshotChunkResponse) CloneVT() *SnapshotChunkResponse {
+	if m == nil {
+		return (*SnapshotChunkResponse)(nil)
+	}
+	r := &SnapshotChunkResponse{
+		ReplicaId: m.ReplicaId,
+		Height:    m.Height,
+		Digest:    m.Digest,
+		Index:     m.Index,
+	}
+	if rhs := m.Chunk; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.Chunk = tmpBytes
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *SnapshotChunkResponse) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
 func (m *EpochChangeRequest) CloneVT() *EpochChangeRequest {
 	if m == nil {
 		return (*EpochChangeRequest)(nil)
@@ -770,6 +1251,11 @@ func (m *Checkpoint_ExecuteState) CloneVT() *Checkpoint_ExecuteState {
 		Digest:      m.Digest,
 		BatchDigest: m.BatchDigest,
 	}
+	if rhs := m.VrfOutput; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.VrfOutput = tmpBytes
+	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -791,6 +1277,20 @@ func (m *Checkpoint) CloneVT() *Checkpoint {
 		NeedUpdateEpoch: m.NeedUpdateEpoch,
 		ViewChange:      m.ViewChange.CloneVT(),
 	}
+	if rhs := m.ValidatorKeys; rhs != nil {
+		tmpContainer := make([]*ValidatorInfo, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.ValidatorKeys = tmpContainer
+	}
+	if rhs := m.ReputationPenalties; rhs != nil {
+		tmpContainer := make([]*NodeDynamicInfo, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.ReputationPenalties = tmpContainer
+	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -831,8 +1331,14 @@ func (m *ValidatorInfo) CloneVT() *ValidatorInfo {
 		return (*ValidatorInfo)(nil)
 	}
 	r := &ValidatorInfo{
-		Id:    m.Id,
-		P2PId: m.P2PId,
+		Id:         m.Id,
+		P2PId:      m.P2PId,
+		KeyVersion: m.KeyVersion,
+	}
+	if rhs := m.PubKey; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.PubKey = tmpBytes
 	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
@@ -995,6 +1501,15 @@ func (this *ConsensusMessage) EqualVT(that *ConsensusMessage) bool {
 	if this.Nonce != that.Nonce {
 		return false
 	}
+	if this.Compression != that.Compression {
+		return false
+	}
+	if this.AcceptCompression != that.AcceptCompression {
+		return false
+	}
+	if string(this.Signature) != string(that.Signature) {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1045,6 +1560,23 @@ func (this *PrePrepare) EqualVT(that *PrePrepare) bool {
 	if !this.HashBatch.EqualVT(that.HashBatch) {
 		return false
 	}
+	if len(this.BftTimeCommits) != len(that.BftTimeCommits) {
+		return false
+	}
+	for i, vx := range this.BftTimeCommits {
+		vy := that.BftTimeCommits[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Commit{}
+			}
+			if q == nil {
+				q = &Commit{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1073,6 +1605,9 @@ func (this *Prepare) EqualVT(that *Prepare) bool {
 	if this.BatchDigest != that.BatchDigest {
 		return false
 	}
+	if string(this.Signature) != string(that.Signature) {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1101,6 +1636,12 @@ func (this *Commit) EqualVT(that *Commit) bool {
 	if this.BatchDigest != that.BatchDigest {
 		return false
 	}
+	if string(this.Signature) != string(that.Signature) {
+		return false
+	}
+	if this.Timestamp != that.Timestamp {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1111,7 +1652,7 @@ func (this *Commit) EqualMessageVT(thatMsg proto.Message) bool {
 	}
 	return this.EqualVT(that)
 }
-func (this *ReBroadcastRequestSet) EqualVT(that *ReBroadcastRequestSet) bool {
+func (this *AggregatedVotes) EqualVT(that *AggregatedVotes) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
@@ -1120,149 +1661,178 @@ func (this *ReBroadcastRequestSet) EqualVT(that *ReBroadcastRequestSet) bool {
 	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if len(this.Requests) != len(that.Requests) {
+	if len(this.Prepares) != len(that.Prepares) {
 		return false
 	}
-	for i, vx := range this.Requests {
-		vy := that.Requests[i]
-		if string(vx) != string(vy) {
-			return false
+	for i, vx := range this.Prepares {
+		vy := that.Prepares[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Prepare{}
+			}
+			if q == nil {
+				q = &Prepare{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if len(this.Commits) != len(that.Commits) {
+		return false
+	}
+	for i, vx := range this.Commits {
+		vy := that.Commits[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Commit{}
+			}
+			if q == nil {
+				q = &Commit{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
 		}
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *ReBroadcastRequestSet) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*ReBroadcastRequestSet)
+func (this *AggregatedVotes) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*AggregatedVotes)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *HashBatch) EqualVT(that *HashBatch) bool {
+func (this *DecryptionShare) EqualVT(that *DecryptionShare) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.RequestHashList) != len(that.RequestHashList) {
+	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	for i, vx := range this.RequestHashList {
-		vy := that.RequestHashList[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.DeDuplicateRequestHashList) != len(that.DeDuplicateRequestHashList) {
+	if this.SequenceNumber != that.SequenceNumber {
 		return false
 	}
-	for i, vx := range this.DeDuplicateRequestHashList {
-		vy := that.DeDuplicateRequestHashList[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if this.Timestamp != that.Timestamp {
+	if this.BatchDigest != that.BatchDigest {
 		return false
 	}
-	if this.Proposer != that.Proposer {
+	if string(this.Share) != string(that.Share) {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *HashBatch) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*HashBatch)
+func (this *DecryptionShare) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*DecryptionShare)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *FetchCheckpoint) EqualVT(that *FetchCheckpoint) bool {
+func (this *HotStuffQC) EqualVT(that *HotStuffQC) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.ReplicaId != that.ReplicaId {
+	if this.View != that.View {
 		return false
 	}
-	if this.SequenceNumber != that.SequenceNumber {
+	if this.Height != that.Height {
+		return false
+	}
+	if this.BlockHash != that.BlockHash {
+		return false
+	}
+	if len(this.Signatures) != len(that.Signatures) {
 		return false
 	}
+	for i, vx := range this.Signatures {
+		vy, ok := that.Signatures[i]
+		if !ok {
+			return false
+		}
+		if string(vx) != string(vy) {
+			return false
+		}
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *FetchCheckpoint) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*FetchCheckpoint)
+func (this *HotStuffQC) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*HotStuffQC)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *ViewChange) EqualVT(that *ViewChange) bool {
+func (this *HotStuffBlock) EqualVT(that *HotStuffBlock) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if !this.Basis.EqualVT(that.Basis) {
+	if this.View != that.View {
 		return false
 	}
-	if string(this.Signature) != string(that.Signature) {
+	if this.Height != that.Height {
 		return false
 	}
-	if this.Timestamp != that.Timestamp {
+	if this.ParentHash != that.ParentHash {
 		return false
 	}
-	if this.Recovery != that.Recovery {
+	if !this.Justify.EqualVT(that.Justify) {
+		return false
+	}
+	if this.BatchDigest != that.BatchDigest {
+		return false
+	}
+	if !this.HashBatch.EqualVT(that.HashBatch) {
+		return false
+	}
+	if this.Proposer != that.Proposer {
+		return false
+	}
+	if this.Hash != that.Hash {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *ViewChange) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*ViewChange)
+func (this *HotStuffBlock) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*HotStuffBlock)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *ValidatorDynamicInfo) EqualVT(that *ValidatorDynamicInfo) bool {
+func (this *HotStuffProposal) EqualVT(that *HotStuffProposal) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.Info) != len(that.Info) {
+	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	for i, vx := range this.Info {
-		vy := that.Info[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &NodeDynamicInfo{}
-			}
-			if q == nil {
-				q = &NodeDynamicInfo{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
+	if !this.Block.EqualVT(that.Block) {
+		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *ValidatorDynamicInfo) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*ValidatorDynamicInfo)
+func (this *HotStuffProposal) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*HotStuffProposal)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *VcBasis) EqualVT(that *VcBasis) bool {
+func (this *HotStuffVote) EqualVT(that *HotStuffVote) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
@@ -1274,130 +1844,73 @@ func (this *VcBasis) EqualVT(that *VcBasis) bool {
 	if this.View != that.View {
 		return false
 	}
-	if this.H != that.H {
+	if this.Height != that.Height {
 		return false
 	}
-	if len(this.Pset) != len(that.Pset) {
+	if this.BlockHash != that.BlockHash {
 		return false
 	}
-	for i, vx := range this.Pset {
-		vy := that.Pset[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &VcPq{}
-			}
-			if q == nil {
-				q = &VcPq{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
-	}
-	if len(this.Qset) != len(that.Qset) {
+	if string(this.Signature) != string(that.Signature) {
 		return false
 	}
-	for i, vx := range this.Qset {
-		vy := that.Qset[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &VcPq{}
-			}
-			if q == nil {
-				q = &VcPq{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
-	}
-	if len(this.Cset) != len(that.Cset) {
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *HotStuffVote) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*HotStuffVote)
+	if !ok {
 		return false
 	}
-	for i, vx := range this.Cset {
-		vy := that.Cset[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &SignedCheckpoint{}
-			}
-			if q == nil {
-				q = &SignedCheckpoint{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
-	}
-	if len(this.IfNotRecoverValidatorDynamicInfo) != len(that.IfNotRecoverValidatorDynamicInfo) {
+	return this.EqualVT(that)
+}
+func (this *HotStuffNewView) EqualVT(that *HotStuffNewView) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
 		return false
 	}
-	for i, vx := range this.IfNotRecoverValidatorDynamicInfo {
-		vy := that.IfNotRecoverValidatorDynamicInfo[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &NodeDynamicInfo{}
-			}
-			if q == nil {
-				q = &NodeDynamicInfo{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
+	if this.ReplicaId != that.ReplicaId {
+		return false
 	}
-	if len(this.IfRecoverValidatorDynamicInfo) != len(that.IfRecoverValidatorDynamicInfo) {
+	if this.View != that.View {
 		return false
 	}
-	for i, vx := range this.IfRecoverValidatorDynamicInfo {
-		vy := that.IfRecoverValidatorDynamicInfo[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &NodeDynamicInfo{}
-			}
-			if q == nil {
-				q = &NodeDynamicInfo{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
+	if !this.HighQc.EqualVT(that.HighQc) {
+		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *VcBasis) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*VcBasis)
+func (this *HotStuffNewView) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*HotStuffNewView)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *VcPq) EqualVT(that *VcPq) bool {
+func (this *HotStuffFetchBlock) EqualVT(that *HotStuffFetchBlock) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.SequenceNumber != that.SequenceNumber {
-		return false
-	}
-	if this.BatchDigest != that.BatchDigest {
+	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if this.View != that.View {
+	if this.BlockHash != that.BlockHash {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *VcPq) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*VcPq)
+func (this *HotStuffFetchBlock) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*HotStuffFetchBlock)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *QuorumViewChange) EqualVT(that *QuorumViewChange) bool {
+func (this *HotStuffFetchBlockResponse) EqualVT(that *HotStuffFetchBlockResponse) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
@@ -1406,133 +1919,120 @@ func (this *QuorumViewChange) EqualVT(that *QuorumViewChange) bool {
 	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if len(this.ViewChanges) != len(that.ViewChanges) {
+	if !this.Block.EqualVT(that.Block) {
 		return false
 	}
-	for i, vx := range this.ViewChanges {
-		vy := that.ViewChanges[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &ViewChange{}
-			}
-			if q == nil {
-				q = &ViewChange{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *QuorumViewChange) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*QuorumViewChange)
+func (this *HotStuffFetchBlockResponse) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*HotStuffFetchBlockResponse)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *NodeDynamicInfo) EqualVT(that *NodeDynamicInfo) bool {
+func (this *ReBroadcastRequestSet) EqualVT(that *ReBroadcastRequestSet) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Id != that.Id {
-		return false
-	}
-	if this.ConsensusVotingPower != that.ConsensusVotingPower {
+	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if this.ConsensusVotingPowerReduced != that.ConsensusVotingPowerReduced {
+	if len(this.Requests) != len(that.Requests) {
 		return false
 	}
-	if this.ConsensusVotingPowerReduceView != that.ConsensusVotingPowerReduceView {
-		return false
+	for i, vx := range this.Requests {
+		vy := that.Requests[i]
+		if string(vx) != string(vy) {
+			return false
+		}
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *NodeDynamicInfo) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*NodeDynamicInfo)
+func (this *ReBroadcastRequestSet) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*ReBroadcastRequestSet)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *NewView) EqualVT(that *NewView) bool {
+func (this *HashBatch) EqualVT(that *HashBatch) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.ReplicaId != that.ReplicaId {
-		return false
-	}
-	if this.View != that.View {
-		return false
-	}
-	if len(this.Xset) != len(that.Xset) {
+	if len(this.RequestHashList) != len(that.RequestHashList) {
 		return false
 	}
-	for i, vx := range this.Xset {
-		vy := that.Xset[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &VcPq{}
-			}
-			if q == nil {
-				q = &VcPq{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
+	for i, vx := range this.RequestHashList {
+		vy := that.RequestHashList[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if !this.ViewChangeSet.EqualVT(that.ViewChangeSet) {
+	if len(this.DeDuplicateRequestHashList) != len(that.DeDuplicateRequestHashList) {
 		return false
 	}
-	if !this.QuorumCheckpoint.EqualVT(that.QuorumCheckpoint) {
-		return false
+	for i, vx := range this.DeDuplicateRequestHashList {
+		vy := that.DeDuplicateRequestHashList[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if string(this.Signature) != string(that.Signature) {
+	if this.Timestamp != that.Timestamp {
 		return false
 	}
-	if this.FromId != that.FromId {
+	if this.Proposer != that.Proposer {
 		return false
 	}
-	if this.AutoTermUpdate != that.AutoTermUpdate {
+	if string(this.VrfProof) != string(that.VrfProof) {
 		return false
 	}
-	if len(this.ValidatorDynamicInfo) != len(that.ValidatorDynamicInfo) {
+	if len(this.KeyRotations) != len(that.KeyRotations) {
 		return false
 	}
-	for i, vx := range this.ValidatorDynamicInfo {
-		vy := that.ValidatorDynamicInfo[i]
+	for i, vx := range this.KeyRotations {
+		vy := that.KeyRotations[i]
 		if p, q := vx, vy; p != q {
 			if p == nil {
-				p = &NodeDynamicInfo{}
+				p = &KeyRotation{}
 			}
 			if q == nil {
-				q = &NodeDynamicInfo{}
+				q = &KeyRotation{}
 			}
 			if !p.EqualVT(q) {
 				return false
 			}
 		}
 	}
+	if this.BftTime != that.BftTime {
+		return false
+	}
+	if len(this.ReceiveTimes) != len(that.ReceiveTimes) {
+		return false
+	}
+	for i, vx := range this.ReceiveTimes {
+		vy := that.ReceiveTimes[i]
+		if vx != vy {
+			return false
+		}
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *NewView) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*NewView)
+func (this *HashBatch) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*HashBatch)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *FetchView) EqualVT(that *FetchView) bool {
+func (this *FetchCheckpoint) EqualVT(that *FetchCheckpoint) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
@@ -1541,45 +2041,51 @@ func (this *FetchView) EqualVT(that *FetchView) bool {
 	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if this.View != that.View {
+	if this.SequenceNumber != that.SequenceNumber {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *FetchView) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*FetchView)
+func (this *FetchCheckpoint) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*FetchCheckpoint)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *RecoveryResponse) EqualVT(that *RecoveryResponse) bool {
+func (this *ViewChange) EqualVT(that *ViewChange) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if !this.NewView.EqualVT(that.NewView) {
+	if !this.Basis.EqualVT(that.Basis) {
 		return false
 	}
-	if !this.InitialCheckpoint.EqualVT(that.InitialCheckpoint) {
+	if string(this.Signature) != string(that.Signature) {
 		return false
 	}
-	if this.GenesisBlockDigest != that.GenesisBlockDigest {
+	if this.Timestamp != that.Timestamp {
+		return false
+	}
+	if this.Recovery != that.Recovery {
+		return false
+	}
+	if this.LeaderTransfer != that.LeaderTransfer {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *RecoveryResponse) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*RecoveryResponse)
+func (this *ViewChange) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*ViewChange)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *FetchBatchRequest) EqualVT(that *FetchBatchRequest) bool {
+func (this *LeaderTransfer) EqualVT(that *LeaderTransfer) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
@@ -1588,20 +2094,26 @@ func (this *FetchBatchRequest) EqualVT(that *FetchBatchRequest) bool {
 	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if this.BatchDigest != that.BatchDigest {
+	if this.View != that.View {
+		return false
+	}
+	if this.SequenceNumber != that.SequenceNumber {
+		return false
+	}
+	if string(this.Signature) != string(that.Signature) {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *FetchBatchRequest) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*FetchBatchRequest)
+func (this *LeaderTransfer) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*LeaderTransfer)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *FetchBatchResponse) EqualVT(that *FetchBatchResponse) bool {
+func (this *KeyRotation) EqualVT(that *KeyRotation) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
@@ -1610,118 +2122,93 @@ func (this *FetchBatchResponse) EqualVT(that *FetchBatchResponse) bool {
 	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if !this.Batch.EqualVT(that.Batch) {
+	if this.KeyVersion != that.KeyVersion {
 		return false
 	}
-	if this.BatchDigest != that.BatchDigest {
+	if string(this.PubKey) != string(that.PubKey) {
+		return false
+	}
+	if string(this.Signature) != string(that.Signature) {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *FetchBatchResponse) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*FetchBatchResponse)
+func (this *KeyRotation) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*KeyRotation)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *RequestBatch) EqualVT(that *RequestBatch) bool {
+func (this *ValidatorKeyState) EqualVT(that *ValidatorKeyState) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.RequestHashList) != len(that.RequestHashList) {
+	if this.KeyVersion != that.KeyVersion {
 		return false
 	}
-	for i, vx := range this.RequestHashList {
-		vy := that.RequestHashList[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RequestList) != len(that.RequestList) {
+	if string(this.PubKey) != string(that.PubKey) {
 		return false
 	}
-	for i, vx := range this.RequestList {
-		vy := that.RequestList[i]
-		if string(vx) != string(vy) {
-			return false
-		}
+	if this.PrevKeyVersion != that.PrevKeyVersion {
+		return false
 	}
-	if this.Timestamp != that.Timestamp {
+	if string(this.PrevPubKey) != string(that.PrevPubKey) {
 		return false
 	}
-	if this.SeqNo != that.SeqNo {
+	if this.PrevUntil != that.PrevUntil {
 		return false
 	}
-	if len(this.LocalList) != len(that.LocalList) {
-		return false
-	}
-	for i, vx := range this.LocalList {
-		vy := that.LocalList[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if this.BatchHash != that.BatchHash {
-		return false
-	}
-	if this.Proposer != that.Proposer {
+	if !this.Pending.EqualVT(that.Pending) {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *RequestBatch) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*RequestBatch)
+func (this *ValidatorKeyState) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*ValidatorKeyState)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *FetchMissingRequest) EqualVT(that *FetchMissingRequest) bool {
+func (this *ValidatorDynamicInfo) EqualVT(that *ValidatorDynamicInfo) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.ReplicaId != that.ReplicaId {
-		return false
-	}
-	if this.View != that.View {
-		return false
-	}
-	if this.SequenceNumber != that.SequenceNumber {
-		return false
-	}
-	if this.BatchDigest != that.BatchDigest {
-		return false
-	}
-	if len(this.MissingRequestHashes) != len(that.MissingRequestHashes) {
+	if len(this.Info) != len(that.Info) {
 		return false
 	}
-	for i, vx := range this.MissingRequestHashes {
-		vy, ok := that.MissingRequestHashes[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
+	for i, vx := range this.Info {
+		vy := that.Info[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &NodeDynamicInfo{}
+			}
+			if q == nil {
+				q = &NodeDynamicInfo{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
 		}
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *FetchMissingRequest) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*FetchMissingRequest)
+func (this *ValidatorDynamicInfo) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*ValidatorDynamicInfo)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *FetchMissingResponse) EqualVT(that *FetchMissingResponse) bool {
+func (this *VcBasis) EqualVT(that *VcBasis) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
@@ -1733,125 +2220,88 @@ func (this *FetchMissingResponse) EqualVT(that *FetchMissingResponse) bool {
 	if this.View != that.View {
 		return false
 	}
-	if this.SequenceNumber != that.SequenceNumber {
-		return false
-	}
-	if this.BatchDigest != that.BatchDigest {
+	if this.H != that.H {
 		return false
 	}
-	if len(this.MissingRequestHashes) != len(that.MissingRequestHashes) {
+	if len(this.Pset) != len(that.Pset) {
 		return false
 	}
-	for i, vx := range this.MissingRequestHashes {
-		vy, ok := that.MissingRequestHashes[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
+	for i, vx := range this.Pset {
+		vy := that.Pset[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &VcPq{}
+			}
+			if q == nil {
+				q = &VcPq{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
 		}
 	}
-	if len(this.MissingRequests) != len(that.MissingRequests) {
+	if len(this.Qset) != len(that.Qset) {
 		return false
 	}
-	for i, vx := range this.MissingRequests {
-		vy, ok := that.MissingRequests[i]
-		if !ok {
-			return false
-		}
-		if string(vx) != string(vy) {
-			return false
+	for i, vx := range this.Qset {
+		vy := that.Qset[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &VcPq{}
+			}
+			if q == nil {
+				q = &VcPq{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
 		}
 	}
-	if this.Status != that.Status {
-		return false
-	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *FetchMissingResponse) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*FetchMissingResponse)
-	if !ok {
-		return false
-	}
-	return this.EqualVT(that)
-}
-func (this *FetchPQCRequest) EqualVT(that *FetchPQCRequest) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
-	}
-	if this.ReplicaId != that.ReplicaId {
-		return false
-	}
-	if this.H != that.H {
-		return false
-	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *FetchPQCRequest) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*FetchPQCRequest)
-	if !ok {
-		return false
-	}
-	return this.EqualVT(that)
-}
-func (this *FetchPQCResponse) EqualVT(that *FetchPQCResponse) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
-	}
-	if this.ReplicaId != that.ReplicaId {
-		return false
-	}
-	if len(this.PrepreSet) != len(that.PrepreSet) {
+	if len(this.Cset) != len(that.Cset) {
 		return false
 	}
-	for i, vx := range this.PrepreSet {
-		vy := that.PrepreSet[i]
+	for i, vx := range this.Cset {
+		vy := that.Cset[i]
 		if p, q := vx, vy; p != q {
 			if p == nil {
-				p = &PrePrepare{}
+				p = &SignedCheckpoint{}
 			}
 			if q == nil {
-				q = &PrePrepare{}
+				q = &SignedCheckpoint{}
 			}
 			if !p.EqualVT(q) {
 				return false
 			}
 		}
 	}
-	if len(this.PreSet) != len(that.PreSet) {
+	if len(this.IfNotRecoverValidatorDynamicInfo) != len(that.IfNotRecoverValidatorDynamicInfo) {
 		return false
 	}
-	for i, vx := range this.PreSet {
-		vy := that.PreSet[i]
+	for i, vx := range this.IfNotRecoverValidatorDynamicInfo {
+		vy := that.IfNotRecoverValidatorDynamicInfo[i]
 		if p, q := vx, vy; p != q {
 			if p == nil {
-				p = &Prepare{}
+				p = &NodeDynamicInfo{}
 			}
 			if q == nil {
-				q = &Prepare{}
+				q = &NodeDynamicInfo{}
 			}
 			if !p.EqualVT(q) {
 				return false
 			}
 		}
 	}
-	if len(this.CmtSet) != len(that.CmtSet) {
+	if len(this.IfRecoverValidatorDynamicInfo) != len(that.IfRecoverValidatorDynamicInfo) {
 		return false
 	}
-	for i, vx := range this.CmtSet {
-		vy := that.CmtSet[i]
+	for i, vx := range this.IfRecoverValidatorDynamicInfo {
+		vy := that.IfRecoverValidatorDynamicInfo[i]
 		if p, q := vx, vy; p != q {
 			if p == nil {
-				p = &Commit{}
+				p = &NodeDynamicInfo{}
 			}
 			if q == nil {
-				q = &Commit{}
+				q = &NodeDynamicInfo{}
 			}
 			if !p.EqualVT(q) {
 				return false
@@ -1861,33 +2311,39 @@ func (this *FetchPQCResponse) EqualVT(that *FetchPQCResponse) bool {
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *FetchPQCResponse) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*FetchPQCResponse)
+func (this *VcBasis) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*VcBasis)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *SyncState) EqualVT(that *SyncState) bool {
+func (this *VcPq) EqualVT(that *VcPq) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.AuthorP2PNodeId != that.AuthorP2PNodeId {
+	if this.SequenceNumber != that.SequenceNumber {
+		return false
+	}
+	if this.BatchDigest != that.BatchDigest {
+		return false
+	}
+	if this.View != that.View {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *SyncState) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*SyncState)
+func (this *VcPq) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*VcPq)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *SyncStateResponse) EqualVT(that *SyncStateResponse) bool {
+func (this *QuorumViewChange) EqualVT(that *QuorumViewChange) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
@@ -1896,100 +2352,119 @@ func (this *SyncStateResponse) EqualVT(that *SyncStateResponse) bool {
 	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if this.View != that.View {
+	if len(this.ViewChanges) != len(that.ViewChanges) {
 		return false
 	}
-	if !this.SignedCheckpoint.EqualVT(that.SignedCheckpoint) {
-		return false
+	for i, vx := range this.ViewChanges {
+		vy := that.ViewChanges[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &ViewChange{}
+			}
+			if q == nil {
+				q = &ViewChange{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *SyncStateResponse) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*SyncStateResponse)
+func (this *QuorumViewChange) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*QuorumViewChange)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *EpochChangeRequest) EqualVT(that *EpochChangeRequest) bool {
+func (this *NodeDynamicInfo) EqualVT(that *NodeDynamicInfo) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Author != that.Author {
+	if this.Id != that.Id {
 		return false
 	}
-	if this.StartEpoch != that.StartEpoch {
+	if this.ConsensusVotingPower != that.ConsensusVotingPower {
 		return false
 	}
-	if this.TargetEpoch != that.TargetEpoch {
+	if this.ConsensusVotingPowerReduced != that.ConsensusVotingPowerReduced {
 		return false
 	}
-	if this.AuthorP2PNodeId != that.AuthorP2PNodeId {
+	if this.ConsensusVotingPowerReduceView != that.ConsensusVotingPowerReduceView {
+		return false
+	}
+	if this.ReputationPenalty != that.ReputationPenalty {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *EpochChangeRequest) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*EpochChangeRequest)
+func (this *NodeDynamicInfo) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*NodeDynamicInfo)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Pset) EqualVT(that *Pset) bool {
+func (this *NewView) EqualVT(that *NewView) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.Set) != len(that.Set) {
+	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	for i, vx := range this.Set {
-		vy := that.Set[i]
+	if this.View != that.View {
+		return false
+	}
+	if len(this.Xset) != len(that.Xset) {
+		return false
+	}
+	for i, vx := range this.Xset {
+		vy := that.Xset[i]
 		if p, q := vx, vy; p != q {
 			if p == nil {
-				p = &Prepare{}
+				p = &VcPq{}
 			}
 			if q == nil {
-				q = &Prepare{}
+				q = &VcPq{}
 			}
 			if !p.EqualVT(q) {
 				return false
 			}
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *Pset) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Pset)
-	if !ok {
+	if !this.ViewChangeSet.EqualVT(that.ViewChangeSet) {
 		return false
 	}
-	return this.EqualVT(that)
-}
-func (this *Cset) EqualVT(that *Cset) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
+	if !this.QuorumCheckpoint.EqualVT(that.QuorumCheckpoint) {
 		return false
 	}
-	if len(this.Set) != len(that.Set) {
+	if string(this.Signature) != string(that.Signature) {
 		return false
 	}
-	for i, vx := range this.Set {
-		vy := that.Set[i]
+	if this.FromId != that.FromId {
+		return false
+	}
+	if this.AutoTermUpdate != that.AutoTermUpdate {
+		return false
+	}
+	if len(this.ValidatorDynamicInfo) != len(that.ValidatorDynamicInfo) {
+		return false
+	}
+	for i, vx := range this.ValidatorDynamicInfo {
+		vy := that.ValidatorDynamicInfo[i]
 		if p, q := vx, vy; p != q {
 			if p == nil {
-				p = &Commit{}
+				p = &NodeDynamicInfo{}
 			}
 			if q == nil {
-				q = &Commit{}
+				q = &NodeDynamicInfo{}
 			}
 			if !p.EqualVT(q) {
 				return false
@@ -1999,1007 +2474,988 @@ func (this *Cset) EqualVT(that *Cset) bool {
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Cset) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Cset)
+func (this *NewView) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*NewView)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Checkpoint_ExecuteState) EqualVT(that *Checkpoint_ExecuteState) bool {
+func (this *FetchView) EqualVT(that *FetchView) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Height != that.Height {
-		return false
-	}
-	if this.Digest != that.Digest {
+	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if this.BatchDigest != that.BatchDigest {
+	if this.View != that.View {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Checkpoint_ExecuteState) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Checkpoint_ExecuteState)
+func (this *FetchView) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*FetchView)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Checkpoint) EqualVT(that *Checkpoint) bool {
+func (this *RecoveryResponse) EqualVT(that *RecoveryResponse) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Epoch != that.Epoch {
-		return false
-	}
-	if !this.ExecuteState.EqualVT(that.ExecuteState) {
+	if !this.NewView.EqualVT(that.NewView) {
 		return false
 	}
-	if this.NeedUpdateEpoch != that.NeedUpdateEpoch {
+	if !this.InitialCheckpoint.EqualVT(that.InitialCheckpoint) {
 		return false
 	}
-	if !this.ViewChange.EqualVT(that.ViewChange) {
+	if this.GenesisBlockDigest != that.GenesisBlockDigest {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Checkpoint) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Checkpoint)
+func (this *RecoveryResponse) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*RecoveryResponse)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *SignedCheckpoint) EqualVT(that *SignedCheckpoint) bool {
+func (this *FetchBatchRequest) EqualVT(that *FetchBatchRequest) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if !this.Checkpoint.EqualVT(that.Checkpoint) {
-		return false
-	}
-	if this.Author != that.Author {
+	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if string(this.Signature) != string(that.Signature) {
+	if this.BatchDigest != that.BatchDigest {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *SignedCheckpoint) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*SignedCheckpoint)
+func (this *FetchBatchRequest) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*FetchBatchRequest)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *ValidatorInfo) EqualVT(that *ValidatorInfo) bool {
+func (this *FetchBatchResponse) EqualVT(that *FetchBatchResponse) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Id != that.Id {
+	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if this.P2PId != that.P2PId {
+	if !this.Batch.EqualVT(that.Batch) {
+		return false
+	}
+	if this.BatchDigest != that.BatchDigest {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *ValidatorInfo) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*ValidatorInfo)
+func (this *FetchBatchResponse) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*FetchBatchResponse)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *QuorumCheckpoint) EqualVT(that *QuorumCheckpoint) bool {
+func (this *RequestBatch) EqualVT(that *RequestBatch) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if !this.Checkpoint.EqualVT(that.Checkpoint) {
-		return false
-	}
-	if len(this.Signatures) != len(that.Signatures) {
+	if len(this.RequestHashList) != len(that.RequestHashList) {
 		return false
 	}
-	for i, vx := range this.Signatures {
-		vy, ok := that.Signatures[i]
-		if !ok {
+	for i, vx := range this.RequestHashList {
+		vy := that.RequestHashList[i]
+		if vx != vy {
 			return false
 		}
+	}
+	if len(this.RequestList) != len(that.RequestList) {
+		return false
+	}
+	for i, vx := range this.RequestList {
+		vy := that.RequestList[i]
 		if string(vx) != string(vy) {
 			return false
 		}
 	}
-	if len(this.ValidatorSet) != len(that.ValidatorSet) {
+	if this.Timestamp != that.Timestamp {
 		return false
 	}
-	for i, vx := range this.ValidatorSet {
-		vy, ok := that.ValidatorSet[i]
-		if !ok {
+	if this.SeqNo != that.SeqNo {
+		return false
+	}
+	if len(this.LocalList) != len(that.LocalList) {
+		return false
+	}
+	for i, vx := range this.LocalList {
+		vy := that.LocalList[i]
+		if vx != vy {
 			return false
 		}
+	}
+	if this.BatchHash != that.BatchHash {
+		return false
+	}
+	if this.Proposer != that.Proposer {
+		return false
+	}
+	if string(this.VrfProof) != string(that.VrfProof) {
+		return false
+	}
+	if string(this.VrfOutput) != string(that.VrfOutput) {
+		return false
+	}
+	if len(this.KeyRotations) != len(that.KeyRotations) {
+		return false
+	}
+	for i, vx := range this.KeyRotations {
+		vy := that.KeyRotations[i]
 		if p, q := vx, vy; p != q {
 			if p == nil {
-				p = &ValidatorInfo{}
+				p = &KeyRotation{}
 			}
 			if q == nil {
-				q = &ValidatorInfo{}
+				q = &KeyRotation{}
 			}
 			if !p.EqualVT(q) {
 				return false
 			}
 		}
 	}
+	if this.BftTime != that.BftTime {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *QuorumCheckpoint) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*QuorumCheckpoint)
+func (this *RequestBatch) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*RequestBatch)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *EpochChangeProof) EqualVT(that *EpochChangeProof) bool {
+func (this *FetchMissingRequest) EqualVT(that *FetchMissingRequest) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.EpochChanges) != len(that.EpochChanges) {
+	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	for i, vx := range this.EpochChanges {
-		vy := that.EpochChanges[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &EpochChange{}
-			}
-			if q == nil {
-				q = &EpochChange{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
+	if this.View != that.View {
+		return false
 	}
-	if this.More != that.More {
+	if this.SequenceNumber != that.SequenceNumber {
 		return false
 	}
-	if this.Author != that.Author {
+	if this.BatchDigest != that.BatchDigest {
 		return false
 	}
-	if this.GenesisBlockDigest != that.GenesisBlockDigest {
+	if len(this.MissingRequestHashes) != len(that.MissingRequestHashes) {
 		return false
 	}
+	for i, vx := range this.MissingRequestHashes {
+		vy, ok := that.MissingRequestHashes[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *EpochChangeProof) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*EpochChangeProof)
+func (this *FetchMissingRequest) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*FetchMissingRequest)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *EpochChange) EqualVT(that *EpochChange) bool {
+func (this *FetchMissingResponse) EqualVT(that *FetchMissingResponse) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if !this.Checkpoint.EqualVT(that.Checkpoint) {
+	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if !this.Validators.EqualVT(that.Validators) {
+	if this.View != that.View {
 		return false
 	}
-	if this.LowWatermark != that.LowWatermark {
+	if this.SequenceNumber != that.SequenceNumber {
 		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *EpochChange) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*EpochChange)
-	if !ok {
+	if this.BatchDigest != that.BatchDigest {
 		return false
 	}
-	return this.EqualVT(that)
-}
-func (this *QuorumValidators) EqualVT(that *QuorumValidators) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
+	if len(this.MissingRequestHashes) != len(that.MissingRequestHashes) {
 		return false
 	}
-	if len(this.Validators) != len(that.Validators) {
+	for i, vx := range this.MissingRequestHashes {
+		vy, ok := that.MissingRequestHashes[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.MissingRequests) != len(that.MissingRequests) {
 		return false
 	}
-	for i, vx := range this.Validators {
-		vy := that.Validators[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &QuorumValidator{}
-			}
-			if q == nil {
-				q = &QuorumValidator{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
+	for i, vx := range this.MissingRequests {
+		vy, ok := that.MissingRequests[i]
+		if !ok {
+			return false
+		}
+		if string(vx) != string(vy) {
+			return false
 		}
 	}
+	if this.Status != that.Status {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *QuorumValidators) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*QuorumValidators)
+func (this *FetchMissingResponse) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*FetchMissingResponse)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *QuorumValidator) EqualVT(that *QuorumValidator) bool {
+func (this *FetchPQCRequest) EqualVT(that *FetchPQCRequest) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Id != that.Id {
+	if this.ReplicaId != that.ReplicaId {
 		return false
 	}
-	if this.PeerId != that.PeerId {
+	if this.H != that.H {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *QuorumValidator) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*QuorumValidator)
+func (this *FetchPQCRequest) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*FetchPQCRequest)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (m *ConsensusMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+func (this *FetchPQCResponse) EqualVT(that *FetchPQCResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ConsensusMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *ConsensusMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
-	}
-	if m.Nonce != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x38
+	if this.ReplicaId != that.ReplicaId {
+		return false
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarint(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x32
+	if len(this.PrepreSet) != len(that.PrepreSet) {
+		return false
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x28
+	for i, vx := range this.PrepreSet {
+		vy := that.PrepreSet[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &PrePrepare{}
+			}
+			if q == nil {
+				q = &PrePrepare{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
 	}
-	if m.Epoch != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x20
+	if len(this.PreSet) != len(that.PreSet) {
+		return false
 	}
-	if m.To != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.To))
-		i--
-		dAtA[i] = 0x18
+	for i, vx := range this.PreSet {
+		vy := that.PreSet[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Prepare{}
+			}
+			if q == nil {
+				q = &Prepare{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
 	}
-	if m.From != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.From))
-		i--
-		dAtA[i] = 0x10
+	if len(this.CmtSet) != len(that.CmtSet) {
+		return false
 	}
-	if m.Type != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	for i, vx := range this.CmtSet {
+		vy := that.CmtSet[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Commit{}
+			}
+			if q == nil {
+				q = &Commit{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *NullRequest) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *FetchPQCResponse) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*FetchPQCResponse)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
-
-func (m *NullRequest) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *SyncState) EqualVT(that *SyncState) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.AuthorP2PNodeId != that.AuthorP2PNodeId {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *NullRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *SyncState) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*SyncState)
+	if !ok {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	return this.EqualVT(that)
+}
+func (this *SyncStateResponse) EqualVT(that *SyncStateResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
-		i--
-		dAtA[i] = 0x8
+	if this.ReplicaId != that.ReplicaId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PrePrepare) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if this.View != that.View {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.SignedCheckpoint.EqualVT(that.SignedCheckpoint) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PrePrepare) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *PrePrepare) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *SyncStateResponse) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*SyncStateResponse)
+	if !ok {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	return this.EqualVT(that)
+}
+func (this *SnapshotManifest) EqualVT(that *SnapshotManifest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if m.HashBatch != nil {
-		size, err := m.HashBatch.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x2a
+	if this.Height != that.Height {
+		return false
 	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
-		i--
-		dAtA[i] = 0x22
+	if this.Digest != that.Digest {
+		return false
 	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
-		i--
-		dAtA[i] = 0x18
+	if len(this.ChunkHashes) != len(that.ChunkHashes) {
+		return false
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x10
+	for i, vx := range this.ChunkHashes {
+		vy := that.ChunkHashes[i]
+		if string(vx) != string(vy) {
+			return false
+		}
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
-		i--
-		dAtA[i] = 0x8
+	if string(this.Metadata) != string(that.Metadata) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Prepare) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SnapshotManifest) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*SnapshotManifest)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
-
-func (m *Prepare) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *Prepare) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
-	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
-		i--
-		dAtA[i] = 0x22
+func (this *SnapshotManifestRequest) EqualVT(that *SnapshotManifestRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
-		i--
-		dAtA[i] = 0x18
+	if this.ReplicaId != that.ReplicaId {
+		return false
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x10
+	if this.Height != that.Height {
+		return false
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
-		i--
-		dAtA[i] = 0x8
+	if this.Digest != that.Digest {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Commit) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SnapshotManifestRequest) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*SnapshotManifestRequest)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
-
-func (m *Commit) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *SnapshotManifestResponse) EqualVT(that *SnapshotManifestResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.ReplicaId != that.ReplicaId {
+		return false
+	}
+	if !this.Manifest.EqualVT(that.Manifest) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Commit) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *SnapshotManifestResponse) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*SnapshotManifestResponse)
+	if !ok {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	return this.EqualVT(that)
+}
+func (this *SnapshotChunkRequest) EqualVT(that *SnapshotChunkRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
-		i--
-		dAtA[i] = 0x22
+	if this.ReplicaId != that.ReplicaId {
+		return false
 	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
-		i--
-		dAtA[i] = 0x18
+	if this.Height != that.Height {
+		return false
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x10
+	if this.Digest != that.Digest {
+		return false
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
-		i--
-		dAtA[i] = 0x8
+	if this.Index != that.Index {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *ReBroadcastRequestSet) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SnapshotChunkRequest) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*SnapshotChunkRequest)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReBroadcastRequestSet) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return this.EqualVT(that)
 }
-
-func (m *ReBroadcastRequestSet) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *SnapshotChunkResponse) EqualVT(that *SnapshotChunkResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if this.ReplicaId != that.ReplicaId {
+		return false
 	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Requests[iNdEx])
-			copy(dAtA[i:], m.Requests[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.Requests[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if this.Height != that.Height {
+		return false
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
-		i--
-		dAtA[i] = 0x8
+	if this.Digest != that.Digest {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *HashBatch) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if this.Index != that.Index {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if string(this.Chunk) != string(that.Chunk) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HashBatch) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *HashBatch) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *SnapshotChunkResponse) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*SnapshotChunkResponse)
+	if !ok {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	return this.EqualVT(that)
+}
+func (this *EpochChangeRequest) EqualVT(that *EpochChangeRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if m.Proposer != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Proposer))
-		i--
-		dAtA[i] = 0x20
+	if this.Author != that.Author {
+		return false
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
+	if this.StartEpoch != that.StartEpoch {
+		return false
 	}
-	if len(m.DeDuplicateRequestHashList) > 0 {
-		for iNdEx := len(m.DeDuplicateRequestHashList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DeDuplicateRequestHashList[iNdEx])
-			copy(dAtA[i:], m.DeDuplicateRequestHashList[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.DeDuplicateRequestHashList[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if this.TargetEpoch != that.TargetEpoch {
+		return false
 	}
-	if len(m.RequestHashList) > 0 {
-		for iNdEx := len(m.RequestHashList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequestHashList[iNdEx])
-			copy(dAtA[i:], m.RequestHashList[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RequestHashList[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if this.AuthorP2PNodeId != that.AuthorP2PNodeId {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *FetchCheckpoint) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *EpochChangeRequest) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*EpochChangeRequest)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
-
-func (m *FetchCheckpoint) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *Pset) EqualVT(that *Pset) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if len(this.Set) != len(that.Set) {
+		return false
+	}
+	for i, vx := range this.Set {
+		vy := that.Set[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Prepare{}
+			}
+			if q == nil {
+				q = &Prepare{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *FetchCheckpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *Pset) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Pset)
+	if !ok {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	return this.EqualVT(that)
+}
+func (this *Cset) EqualVT(that *Cset) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
-		i--
-		dAtA[i] = 0x10
+	if len(this.Set) != len(that.Set) {
+		return false
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
-		i--
-		dAtA[i] = 0x8
+	for i, vx := range this.Set {
+		vy := that.Set[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Commit{}
+			}
+			if q == nil {
+				q = &Commit{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *ViewChange) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+func (this *Cset) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Cset)
+	if !ok {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	return this.EqualVT(that)
+}
+func (this *Checkpoint_ExecuteState) EqualVT(that *Checkpoint_ExecuteState) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Height != that.Height {
+		return false
+	}
+	if this.Digest != that.Digest {
+		return false
+	}
+	if this.BatchDigest != that.BatchDigest {
+		return false
+	}
+	if string(this.VrfOutput) != string(that.VrfOutput) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *ViewChange) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *Checkpoint_ExecuteState) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Checkpoint_ExecuteState)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
-
-func (m *ViewChange) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *Checkpoint) EqualVT(that *Checkpoint) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if this.Epoch != that.Epoch {
+		return false
 	}
-	if m.Recovery {
-		i--
-		if m.Recovery {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	if !this.ExecuteState.EqualVT(that.ExecuteState) {
+		return false
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
+	if this.NeedUpdateEpoch != that.NeedUpdateEpoch {
+		return false
 	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x12
+	if !this.ViewChange.EqualVT(that.ViewChange) {
+		return false
 	}
-	if m.Basis != nil {
-		size, err := m.Basis.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(this.ValidatorKeys) != len(that.ValidatorKeys) {
+		return false
+	}
+	for i, vx := range this.ValidatorKeys {
+		vy := that.ValidatorKeys[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &ValidatorInfo{}
+			}
+			if q == nil {
+				q = &ValidatorInfo{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorDynamicInfo) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if len(this.ReputationPenalties) != len(that.ReputationPenalties) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i, vx := range this.ReputationPenalties {
+		vy := that.ReputationPenalties[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &NodeDynamicInfo{}
+			}
+			if q == nil {
+				q = &NodeDynamicInfo{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
 	}
-	return dAtA[:n], nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *ValidatorDynamicInfo) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *Checkpoint) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Checkpoint)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
-
-func (m *ValidatorDynamicInfo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *SignedCheckpoint) EqualVT(that *SignedCheckpoint) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if !this.Checkpoint.EqualVT(that.Checkpoint) {
+		return false
 	}
-	if len(m.Info) > 0 {
-		for iNdEx := len(m.Info) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Info[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0xa
-		}
+	if this.Author != that.Author {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if string(this.Signature) != string(that.Signature) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *VcBasis) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+func (this *SignedCheckpoint) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*SignedCheckpoint)
+	if !ok {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	return this.EqualVT(that)
+}
+func (this *ValidatorInfo) EqualVT(that *ValidatorInfo) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Id != that.Id {
+		return false
+	}
+	if this.P2PId != that.P2PId {
+		return false
+	}
+	if this.KeyVersion != that.KeyVersion {
+		return false
+	}
+	if string(this.PubKey) != string(that.PubKey) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *VcBasis) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *ValidatorInfo) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*ValidatorInfo)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
-
-func (m *VcBasis) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *QuorumCheckpoint) EqualVT(that *QuorumCheckpoint) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if !this.Checkpoint.EqualVT(that.Checkpoint) {
+		return false
 	}
-	if len(m.IfRecoverValidatorDynamicInfo) > 0 {
-		for iNdEx := len(m.IfRecoverValidatorDynamicInfo) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.IfRecoverValidatorDynamicInfo[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x42
-		}
+	if len(this.Signatures) != len(that.Signatures) {
+		return false
 	}
-	if len(m.IfNotRecoverValidatorDynamicInfo) > 0 {
-		for iNdEx := len(m.IfNotRecoverValidatorDynamicInfo) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.IfNotRecoverValidatorDynamicInfo[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x3a
+	for i, vx := range this.Signatures {
+		vy, ok := that.Signatures[i]
+		if !ok {
+			return false
 		}
-	}
-	if len(m.Cset) > 0 {
-		for iNdEx := len(m.Cset) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Cset[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x32
+		if string(vx) != string(vy) {
+			return false
 		}
 	}
-	if len(m.Qset) > 0 {
-		for iNdEx := len(m.Qset) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Qset[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(this.ValidatorSet) != len(that.ValidatorSet) {
+		return false
+	}
+	for i, vx := range this.ValidatorSet {
+		vy, ok := that.ValidatorSet[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &ValidatorInfo{}
+			}
+			if q == nil {
+				q = &ValidatorInfo{}
+			}
+			if !p.EqualVT(q) {
+				return false
 			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.Pset) > 0 {
-		for iNdEx := len(m.Pset) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Pset[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *QuorumCheckpoint) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*QuorumCheckpoint)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *EpochChangeProof) EqualVT(that *EpochChangeProof) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if len(this.EpochChanges) != len(that.EpochChanges) {
+		return false
+	}
+	for i, vx := range this.EpochChanges {
+		vy := that.EpochChanges[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &EpochChange{}
+			}
+			if q == nil {
+				q = &EpochChange{}
+			}
+			if !p.EqualVT(q) {
+				return false
 			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x22
 		}
 	}
-	if m.H != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.H))
-		i--
-		dAtA[i] = 0x18
+	if this.More != that.More {
+		return false
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x10
+	if this.Author != that.Author {
+		return false
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
-		i--
-		dAtA[i] = 0x8
+	if this.GenesisBlockDigest != that.GenesisBlockDigest {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *VcPq) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *EpochChangeProof) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*EpochChangeProof)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *VcPq) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return this.EqualVT(that)
 }
-
-func (m *VcPq) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+func (this *EpochChange) EqualVT(that *EpochChange) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x18
+	if !this.Checkpoint.EqualVT(that.Checkpoint) {
+		return false
 	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
-		i--
-		dAtA[i] = 0x12
+	if !this.Validators.EqualVT(that.Validators) {
+		return false
 	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
-		i--
-		dAtA[i] = 0x8
+	if this.LowWatermark != that.LowWatermark {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *QuorumViewChange) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *EpochChange) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*EpochChange)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuorumViewChange) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return this.EqualVT(that)
 }
-
-func (m *QuorumViewChange) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (this *QuorumValidators) EqualVT(that *QuorumValidators) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if len(this.Validators) != len(that.Validators) {
+		return false
+	}
+	for i, vx := range this.Validators {
+		vy := that.Validators[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &QuorumValidator{}
+			}
+			if q == nil {
+				q = &QuorumValidator{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *QuorumValidators) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*QuorumValidators)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *QuorumValidator) EqualVT(that *QuorumValidator) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Id != that.Id {
+		return false
+	}
+	if this.PeerId != that.PeerId {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *QuorumValidator) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*QuorumValidator)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (m *ConsensusMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ConsensusMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3011,27 +3467,64 @@ func (m *QuorumViewChange) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.ViewChanges) > 0 {
-		for iNdEx := len(m.ViewChanges) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.ViewChanges[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+	if m.AcceptCompression != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.AcceptCompression))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Compression != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Nonce != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarint(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Epoch != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.To != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Type))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NodeDynamicInfo) MarshalVT() (dAtA []byte, err error) {
+func (m *NullRequest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3044,12 +3537,12 @@ func (m *NodeDynamicInfo) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeDynamicInfo) MarshalToVT(dAtA []byte) (int, error) {
+func (m *NullRequest) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *NodeDynamicInfo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *NullRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3061,35 +3554,15 @@ func (m *NodeDynamicInfo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.ConsensusVotingPowerReduceView != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ConsensusVotingPowerReduceView))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ConsensusVotingPowerReduced {
-		i--
-		if m.ConsensusVotingPowerReduced {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ConsensusVotingPower != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ConsensusVotingPower))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Id))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NewView) MarshalVT() (dAtA []byte, err error) {
+func (m *PrePrepare) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3102,12 +3575,12 @@ func (m *NewView) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NewView) MarshalToVT(dAtA []byte) (int, error) {
+func (m *PrePrepare) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *NewView) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *PrePrepare) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3119,42 +3592,20 @@ func (m *NewView) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.ValidatorDynamicInfo) > 0 {
-		for iNdEx := len(m.ValidatorDynamicInfo) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.ValidatorDynamicInfo[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+	if len(m.BftTimeCommits) > 0 {
+		for iNdEx := len(m.BftTimeCommits) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.BftTimeCommits[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.AutoTermUpdate {
-		i--
-		if m.AutoTermUpdate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+			dAtA[i] = 0x32
 		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.FromId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.FromId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x32
 	}
-	if m.QuorumCheckpoint != nil {
-		size, err := m.QuorumCheckpoint.MarshalToSizedBufferVT(dAtA[:i])
+	if m.HashBatch != nil {
+		size, err := m.HashBatch.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3163,27 +3614,17 @@ func (m *NewView) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.ViewChangeSet != nil {
-		size, err := m.ViewChangeSet.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Xset) > 0 {
-		for iNdEx := len(m.Xset) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Xset[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.View != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.View))
@@ -3198,7 +3639,7 @@ func (m *NewView) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchView) MarshalVT() (dAtA []byte, err error) {
+func (m *Prepare) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3211,12 +3652,12 @@ func (m *FetchView) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FetchView) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Prepare) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchView) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Prepare) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3228,6 +3669,25 @@ func (m *FetchView) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.View != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.View))
 		i--
@@ -3241,7 +3701,7 @@ func (m *FetchView) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RecoveryResponse) MarshalVT() (dAtA []byte, err error) {
+func (m *Commit) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3254,12 +3714,12 @@ func (m *RecoveryResponse) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecoveryResponse) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Commit) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *RecoveryResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Commit) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3271,37 +3731,44 @@ func (m *RecoveryResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.GenesisBlockDigest) > 0 {
-		i -= len(m.GenesisBlockDigest)
-		copy(dAtA[i:], m.GenesisBlockDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.GenesisBlockDigest)))
+	if m.Timestamp != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x30
 	}
-	if m.InitialCheckpoint != nil {
-		size, err := m.InitialCheckpoint.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if m.NewView != nil {
-		size, err := m.NewView.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchBatchRequest) MarshalVT() (dAtA []byte, err error) {
+func (m *AggregatedVotes) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3314,12 +3781,12 @@ func (m *FetchBatchRequest) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FetchBatchRequest) MarshalToVT(dAtA []byte) (int, error) {
+func (m *AggregatedVotes) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchBatchRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *AggregatedVotes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3331,12 +3798,29 @@ func (m *FetchBatchRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Commits) > 0 {
+		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Commits[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Prepares) > 0 {
+		for iNdEx := len(m.Prepares) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Prepares[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if m.ReplicaId != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
@@ -3346,7 +3830,7 @@ func (m *FetchBatchRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchBatchResponse) MarshalVT() (dAtA []byte, err error) {
+func (m *DecryptionShare) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3359,12 +3843,12 @@ func (m *FetchBatchResponse) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FetchBatchResponse) MarshalToVT(dAtA []byte) (int, error) {
+func (m *DecryptionShare) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchBatchResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *DecryptionShare) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3376,6 +3860,13 @@ func (m *FetchBatchResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarint(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.BatchDigest) > 0 {
 		i -= len(m.BatchDigest)
 		copy(dAtA[i:], m.BatchDigest)
@@ -3383,15 +3874,10 @@ func (m *FetchBatchResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Batch != nil {
-		size, err := m.Batch.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.ReplicaId != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
@@ -3401,7 +3887,7 @@ func (m *FetchBatchResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestBatch) MarshalVT() (dAtA []byte, err error) {
+func (m *HotStuffQC) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3414,12 +3900,12 @@ func (m *RequestBatch) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RequestBatch) MarshalToVT(dAtA []byte) (int, error) {
+func (m *HotStuffQC) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *RequestBatch) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *HotStuffQC) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3431,63 +3917,44 @@ func (m *RequestBatch) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Proposer != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Proposer))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.BatchHash) > 0 {
-		i -= len(m.BatchHash)
-		copy(dAtA[i:], m.BatchHash)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LocalList) > 0 {
-		for iNdEx := len(m.LocalList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Signatures) > 0 {
+		for k := range m.Signatures {
+			v := m.Signatures[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
 			i--
-			if m.LocalList[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.LocalList)))
-		i--
-		dAtA[i] = 0x2a
 	}
-	if m.SeqNo != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SeqNo))
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarint(dAtA, i, uint64(len(m.BlockHash)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x1a
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Timestamp))
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.RequestList) > 0 {
-		for iNdEx := len(m.RequestList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequestList[iNdEx])
-			copy(dAtA[i:], m.RequestList[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RequestList[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+		dAtA[i] = 0x10
 	}
-	if len(m.RequestHashList) > 0 {
-		for iNdEx := len(m.RequestHashList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequestHashList[iNdEx])
-			copy(dAtA[i:], m.RequestHashList[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RequestHashList[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchMissingRequest) MarshalVT() (dAtA []byte, err error) {
+func (m *HotStuffBlock) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3500,12 +3967,12 @@ func (m *FetchMissingRequest) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FetchMissingRequest) MarshalToVT(dAtA []byte) (int, error) {
+func (m *HotStuffBlock) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchMissingRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *HotStuffBlock) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3517,49 +3984,66 @@ func (m *FetchMissingRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.MissingRequestHashes) > 0 {
-		for k := range m.MissingRequestHashes {
-			v := m.MissingRequestHashes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarint(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Proposer != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Proposer))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HashBatch != nil {
+		size, err := m.HashBatch.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
 	}
 	if len(m.BatchDigest) > 0 {
 		i -= len(m.BatchDigest)
 		copy(dAtA[i:], m.BatchDigest)
 		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
 		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Justify != nil {
+		size, err := m.Justify.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
 		dAtA[i] = 0x22
 	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+	if len(m.ParentHash) > 0 {
+		i -= len(m.ParentHash)
+		copy(dAtA[i:], m.ParentHash)
+		i = encodeVarint(dAtA, i, uint64(len(m.ParentHash)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchMissingResponse) MarshalVT() (dAtA []byte, err error) {
+func (m *HotStuffProposal) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3572,12 +4056,12 @@ func (m *FetchMissingResponse) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FetchMissingResponse) MarshalToVT(dAtA []byte) (int, error) {
+func (m *HotStuffProposal) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchMissingResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *HotStuffProposal) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3589,71 +4073,25 @@ func (m *FetchMissingResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error)
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Status != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Status))
+	if m.Block != nil {
+		size, err := m.Block.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x12
 	}
-	if len(m.MissingRequests) > 0 {
-		for k := range m.MissingRequests {
-			v := m.MissingRequests[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.MissingRequestHashes) > 0 {
-		for k := range m.MissingRequestHashes {
-			v := m.MissingRequestHashes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
-		i--
-		dAtA[i] = 0x8
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchPQCRequest) MarshalVT() (dAtA []byte, err error) {
+func (m *HotStuffVote) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3666,12 +4104,12 @@ func (m *FetchPQCRequest) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FetchPQCRequest) MarshalToVT(dAtA []byte) (int, error) {
+func (m *HotStuffVote) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchPQCRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *HotStuffVote) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3683,8 +4121,27 @@ func (m *FetchPQCRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.H != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.H))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarint(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -3696,7 +4153,7 @@ func (m *FetchPQCRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchPQCResponse) MarshalVT() (dAtA []byte, err error) {
+func (m *HotStuffNewView) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3709,12 +4166,12 @@ func (m *FetchPQCResponse) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FetchPQCResponse) MarshalToVT(dAtA []byte) (int, error) {
+func (m *HotStuffNewView) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchPQCResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *HotStuffNewView) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3726,41 +4183,20 @@ func (m *FetchPQCResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.CmtSet) > 0 {
-		for iNdEx := len(m.CmtSet) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.CmtSet[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.PreSet) > 0 {
-		for iNdEx := len(m.PreSet) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.PreSet[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x1a
+	if m.HighQc != nil {
+		size, err := m.HighQc.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.PrepreSet) > 0 {
-		for iNdEx := len(m.PrepreSet) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.PrepreSet[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.ReplicaId != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
@@ -3770,7 +4206,7 @@ func (m *FetchPQCResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncState) MarshalVT() (dAtA []byte, err error) {
+func (m *HotStuffFetchBlock) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3783,12 +4219,12 @@ func (m *SyncState) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncState) MarshalToVT(dAtA []byte) (int, error) {
+func (m *HotStuffFetchBlock) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *SyncState) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *HotStuffFetchBlock) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3800,17 +4236,22 @@ func (m *SyncState) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.AuthorP2PNodeId) > 0 {
-		i -= len(m.AuthorP2PNodeId)
-		copy(dAtA[i:], m.AuthorP2PNodeId)
-		i = encodeVarint(dAtA, i, uint64(len(m.AuthorP2PNodeId)))
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarint(dAtA, i, uint64(len(m.BlockHash)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncStateResponse) MarshalVT() (dAtA []byte, err error) {
+func (m *HotStuffFetchBlockResponse) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3823,12 +4264,12 @@ func (m *SyncStateResponse) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncStateResponse) MarshalToVT(dAtA []byte) (int, error) {
+func (m *HotStuffFetchBlockResponse) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *SyncStateResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *HotStuffFetchBlockResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3840,20 +4281,15 @@ func (m *SyncStateResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.SignedCheckpoint != nil {
-		size, err := m.SignedCheckpoint.MarshalToSizedBufferVT(dAtA[:i])
+	if m.Block != nil {
+		size, err := m.Block.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.ReplicaId != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
@@ -3863,7 +4299,7 @@ func (m *SyncStateResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EpochChangeRequest) MarshalVT() (dAtA []byte, err error) {
+func (m *ReBroadcastRequestSet) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3876,12 +4312,12 @@ func (m *EpochChangeRequest) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EpochChangeRequest) MarshalToVT(dAtA []byte) (int, error) {
+func (m *ReBroadcastRequestSet) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *EpochChangeRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *ReBroadcastRequestSet) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3893,32 +4329,24 @@ func (m *EpochChangeRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.AuthorP2PNodeId) > 0 {
-		i -= len(m.AuthorP2PNodeId)
-		copy(dAtA[i:], m.AuthorP2PNodeId)
-		i = encodeVarint(dAtA, i, uint64(len(m.AuthorP2PNodeId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.TargetEpoch != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.TargetEpoch))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.StartEpoch != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.StartEpoch))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Requests[iNdEx])
+			copy(dAtA[i:], m.Requests[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Requests[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Author != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Author))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Pset) MarshalVT() (dAtA []byte, err error) {
+func (m *HashBatch) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3931,12 +4359,12 @@ func (m *Pset) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pset) MarshalToVT(dAtA []byte) (int, error) {
+func (m *HashBatch) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Pset) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *HashBatch) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3948,22 +4376,83 @@ func (m *Pset) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Set) > 0 {
-		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Set[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+	if len(m.ReceiveTimes) > 0 {
+		var pksize2 int
+		for _, num := range m.ReceiveTimes {
+			pksize2 += sov(uint64(num))
+		}
+		i -= pksize2
+		j1 := i
+		for _, num1 := range m.ReceiveTimes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BftTime != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.BftTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.KeyRotations) > 0 {
+		for iNdEx := len(m.KeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.KeyRotations[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VrfProof) > 0 {
+		i -= len(m.VrfProof)
+		copy(dAtA[i:], m.VrfProof)
+		i = encodeVarint(dAtA, i, uint64(len(m.VrfProof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Proposer != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Proposer))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DeDuplicateRequestHashList) > 0 {
+		for iNdEx := len(m.DeDuplicateRequestHashList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeDuplicateRequestHashList[iNdEx])
+			copy(dAtA[i:], m.DeDuplicateRequestHashList[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.DeDuplicateRequestHashList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RequestHashList) > 0 {
+		for iNdEx := len(m.RequestHashList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequestHashList[iNdEx])
+			copy(dAtA[i:], m.RequestHashList[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RequestHashList[iNdEx])))
+			i--
 			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Cset) MarshalVT() (dAtA []byte, err error) {
+func (m *FetchCheckpoint) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3976,12 +4465,12 @@ func (m *Cset) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Cset) MarshalToVT(dAtA []byte) (int, error) {
+func (m *FetchCheckpoint) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Cset) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *FetchCheckpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3993,22 +4482,20 @@ func (m *Cset) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Set) > 0 {
-		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Set[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Checkpoint_ExecuteState) MarshalVT() (dAtA []byte, err error) {
+func (m *ViewChange) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4021,12 +4508,12 @@ func (m *Checkpoint_ExecuteState) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Checkpoint_ExecuteState) MarshalToVT(dAtA []byte) (int, error) {
+func (m *ViewChange) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Checkpoint_ExecuteState) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *ViewChange) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4038,29 +4525,52 @@ func (m *Checkpoint_ExecuteState) MarshalToSizedBufferVT(dAtA []byte) (int, erro
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+	if m.LeaderTransfer {
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Digest) > 0 {
-		i -= len(m.Digest)
-		copy(dAtA[i:], m.Digest)
-		i = encodeVarint(dAtA, i, uint64(len(m.Digest)))
+		if m.LeaderTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x28
 	}
-	if m.Height != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Height))
+	if m.Recovery {
 		i--
-		dAtA[i] = 0x8
+		if m.Recovery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Basis != nil {
+		size, err := m.Basis.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Checkpoint) MarshalVT() (dAtA []byte, err error) {
+func (m *LeaderTransfer) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4073,12 +4583,12 @@ func (m *Checkpoint) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Checkpoint) MarshalToVT(dAtA []byte) (int, error) {
+func (m *LeaderTransfer) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Checkpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *LeaderTransfer) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4090,45 +4600,32 @@ func (m *Checkpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.ViewChange != nil {
-		size, err := m.ViewChange.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.NeedUpdateEpoch {
-		i--
-		if m.NeedUpdateEpoch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.ExecuteState != nil {
-		size, err := m.ExecuteState.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Epoch != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Epoch))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SignedCheckpoint) MarshalVT() (dAtA []byte, err error) {
+func (m *KeyRotation) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4141,12 +4638,12 @@ func (m *SignedCheckpoint) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignedCheckpoint) MarshalToVT(dAtA []byte) (int, error) {
+func (m *KeyRotation) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *SignedCheckpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *KeyRotation) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4163,27 +4660,29 @@ func (m *SignedCheckpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.Signature)
 		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarint(dAtA, i, uint64(len(m.PubKey)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Author != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Author))
+	if m.KeyVersion != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.KeyVersion))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Checkpoint != nil {
-		size, err := m.Checkpoint.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorInfo) MarshalVT() (dAtA []byte, err error) {
+func (m *ValidatorKeyState) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4196,12 +4695,12 @@ func (m *ValidatorInfo) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorInfo) MarshalToVT(dAtA []byte) (int, error) {
+func (m *ValidatorKeyState) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *ValidatorInfo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *ValidatorKeyState) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4213,22 +4712,49 @@ func (m *ValidatorInfo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.P2PId) > 0 {
-		i -= len(m.P2PId)
-		copy(dAtA[i:], m.P2PId)
-		i = encodeVarint(dAtA, i, uint64(len(m.P2PId)))
+	if m.Pending != nil {
+		size, err := m.Pending.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PrevUntil != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.PrevUntil))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PrevPubKey) > 0 {
+		i -= len(m.PrevPubKey)
+		copy(dAtA[i:], m.PrevPubKey)
+		i = encodeVarint(dAtA, i, uint64(len(m.PrevPubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PrevKeyVersion != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.PrevKeyVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarint(dAtA, i, uint64(len(m.PubKey)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Id != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Id))
+	if m.KeyVersion != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.KeyVersion))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuorumCheckpoint) MarshalVT() (dAtA []byte, err error) {
+func (m *ValidatorDynamicInfo) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4241,12 +4767,12 @@ func (m *QuorumCheckpoint) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuorumCheckpoint) MarshalToVT(dAtA []byte) (int, error) {
+func (m *ValidatorDynamicInfo) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *QuorumCheckpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *ValidatorDynamicInfo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4258,57 +4784,22 @@ func (m *QuorumCheckpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.ValidatorSet) > 0 {
-		for k := range m.ValidatorSet {
-			v := m.ValidatorSet[k]
-			baseI := i
-			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+	if len(m.Info) > 0 {
+		for iNdEx := len(m.Info) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Info[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0x12
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Signatures) > 0 {
-		for k := range m.Signatures {
-			v := m.Signatures[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Checkpoint != nil {
-		size, err := m.Checkpoint.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EpochChangeProof) MarshalVT() (dAtA []byte, err error) {
+func (m *VcBasis) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4321,12 +4812,12 @@ func (m *EpochChangeProof) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EpochChangeProof) MarshalToVT(dAtA []byte) (int, error) {
+func (m *VcBasis) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *EpochChangeProof) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *VcBasis) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4338,39 +4829,85 @@ func (m *EpochChangeProof) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.GenesisBlockDigest) > 0 {
-		i -= len(m.GenesisBlockDigest)
-		copy(dAtA[i:], m.GenesisBlockDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.GenesisBlockDigest)))
-		i--
-		dAtA[i] = 0x22
+	if len(m.IfRecoverValidatorDynamicInfo) > 0 {
+		for iNdEx := len(m.IfRecoverValidatorDynamicInfo) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.IfRecoverValidatorDynamicInfo[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if m.Author != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Author))
-		i--
-		dAtA[i] = 0x18
+	if len(m.IfNotRecoverValidatorDynamicInfo) > 0 {
+		for iNdEx := len(m.IfNotRecoverValidatorDynamicInfo) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.IfNotRecoverValidatorDynamicInfo[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.More != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.More))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Cset) > 0 {
+		for iNdEx := len(m.Cset) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Cset[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if len(m.EpochChanges) > 0 {
-		for iNdEx := len(m.EpochChanges) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.EpochChanges[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+	if len(m.Qset) > 0 {
+		for iNdEx := len(m.Qset) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Qset[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Pset) > 0 {
+		for iNdEx := len(m.Pset) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Pset[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
+	if m.H != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.H))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EpochChange) MarshalVT() (dAtA []byte, err error) {
+func (m *VcPq) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4383,12 +4920,12 @@ func (m *EpochChange) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EpochChange) MarshalToVT(dAtA []byte) (int, error) {
+func (m *VcPq) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *EpochChange) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *VcPq) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4400,35 +4937,27 @@ func (m *EpochChange) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.LowWatermark != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.LowWatermark))
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.Validators != nil {
-		size, err := m.Validators.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Checkpoint != nil {
-		size, err := m.Checkpoint.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuorumValidators) MarshalVT() (dAtA []byte, err error) {
+func (m *QuorumViewChange) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4441,12 +4970,12 @@ func (m *QuorumValidators) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuorumValidators) MarshalToVT(dAtA []byte) (int, error) {
+func (m *QuorumViewChange) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *QuorumValidators) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *QuorumViewChange) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4458,22 +4987,27 @@ func (m *QuorumValidators) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Validators[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+	if len(m.ViewChanges) > 0 {
+		for iNdEx := len(m.ViewChanges) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.ViewChanges[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuorumValidator) MarshalVT() (dAtA []byte, err error) {
+func (m *NodeDynamicInfo) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4486,12 +5020,12 @@ func (m *QuorumValidator) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuorumValidator) MarshalToVT(dAtA []byte) (int, error) {
+func (m *NodeDynamicInfo) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *QuorumValidator) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *NodeDynamicInfo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4503,12 +5037,30 @@ func (m *QuorumValidator) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.PeerId) > 0 {
-		i -= len(m.PeerId)
-		copy(dAtA[i:], m.PeerId)
-		i = encodeVarint(dAtA, i, uint64(len(m.PeerId)))
+	if m.ReputationPenalty != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReputationPenalty))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x28
+	}
+	if m.ConsensusVotingPowerReduceView != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ConsensusVotingPowerReduceView))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConsensusVotingPowerReduced {
+		i--
+		if m.ConsensusVotingPowerReduced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsensusVotingPower != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ConsensusVotingPower))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.Id != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.Id))
@@ -4518,25 +5070,25 @@ func (m *QuorumValidator) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsensusMessage) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *NewView) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *ConsensusMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *NewView) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *ConsensusMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *NewView) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4548,65 +5100,104 @@ func (m *ConsensusMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Nonce != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Nonce))
+	if len(m.ValidatorDynamicInfo) > 0 {
+		for iNdEx := len(m.ValidatorDynamicInfo) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.ValidatorDynamicInfo[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.AutoTermUpdate {
+		i--
+		if m.AutoTermUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FromId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.FromId))
 		i--
 		dAtA[i] = 0x38
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarint(dAtA, i, uint64(len(m.Payload)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
 		i--
 		dAtA[i] = 0x32
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
+	if m.QuorumCheckpoint != nil {
+		size, err := m.QuorumCheckpoint.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
 	}
-	if m.Epoch != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Epoch))
+	if m.ViewChangeSet != nil {
+		size, err := m.ViewChangeSet.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if m.To != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.To))
-		i--
-		dAtA[i] = 0x18
+	if len(m.Xset) > 0 {
+		for iNdEx := len(m.Xset) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Xset[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.From != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.From))
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Type != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Type))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NullRequest) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *FetchView) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *NullRequest) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *FetchView) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *NullRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *FetchView) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4618,6 +5209,11 @@ func (m *NullRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ReplicaId != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
 		i--
@@ -4626,25 +5222,25 @@ func (m *NullRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PrePrepare) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *RecoveryResponse) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *PrePrepare) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *RecoveryResponse) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *PrePrepare) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *RecoveryResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4656,60 +5252,55 @@ func (m *PrePrepare) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.HashBatch != nil {
-		size, err := m.HashBatch.MarshalToSizedBufferVTStrict(dAtA[:i])
+	if len(m.GenesisBlockDigest) > 0 {
+		i -= len(m.GenesisBlockDigest)
+		copy(dAtA[i:], m.GenesisBlockDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.GenesisBlockDigest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InitialCheckpoint != nil {
+		size, err := m.InitialCheckpoint.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+	if m.NewView != nil {
+		size, err := m.NewView.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Prepare) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *FetchBatchRequest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *Prepare) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *FetchBatchRequest) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Prepare) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *FetchBatchRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4726,17 +5317,7 @@ func (m *Prepare) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.BatchDigest)
 		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.ReplicaId != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
@@ -4746,25 +5327,25 @@ func (m *Prepare) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Commit) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *FetchBatchResponse) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *Commit) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *FetchBatchResponse) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Commit) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *FetchBatchResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4781,17 +5362,17 @@ func (m *Commit) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.BatchDigest)
 		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
-		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
+	if m.Batch != nil {
+		size, err := m.Batch.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.ReplicaId != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
@@ -4801,25 +5382,25 @@ func (m *Commit) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ReBroadcastRequestSet) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *RequestBatch) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *ReBroadcastRequestSet) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *RequestBatch) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *ReBroadcastRequestSet) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *RequestBatch) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4831,55 +5412,64 @@ func (m *ReBroadcastRequestSet) MarshalToSizedBufferVTStrict(dAtA []byte) (int,
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Requests[iNdEx])
-			copy(dAtA[i:], m.Requests[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.Requests[iNdEx])))
+	if m.BftTime != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.BftTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.KeyRotations) > 0 {
+		for iNdEx := len(m.KeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.KeyRotations[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x52
 		}
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+	if len(m.VrfOutput) > 0 {
+		i -= len(m.VrfOutput)
+		copy(dAtA[i:], m.VrfOutput)
+		i = encodeVarint(dAtA, i, uint64(len(m.VrfOutput)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x4a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *HashBatch) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if len(m.VrfProof) > 0 {
+		i -= len(m.VrfProof)
+		copy(dAtA[i:], m.VrfProof)
+		i = encodeVarint(dAtA, i, uint64(len(m.VrfProof)))
+		i--
+		dAtA[i] = 0x42
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Proposer != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Proposer))
+		i--
+		dAtA[i] = 0x38
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HashBatch) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
-}
-
-func (m *HashBatch) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	if len(m.BatchHash) > 0 {
+		i -= len(m.BatchHash)
+		copy(dAtA[i:], m.BatchHash)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if len(m.LocalList) > 0 {
+		for iNdEx := len(m.LocalList) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.LocalList[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.LocalList)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Proposer != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Proposer))
+	if m.SeqNo != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SeqNo))
 		i--
 		dAtA[i] = 0x20
 	}
@@ -4888,11 +5478,11 @@ func (m *HashBatch) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.DeDuplicateRequestHashList) > 0 {
-		for iNdEx := len(m.DeDuplicateRequestHashList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DeDuplicateRequestHashList[iNdEx])
-			copy(dAtA[i:], m.DeDuplicateRequestHashList[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.DeDuplicateRequestHashList[iNdEx])))
+	if len(m.RequestList) > 0 {
+		for iNdEx := len(m.RequestList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequestList[iNdEx])
+			copy(dAtA[i:], m.RequestList[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RequestList[iNdEx])))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -4909,25 +5499,25 @@ func (m *HashBatch) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchCheckpoint) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *FetchMissingRequest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *FetchCheckpoint) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *FetchMissingRequest) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchCheckpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *FetchMissingRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4939,9 +5529,38 @@ func (m *FetchCheckpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.MissingRequestHashes) > 0 {
+		for k := range m.MissingRequestHashes {
+			v := m.MissingRequestHashes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.SequenceNumber != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
 		i--
+		dAtA[i] = 0x18
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
 		dAtA[i] = 0x10
 	}
 	if m.ReplicaId != 0 {
@@ -4952,25 +5571,25 @@ func (m *FetchCheckpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ViewChange) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *FetchMissingResponse) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *ViewChange) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *FetchMissingResponse) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *ViewChange) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *FetchMissingResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4982,60 +5601,89 @@ func (m *ViewChange) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Recovery {
+	if m.Status != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Status))
 		i--
-		if m.Recovery {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x38
+	}
+	if len(m.MissingRequests) > 0 {
+		for k := range m.MissingRequests {
+			v := m.MissingRequests[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MissingRequestHashes) > 0 {
+		for k := range m.MissingRequestHashes {
+			v := m.MissingRequestHashes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
 		}
+	}
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Timestamp))
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Basis != nil {
-		size, err := m.Basis.MarshalToSizedBufferVTStrict(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorDynamicInfo) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *FetchPQCRequest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorDynamicInfo) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *FetchPQCRequest) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *ValidatorDynamicInfo) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *FetchPQCRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5047,40 +5695,38 @@ func (m *ValidatorDynamicInfo) MarshalToSizedBufferVTStrict(dAtA []byte) (int, e
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Info) > 0 {
-		for iNdEx := len(m.Info) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Info[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.H != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.H))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VcBasis) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *FetchPQCResponse) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *VcBasis) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *FetchPQCResponse) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *VcBasis) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *FetchPQCResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5092,76 +5738,42 @@ func (m *VcBasis) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.IfRecoverValidatorDynamicInfo) > 0 {
-		for iNdEx := len(m.IfRecoverValidatorDynamicInfo) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.IfRecoverValidatorDynamicInfo[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.IfNotRecoverValidatorDynamicInfo) > 0 {
-		for iNdEx := len(m.IfNotRecoverValidatorDynamicInfo) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.IfNotRecoverValidatorDynamicInfo[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.Cset) > 0 {
-		for iNdEx := len(m.Cset) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Cset[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+	if len(m.CmtSet) > 0 {
+		for iNdEx := len(m.CmtSet) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.CmtSet[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.Qset) > 0 {
-		for iNdEx := len(m.Qset) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Qset[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+	if len(m.PreSet) > 0 {
+		for iNdEx := len(m.PreSet) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.PreSet[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Pset) > 0 {
-		for iNdEx := len(m.Pset) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Pset[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+	if len(m.PrepreSet) > 0 {
+		for iNdEx := len(m.PrepreSet) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.PrepreSet[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x12
 		}
 	}
-	if m.H != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.H))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x10
-	}
 	if m.ReplicaId != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
 		i--
@@ -5170,25 +5782,25 @@ func (m *VcBasis) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VcPq) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *SyncState) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *VcPq) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *SyncState) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *VcPq) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *SyncState) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5200,45 +5812,35 @@ func (m *VcPq) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+	if len(m.AuthorP2PNodeId) > 0 {
+		i -= len(m.AuthorP2PNodeId)
+		copy(dAtA[i:], m.AuthorP2PNodeId)
+		i = encodeVarint(dAtA, i, uint64(len(m.AuthorP2PNodeId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuorumViewChange) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *SyncStateResponse) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *QuorumViewChange) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *SyncStateResponse) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *QuorumViewChange) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *SyncStateResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5250,17 +5852,20 @@ func (m *QuorumViewChange) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.ViewChanges) > 0 {
-		for iNdEx := len(m.ViewChanges) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.ViewChanges[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x12
+	if m.SignedCheckpoint != nil {
+		size, err := m.SignedCheckpoint.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.ReplicaId != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
@@ -5270,25 +5875,25 @@ func (m *QuorumViewChange) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *NodeDynamicInfo) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *SnapshotManifest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *NodeDynamicInfo) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *SnapshotManifest) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *NodeDynamicInfo) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *SnapshotManifest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5300,53 +5905,56 @@ func (m *NodeDynamicInfo) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.ConsensusVotingPowerReduceView != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ConsensusVotingPowerReduceView))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarint(dAtA, i, uint64(len(m.Metadata)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if m.ConsensusVotingPowerReduced {
-		i--
-		if m.ConsensusVotingPowerReduced {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.ChunkHashes) > 0 {
+		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChunkHashes[iNdEx])
+			copy(dAtA[i:], m.ChunkHashes[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.ChunkHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.ConsensusVotingPower != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ConsensusVotingPower))
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarint(dAtA, i, uint64(len(m.Digest)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Id != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Id))
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NewView) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *SnapshotManifestRequest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *NewView) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *SnapshotManifestRequest) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *NewView) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *SnapshotManifestRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5358,74 +5966,15 @@ func (m *NewView) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.ValidatorDynamicInfo) > 0 {
-		for iNdEx := len(m.ValidatorDynamicInfo) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.ValidatorDynamicInfo[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.AutoTermUpdate {
-		i--
-		if m.AutoTermUpdate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.FromId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.FromId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.QuorumCheckpoint != nil {
-		size, err := m.QuorumCheckpoint.MarshalToSizedBufferVTStrict(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ViewChangeSet != nil {
-		size, err := m.ViewChangeSet.MarshalToSizedBufferVTStrict(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarint(dAtA, i, uint64(len(m.Digest)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Xset) > 0 {
-		for iNdEx := len(m.Xset) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Xset[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x1a
-		}
+		dAtA[i] = 0x1a
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -5437,25 +5986,25 @@ func (m *NewView) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchView) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *SnapshotManifestResponse) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *FetchView) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *SnapshotManifestResponse) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchView) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *SnapshotManifestResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5467,10 +6016,15 @@ func (m *FetchView) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
+	if m.Manifest != nil {
+		size, err := m.Manifest.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.ReplicaId != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
@@ -5480,25 +6034,25 @@ func (m *FetchView) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RecoveryResponse) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *SnapshotChunkRequest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *RecoveryResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *SnapshotChunkRequest) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *RecoveryResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *SnapshotChunkRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5510,55 +6064,50 @@ func (m *RecoveryResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.GenesisBlockDigest) > 0 {
-		i -= len(m.GenesisBlockDigest)
-		copy(dAtA[i:], m.GenesisBlockDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.GenesisBlockDigest)))
+	if m.Index != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarint(dAtA, i, uint64(len(m.Digest)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.InitialCheckpoint != nil {
-		size, err := m.InitialCheckpoint.MarshalToSizedBufferVTStrict(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.NewView != nil {
-		size, err := m.NewView.MarshalToSizedBufferVTStrict(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchBatchRequest) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *SnapshotChunkResponse) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *FetchBatchRequest) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *SnapshotChunkResponse) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchBatchRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *SnapshotChunkResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5570,12 +6119,29 @@ func (m *FetchBatchRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, erro
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarint(dAtA, i, uint64(len(m.Chunk)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
+	}
+	if m.Index != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarint(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.ReplicaId != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
@@ -5585,25 +6151,25 @@ func (m *FetchBatchRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchBatchResponse) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *EpochChangeRequest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *FetchBatchResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *EpochChangeRequest) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchBatchResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *EpochChangeRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5615,50 +6181,50 @@ func (m *FetchBatchResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, err
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+	if len(m.AuthorP2PNodeId) > 0 {
+		i -= len(m.AuthorP2PNodeId)
+		copy(dAtA[i:], m.AuthorP2PNodeId)
+		i = encodeVarint(dAtA, i, uint64(len(m.AuthorP2PNodeId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if m.Batch != nil {
-		size, err := m.Batch.MarshalToSizedBufferVTStrict(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.TargetEpoch != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.TargetEpoch))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+	if m.StartEpoch != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Author != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Author))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestBatch) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *Pset) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *RequestBatch) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *Pset) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *RequestBatch) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *Pset) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5670,55 +6236,14 @@ func (m *RequestBatch) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Proposer != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Proposer))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.BatchHash) > 0 {
-		i -= len(m.BatchHash)
-		copy(dAtA[i:], m.BatchHash)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LocalList) > 0 {
-		for iNdEx := len(m.LocalList) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.LocalList[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+	if len(m.Set) > 0 {
+		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Set[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.LocalList)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.SeqNo != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SeqNo))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.RequestList) > 0 {
-		for iNdEx := len(m.RequestList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequestList[iNdEx])
-			copy(dAtA[i:], m.RequestList[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RequestList[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.RequestHashList) > 0 {
-		for iNdEx := len(m.RequestHashList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RequestHashList[iNdEx])
-			copy(dAtA[i:], m.RequestHashList[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RequestHashList[iNdEx])))
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5726,25 +6251,25 @@ func (m *RequestBatch) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchMissingRequest) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *Cset) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *FetchMissingRequest) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *Cset) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchMissingRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *Cset) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5756,67 +6281,40 @@ func (m *FetchMissingRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, er
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.MissingRequestHashes) > 0 {
-		for k := range m.MissingRequestHashes {
-			v := m.MissingRequestHashes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
+	if len(m.Set) > 0 {
+		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Set[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchMissingResponse) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *Checkpoint_ExecuteState) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *FetchMissingResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *Checkpoint_ExecuteState) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchMissingResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *Checkpoint_ExecuteState) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5828,132 +6326,54 @@ func (m *FetchMissingResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, e
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Status != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Status))
+	if len(m.VrfOutput) > 0 {
+		i -= len(m.VrfOutput)
+		copy(dAtA[i:], m.VrfOutput)
+		i = encodeVarint(dAtA, i, uint64(len(m.VrfOutput)))
 		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.MissingRequests) > 0 {
-		for k := range m.MissingRequests {
-			v := m.MissingRequests[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.MissingRequestHashes) > 0 {
-		for k := range m.MissingRequestHashes {
-			v := m.MissingRequestHashes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
+		dAtA[i] = 0x22
 	}
 	if len(m.BatchDigest) > 0 {
 		i -= len(m.BatchDigest)
 		copy(dAtA[i:], m.BatchDigest)
 		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.SequenceNumber != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FetchPQCRequest) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *FetchPQCRequest) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
-}
-
-func (m *FetchPQCRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+		dAtA[i] = 0x1a
 	}
-	if m.H != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.H))
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarint(dAtA, i, uint64(len(m.Digest)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FetchPQCResponse) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *Checkpoint) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *FetchPQCResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *Checkpoint) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *FetchPQCResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *Checkpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -5965,69 +6385,87 @@ func (m *FetchPQCResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.CmtSet) > 0 {
-		for iNdEx := len(m.CmtSet) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.CmtSet[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+	if len(m.ReputationPenalties) > 0 {
+		for iNdEx := len(m.ReputationPenalties) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.ReputationPenalties[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.PreSet) > 0 {
-		for iNdEx := len(m.PreSet) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.PreSet[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+	if len(m.ValidatorKeys) > 0 {
+		for iNdEx := len(m.ValidatorKeys) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.ValidatorKeys[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.PrepreSet) > 0 {
-		for iNdEx := len(m.PrepreSet) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.PrepreSet[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x12
+	if m.ViewChange != nil {
+		size, err := m.ViewChange.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+	if m.NeedUpdateEpoch {
+		i--
+		if m.NeedUpdateEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecuteState != nil {
+		size, err := m.ExecuteState.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Epoch))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncState) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *SignedCheckpoint) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *SyncState) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *SignedCheckpoint) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *SyncState) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *SignedCheckpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6039,35 +6477,50 @@ func (m *SyncState) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.AuthorP2PNodeId) > 0 {
-		i -= len(m.AuthorP2PNodeId)
-		copy(dAtA[i:], m.AuthorP2PNodeId)
-		i = encodeVarint(dAtA, i, uint64(len(m.AuthorP2PNodeId)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Author != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Author))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Checkpoint != nil {
+		size, err := m.Checkpoint.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncStateResponse) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *ValidatorInfo) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *SyncStateResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *ValidatorInfo) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *SyncStateResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *ValidatorInfo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6079,48 +6532,52 @@ func (m *SyncStateResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, erro
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.SignedCheckpoint != nil {
-		size, err := m.SignedCheckpoint.MarshalToSizedBufferVTStrict(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarint(dAtA, i, uint64(len(m.PubKey)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if m.View != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.View))
+	if m.KeyVersion != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.KeyVersion))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
-	if m.ReplicaId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+	if len(m.P2PId) > 0 {
+		i -= len(m.P2PId)
+		copy(dAtA[i:], m.P2PId)
+		i = encodeVarint(dAtA, i, uint64(len(m.P2PId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EpochChangeRequest) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *QuorumCheckpoint) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *EpochChangeRequest) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *QuorumCheckpoint) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *EpochChangeRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *QuorumCheckpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6132,50 +6589,75 @@ func (m *EpochChangeRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, err
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.AuthorP2PNodeId) > 0 {
-		i -= len(m.AuthorP2PNodeId)
-		copy(dAtA[i:], m.AuthorP2PNodeId)
-		i = encodeVarint(dAtA, i, uint64(len(m.AuthorP2PNodeId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.TargetEpoch != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.TargetEpoch))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.StartEpoch != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.StartEpoch))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Author != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Author))
+	if len(m.ValidatorSet) > 0 {
+		for k := range m.ValidatorSet {
+			v := m.ValidatorSet[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Signatures) > 0 {
+		for k := range m.Signatures {
+			v := m.Signatures[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Checkpoint != nil {
+		size, err := m.Checkpoint.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Pset) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *EpochChangeProof) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *Pset) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *EpochChangeProof) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Pset) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *EpochChangeProof) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6187,9 +6669,26 @@ func (m *Pset) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Set) > 0 {
-		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Set[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+	if len(m.GenesisBlockDigest) > 0 {
+		i -= len(m.GenesisBlockDigest)
+		copy(dAtA[i:], m.GenesisBlockDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.GenesisBlockDigest)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Author != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Author))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.More != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.More))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochChanges) > 0 {
+		for iNdEx := len(m.EpochChanges) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.EpochChanges[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6202,25 +6701,25 @@ func (m *Pset) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Cset) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *EpochChange) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *Cset) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *EpochChange) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Cset) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *EpochChange) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6232,9 +6731,67 @@ func (m *Cset) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Set) > 0 {
-		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Set[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+	if m.LowWatermark != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.LowWatermark))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Validators != nil {
+		size, err := m.Validators.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Checkpoint != nil {
+		size, err := m.Checkpoint.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumValidators) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumValidators) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *QuorumValidators) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Validators[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6247,25 +6804,25 @@ func (m *Cset) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Checkpoint_ExecuteState) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *QuorumValidator) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *Checkpoint_ExecuteState) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *QuorumValidator) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Checkpoint_ExecuteState) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *QuorumValidator) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6277,29 +6834,22 @@ func (m *Checkpoint_ExecuteState) MarshalToSizedBufferVTStrict(dAtA []byte) (int
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.BatchDigest) > 0 {
-		i -= len(m.BatchDigest)
-		copy(dAtA[i:], m.BatchDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Digest) > 0 {
-		i -= len(m.Digest)
-		copy(dAtA[i:], m.Digest)
-		i = encodeVarint(dAtA, i, uint64(len(m.Digest)))
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarint(dAtA, i, uint64(len(m.PeerId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Height != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Height))
+	if m.Id != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Checkpoint) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *ConsensusMessage) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -6312,12 +6862,12 @@ func (m *Checkpoint) MarshalVTStrict() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Checkpoint) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *ConsensusMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
 }
 
-func (m *Checkpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *ConsensusMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6329,45 +6879,64 @@ func (m *Checkpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.ViewChange != nil {
-		size, err := m.ViewChange.MarshalToSizedBufferVTStrict(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x52
 	}
-	if m.NeedUpdateEpoch {
+	if m.AcceptCompression != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.AcceptCompression))
 		i--
-		if m.NeedUpdateEpoch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x48
+	}
+	if m.Compression != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Compression))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x40
 	}
-	if m.ExecuteState != nil {
-		size, err := m.ExecuteState.MarshalToSizedBufferVTStrict(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.Nonce != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Nonce))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x38
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarint(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.Epoch != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.Epoch))
 		i--
+		dAtA[i] = 0x20
+	}
+	if m.To != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Type))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SignedCheckpoint) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *NullRequest) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -6380,12 +6949,12 @@ func (m *SignedCheckpoint) MarshalVTStrict() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignedCheckpoint) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *NullRequest) MarshalToVTStrict(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
 }
 
-func (m *SignedCheckpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *NullRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6397,77 +6966,15 @@ func (m *SignedCheckpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Author != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Author))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Checkpoint != nil {
-		size, err := m.Checkpoint.MarshalToSizedBufferVTStrict(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorInfo) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorInfo) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
-}
-
-func (m *ValidatorInfo) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
-	}
-	if len(m.P2PId) > 0 {
-		i -= len(m.P2PId)
-		copy(dAtA[i:], m.P2PId)
-		i = encodeVarint(dAtA, i, uint64(len(m.P2PId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Id))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuorumCheckpoint) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *PrePrepare) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -6480,12 +6987,12 @@ func (m *QuorumCheckpoint) MarshalVTStrict() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuorumCheckpoint) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *PrePrepare) MarshalToVTStrict(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
 }
 
-func (m *QuorumCheckpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *PrePrepare) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6497,57 +7004,54 @@ func (m *QuorumCheckpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.ValidatorSet) > 0 {
-		for k := range m.ValidatorSet {
-			v := m.ValidatorSet[k]
-			baseI := i
-			size, err := v.MarshalToSizedBufferVTStrict(dAtA[:i])
+	if len(m.BftTimeCommits) > 0 {
+		for iNdEx := len(m.BftTimeCommits) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.BftTimeCommits[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0x12
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Signatures) > 0 {
-		for k := range m.Signatures {
-			v := m.Signatures[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x32
 		}
 	}
-	if m.Checkpoint != nil {
-		size, err := m.Checkpoint.MarshalToSizedBufferVTStrict(dAtA[:i])
+	if m.HashBatch != nil {
+		size, err := m.HashBatch.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
+	}
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EpochChangeProof) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *Prepare) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -6560,12 +7064,12 @@ func (m *EpochChangeProof) MarshalVTStrict() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EpochChangeProof) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *Prepare) MarshalToVTStrict(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
 }
 
-func (m *EpochChangeProof) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *Prepare) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6577,39 +7081,39 @@ func (m *EpochChangeProof) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.GenesisBlockDigest) > 0 {
-		i -= len(m.GenesisBlockDigest)
-		copy(dAtA[i:], m.GenesisBlockDigest)
-		i = encodeVarint(dAtA, i, uint64(len(m.GenesisBlockDigest)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.Author != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Author))
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.More != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.More))
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.EpochChanges) > 0 {
-		for iNdEx := len(m.EpochChanges) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.EpochChanges[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EpochChange) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *Commit) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -6622,12 +7126,12 @@ func (m *EpochChange) MarshalVTStrict() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EpochChange) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *Commit) MarshalToVTStrict(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
 }
 
-func (m *EpochChange) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *Commit) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6639,35 +7143,44 @@ func (m *EpochChange) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.LowWatermark != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.LowWatermark))
+	if m.Timestamp != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.Validators != nil {
-		size, err := m.Validators.MarshalToSizedBufferVTStrict(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Checkpoint != nil {
-		size, err := m.Checkpoint.MarshalToSizedBufferVTStrict(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuorumValidators) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *AggregatedVotes) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -6680,12 +7193,12 @@ func (m *QuorumValidators) MarshalVTStrict() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuorumValidators) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *AggregatedVotes) MarshalToVTStrict(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
 }
 
-func (m *QuorumValidators) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *AggregatedVotes) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6697,22 +7210,39 @@ func (m *QuorumValidators) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Validators[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+	if len(m.Commits) > 0 {
+		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Commits[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Prepares) > 0 {
+		for iNdEx := len(m.Prepares) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Prepares[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuorumValidator) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *DecryptionShare) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -6725,12 +7255,12 @@ func (m *QuorumValidator) MarshalVTStrict() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuorumValidator) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *DecryptionShare) MarshalToVTStrict(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
 }
 
-func (m *QuorumValidator) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *DecryptionShare) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -6742,922 +7272,6523 @@ func (m *QuorumValidator) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.PeerId) > 0 {
-		i -= len(m.PeerId)
-		copy(dAtA[i:], m.PeerId)
-		i = encodeVarint(dAtA, i, uint64(len(m.PeerId)))
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarint(dAtA, i, uint64(len(m.Share)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if m.Id != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsensusMessage) SizeVT() (n int) {
+func (m *HotStuffQC) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HotStuffQC) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *HotStuffQC) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sov(uint64(m.Type))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.From != 0 {
-		n += 1 + sov(uint64(m.From))
+	if len(m.Signatures) > 0 {
+		for k := range m.Signatures {
+			v := m.Signatures[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.To != 0 {
-		n += 1 + sov(uint64(m.To))
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarint(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Epoch != 0 {
-		n += 1 + sov(uint64(m.Epoch))
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.View != 0 {
-		n += 1 + sov(uint64(m.View))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sov(uint64(m.Nonce))
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x8
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NullRequest) SizeVT() (n int) {
+func (m *HotStuffBlock) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
 	}
-	var l int
-	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PrePrepare) SizeVT() (n int) {
+func (m *HotStuffBlock) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *HotStuffBlock) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
-	}
-	if m.View != 0 {
-		n += 1 + sov(uint64(m.View))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.SequenceNumber != 0 {
-		n += 1 + sov(uint64(m.SequenceNumber))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarint(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.BatchDigest)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.Proposer != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Proposer))
+		i--
+		dAtA[i] = 0x38
 	}
 	if m.HashBatch != nil {
-		l = m.HashBatch.SizeVT()
-		n += 1 + l + sov(uint64(l))
+		size, err := m.HashBatch.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *Prepare) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
+	if m.Justify != nil {
+		size, err := m.Justify.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ParentHash) > 0 {
+		i -= len(m.ParentHash)
+		copy(dAtA[i:], m.ParentHash)
+		i = encodeVarint(dAtA, i, uint64(len(m.ParentHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.View != 0 {
-		n += 1 + sov(uint64(m.View))
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.SequenceNumber != 0 {
-		n += 1 + sov(uint64(m.SequenceNumber))
+	return len(dAtA) - i, nil
+}
+
+func (m *HotStuffProposal) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
 	}
-	l = len(m.BatchDigest)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commit) SizeVT() (n int) {
+func (m *HotStuffProposal) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *HotStuffProposal) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
-	}
-	if m.View != 0 {
-		n += 1 + sov(uint64(m.View))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.SequenceNumber != 0 {
-		n += 1 + sov(uint64(m.SequenceNumber))
+	if m.Block != nil {
+		size, err := m.Block.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.BatchDigest)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReBroadcastRequestSet) SizeVT() (n int) {
+func (m *HotStuffVote) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
+		return nil, nil
 	}
-	if len(m.Requests) > 0 {
-		for _, b := range m.Requests {
-			l = len(b)
-			n += 1 + l + sov(uint64(l))
-		}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *HashBatch) SizeVT() (n int) {
+func (m *HotStuffVote) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *HotStuffVote) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RequestHashList) > 0 {
-		for _, s := range m.RequestHashList {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
-		}
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.DeDuplicateRequestHashList) > 0 {
-		for _, s := range m.DeDuplicateRequestHashList {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
-		}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sov(uint64(m.Timestamp))
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarint(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Proposer != 0 {
-		n += 1 + sov(uint64(m.Proposer))
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	n += len(m.unknownFields)
-	return n
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FetchCheckpoint) SizeVT() (n int) {
+func (m *HotStuffNewView) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
+		return nil, nil
 	}
-	if m.SequenceNumber != 0 {
-		n += 1 + sov(uint64(m.SequenceNumber))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ViewChange) SizeVT() (n int) {
+func (m *HotStuffNewView) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *HotStuffNewView) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Basis != nil {
-		l = m.Basis.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.HighQc != nil {
+		size, err := m.HighQc.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sov(uint64(m.Timestamp))
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Recovery {
-		n += 2
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorDynamicInfo) SizeVT() (n int) {
+func (m *HotStuffFetchBlock) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
 	}
-	var l int
-	_ = l
-	if len(m.Info) > 0 {
-		for _, e := range m.Info {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *VcBasis) SizeVT() (n int) {
+func (m *HotStuffFetchBlock) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *HotStuffFetchBlock) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
-	}
-	if m.View != 0 {
-		n += 1 + sov(uint64(m.View))
-	}
-	if m.H != 0 {
-		n += 1 + sov(uint64(m.H))
-	}
-	if len(m.Pset) > 0 {
-		for _, e := range m.Pset {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Qset) > 0 {
-		for _, e := range m.Qset {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarint(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Cset) > 0 {
-		for _, e := range m.Cset {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.IfNotRecoverValidatorDynamicInfo) > 0 {
-		for _, e := range m.IfNotRecoverValidatorDynamicInfo {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *HotStuffFetchBlockResponse) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
 	}
-	if len(m.IfRecoverValidatorDynamicInfo) > 0 {
-		for _, e := range m.IfRecoverValidatorDynamicInfo {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *VcPq) SizeVT() (n int) {
+func (m *HotStuffFetchBlockResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *HotStuffFetchBlockResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SequenceNumber != 0 {
-		n += 1 + sov(uint64(m.SequenceNumber))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	l = len(m.BatchDigest)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.Block != nil {
+		size, err := m.Block.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.View != 0 {
-		n += 1 + sov(uint64(m.View))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuorumViewChange) SizeVT() (n int) {
+func (m *ReBroadcastRequestSet) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
+		return nil, nil
 	}
-	if len(m.ViewChanges) > 0 {
-		for _, e := range m.ViewChanges {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NodeDynamicInfo) SizeVT() (n int) {
+func (m *ReBroadcastRequestSet) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *ReBroadcastRequestSet) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sov(uint64(m.Id))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.ConsensusVotingPower != 0 {
-		n += 1 + sov(uint64(m.ConsensusVotingPower))
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Requests[iNdEx])
+			copy(dAtA[i:], m.Requests[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Requests[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.ConsensusVotingPowerReduced {
-		n += 2
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.ConsensusVotingPowerReduceView != 0 {
-		n += 1 + sov(uint64(m.ConsensusVotingPowerReduceView))
+	return len(dAtA) - i, nil
+}
+
+func (m *HashBatch) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
 	}
-	n += len(m.unknownFields)
-	return n
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *NewView) SizeVT() (n int) {
+func (m *HashBatch) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *HashBatch) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
-	}
-	if m.View != 0 {
-		n += 1 + sov(uint64(m.View))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Xset) > 0 {
-		for _, e := range m.Xset {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
+	if len(m.ReceiveTimes) > 0 {
+		var pksize2 int
+		for _, num := range m.ReceiveTimes {
+			pksize2 += sov(uint64(num))
+		}
+		i -= pksize2
+		j1 := i
+		for _, num1 := range m.ReceiveTimes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
 		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.ViewChangeSet != nil {
-		l = m.ViewChangeSet.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.BftTime != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.BftTime))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.QuorumCheckpoint != nil {
-		l = m.QuorumCheckpoint.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if len(m.KeyRotations) > 0 {
+		for iNdEx := len(m.KeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.KeyRotations[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if len(m.VrfProof) > 0 {
+		i -= len(m.VrfProof)
+		copy(dAtA[i:], m.VrfProof)
+		i = encodeVarint(dAtA, i, uint64(len(m.VrfProof)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.FromId != 0 {
-		n += 1 + sov(uint64(m.FromId))
+	if m.Proposer != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Proposer))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.AutoTermUpdate {
-		n += 2
+	if m.Timestamp != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.ValidatorDynamicInfo) > 0 {
-		for _, e := range m.ValidatorDynamicInfo {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
+	if len(m.DeDuplicateRequestHashList) > 0 {
+		for iNdEx := len(m.DeDuplicateRequestHashList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeDuplicateRequestHashList[iNdEx])
+			copy(dAtA[i:], m.DeDuplicateRequestHashList[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.DeDuplicateRequestHashList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	n += len(m.unknownFields)
-	return n
+	if len(m.RequestHashList) > 0 {
+		for iNdEx := len(m.RequestHashList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequestHashList[iNdEx])
+			copy(dAtA[i:], m.RequestHashList[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RequestHashList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FetchView) SizeVT() (n int) {
+func (m *FetchCheckpoint) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
+		return nil, nil
 	}
-	if m.View != 0 {
-		n += 1 + sov(uint64(m.View))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RecoveryResponse) SizeVT() (n int) {
+func (m *FetchCheckpoint) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *FetchCheckpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NewView != nil {
-		l = m.NewView.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.InitialCheckpoint != nil {
-		l = m.InitialCheckpoint.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.GenesisBlockDigest)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FetchBatchRequest) SizeVT() (n int) {
+func (m *ViewChange) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
+		return nil, nil
 	}
-	l = len(m.BatchDigest)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FetchBatchResponse) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
-	}
-	if m.Batch != nil {
-		l = m.Batch.SizeVT()
-		n += 1 + l + sov(uint64(l))
-	}
-	l = len(m.BatchDigest)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	n += len(m.unknownFields)
-	return n
+func (m *ViewChange) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
 }
 
-func (m *RequestBatch) SizeVT() (n int) {
+func (m *ViewChange) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RequestHashList) > 0 {
-		for _, s := range m.RequestHashList {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.LeaderTransfer {
+		i--
+		if m.LeaderTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.RequestList) > 0 {
-		for _, b := range m.RequestList {
-			l = len(b)
-			n += 1 + l + sov(uint64(l))
+	if m.Recovery {
+		i--
+		if m.Recovery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Timestamp != 0 {
-		n += 1 + sov(uint64(m.Timestamp))
-	}
-	if m.SeqNo != 0 {
-		n += 1 + sov(uint64(m.SeqNo))
+		i = encodeVarint(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.LocalList) > 0 {
-		n += 1 + sov(uint64(len(m.LocalList))) + len(m.LocalList)*1
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.BatchHash)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.Proposer != 0 {
-		n += 1 + sov(uint64(m.Proposer))
+	if m.Basis != nil {
+		size, err := m.Basis.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FetchMissingRequest) SizeVT() (n int) {
+func (m *LeaderTransfer) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
-	}
-	if m.View != 0 {
-		n += 1 + sov(uint64(m.View))
-	}
-	if m.SequenceNumber != 0 {
-		n += 1 + sov(uint64(m.SequenceNumber))
-	}
-	l = len(m.BatchDigest)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+		return nil, nil
 	}
-	if len(m.MissingRequestHashes) > 0 {
-		for k, v := range m.MissingRequestHashes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + len(v) + sov(uint64(len(v)))
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
-		}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FetchMissingResponse) SizeVT() (n int) {
+func (m *LeaderTransfer) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *LeaderTransfer) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.View != 0 {
-		n += 1 + sov(uint64(m.View))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.SequenceNumber != 0 {
-		n += 1 + sov(uint64(m.SequenceNumber))
-	}
-	l = len(m.BatchDigest)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if len(m.MissingRequestHashes) > 0 {
-		for k, v := range m.MissingRequestHashes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + len(v) + sov(uint64(len(v)))
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
-		}
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.MissingRequests) > 0 {
-		for k, v := range m.MissingRequests {
-			_ = k
-			_ = v
-			l = 1 + len(v) + sov(uint64(len(v)))
-			mapEntrySize := 1 + sov(uint64(k)) + l
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
-		}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Status != 0 {
-		n += 1 + sov(uint64(m.Status))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FetchPQCRequest) SizeVT() (n int) {
+func (m *KeyRotation) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
+		return nil, nil
 	}
-	if m.H != 0 {
-		n += 1 + sov(uint64(m.H))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FetchPQCResponse) SizeVT() (n int) {
+func (m *KeyRotation) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *KeyRotation) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.PrepreSet) > 0 {
-		for _, e := range m.PrepreSet {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.PreSet) > 0 {
-		for _, e := range m.PreSet {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarint(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.CmtSet) > 0 {
-		for _, e := range m.CmtSet {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	if m.KeyVersion != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.KeyVersion))
+		i--
+		dAtA[i] = 0x10
 	}
-	n += len(m.unknownFields)
-	return n
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncState) SizeVT() (n int) {
+func (m *ValidatorKeyState) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
 	}
-	var l int
-	_ = l
-	l = len(m.AuthorP2PNodeId)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SyncStateResponse) SizeVT() (n int) {
+func (m *ValidatorKeyState) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *ValidatorKeyState) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ReplicaId != 0 {
-		n += 1 + sov(uint64(m.ReplicaId))
-	}
-	if m.View != 0 {
-		n += 1 + sov(uint64(m.View))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.SignedCheckpoint != nil {
-		l = m.SignedCheckpoint.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.Pending != nil {
+		size, err := m.Pending.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *EpochChangeRequest) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if m.PrevUntil != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.PrevUntil))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	if m.Author != 0 {
-		n += 1 + sov(uint64(m.Author))
+	if len(m.PrevPubKey) > 0 {
+		i -= len(m.PrevPubKey)
+		copy(dAtA[i:], m.PrevPubKey)
+		i = encodeVarint(dAtA, i, uint64(len(m.PrevPubKey)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.StartEpoch != 0 {
-		n += 1 + sov(uint64(m.StartEpoch))
+	if m.PrevKeyVersion != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.PrevKeyVersion))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.TargetEpoch != 0 {
-		n += 1 + sov(uint64(m.TargetEpoch))
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarint(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.AuthorP2PNodeId)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.KeyVersion != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.KeyVersion))
+		i--
+		dAtA[i] = 0x8
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Pset) SizeVT() (n int) {
+func (m *ValidatorDynamicInfo) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
 	}
-	var l int
-	_ = l
-	if len(m.Set) > 0 {
-		for _, e := range m.Set {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Cset) SizeVT() (n int) {
+func (m *ValidatorDynamicInfo) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *ValidatorDynamicInfo) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Set) > 0 {
-		for _, e := range m.Set {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Info) > 0 {
+		for iNdEx := len(m.Info) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Info[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Checkpoint_ExecuteState) SizeVT() (n int) {
+func (m *VcBasis) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sov(uint64(m.Height))
-	}
-	l = len(m.Digest)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+		return nil, nil
 	}
-	l = len(m.BatchDigest)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Checkpoint) SizeVT() (n int) {
+func (m *VcBasis) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *VcBasis) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sov(uint64(m.Epoch))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.ExecuteState != nil {
-		l = m.ExecuteState.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if len(m.IfRecoverValidatorDynamicInfo) > 0 {
+		for iNdEx := len(m.IfRecoverValidatorDynamicInfo) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.IfRecoverValidatorDynamicInfo[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if m.NeedUpdateEpoch {
-		n += 2
+	if len(m.IfNotRecoverValidatorDynamicInfo) > 0 {
+		for iNdEx := len(m.IfNotRecoverValidatorDynamicInfo) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.IfNotRecoverValidatorDynamicInfo[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.ViewChange != nil {
-		l = m.ViewChange.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if len(m.Cset) > 0 {
+		for iNdEx := len(m.Cset) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Cset[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *SignedCheckpoint) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Qset) > 0 {
+		for iNdEx := len(m.Qset) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Qset[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	var l int
-	_ = l
-	if m.Checkpoint != nil {
-		l = m.Checkpoint.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if len(m.Pset) > 0 {
+		for iNdEx := len(m.Pset) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Pset[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Author != 0 {
-		n += 1 + sov(uint64(m.Author))
+	if m.H != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.H))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
 	}
-	n += len(m.unknownFields)
-	return n
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorInfo) SizeVT() (n int) {
+func (m *VcPq) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sov(uint64(m.Id))
+		return nil, nil
 	}
-	l = len(m.P2PId)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuorumCheckpoint) SizeVT() (n int) {
+func (m *VcPq) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *VcPq) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Checkpoint != nil {
-		l = m.Checkpoint.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Signatures) > 0 {
-		for k, v := range m.Signatures {
-			_ = k
-			_ = v
-			l = 1 + len(v) + sov(uint64(len(v)))
-			mapEntrySize := 1 + sov(uint64(k)) + l
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
-		}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.ValidatorSet) > 0 {
-		for k, v := range m.ValidatorSet {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.SizeVT()
-			}
-			l += 1 + sov(uint64(l))
-			mapEntrySize := 1 + sov(uint64(k)) + l
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
-		}
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+		i--
+		dAtA[i] = 0x12
 	}
-	n += len(m.unknownFields)
-	return n
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EpochChangeProof) SizeVT() (n int) {
+func (m *QuorumViewChange) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumViewChange) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *QuorumViewChange) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.EpochChanges) > 0 {
-		for _, e := range m.EpochChanges {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ViewChanges) > 0 {
+		for iNdEx := len(m.ViewChanges) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.ViewChanges[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.More != 0 {
-		n += 1 + sov(uint64(m.More))
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Author != 0 {
-		n += 1 + sov(uint64(m.Author))
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeDynamicInfo) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
 	}
-	l = len(m.GenesisBlockDigest)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EpochChange) SizeVT() (n int) {
+func (m *NodeDynamicInfo) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *NodeDynamicInfo) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Checkpoint != nil {
-		l = m.Checkpoint.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Validators != nil {
-		l = m.Validators.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.ReputationPenalty != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReputationPenalty))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.LowWatermark != 0 {
-		n += 1 + sov(uint64(m.LowWatermark))
+	if m.ConsensusVotingPowerReduceView != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ConsensusVotingPowerReduceView))
+		i--
+		dAtA[i] = 0x20
 	}
-	n += len(m.unknownFields)
-	return n
+	if m.ConsensusVotingPowerReduced {
+		i--
+		if m.ConsensusVotingPowerReduced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsensusVotingPower != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ConsensusVotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuorumValidators) SizeVT() (n int) {
+func (m *NewView) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewView) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *NewView) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ValidatorDynamicInfo) > 0 {
+		for iNdEx := len(m.ValidatorDynamicInfo) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.ValidatorDynamicInfo[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x4a
 		}
 	}
-	n += len(m.unknownFields)
-	return n
+	if m.AutoTermUpdate {
+		i--
+		if m.AutoTermUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FromId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.FromId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.QuorumCheckpoint != nil {
+		size, err := m.QuorumCheckpoint.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ViewChangeSet != nil {
+		size, err := m.ViewChangeSet.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Xset) > 0 {
+		for iNdEx := len(m.Xset) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Xset[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuorumValidator) SizeVT() (n int) {
+func (m *FetchView) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchView) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *FetchView) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sov(uint64(m.Id))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveryResponse) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *RecoveryResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.GenesisBlockDigest) > 0 {
+		i -= len(m.GenesisBlockDigest)
+		copy(dAtA[i:], m.GenesisBlockDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.GenesisBlockDigest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InitialCheckpoint != nil {
+		size, err := m.InitialCheckpoint.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NewView != nil {
+		size, err := m.NewView.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBatchRequest) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBatchRequest) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *FetchBatchRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBatchResponse) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBatchResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *FetchBatchResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Batch != nil {
+		size, err := m.Batch.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestBatch) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestBatch) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *RequestBatch) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.BftTime != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.BftTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.KeyRotations) > 0 {
+		for iNdEx := len(m.KeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.KeyRotations[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VrfOutput) > 0 {
+		i -= len(m.VrfOutput)
+		copy(dAtA[i:], m.VrfOutput)
+		i = encodeVarint(dAtA, i, uint64(len(m.VrfOutput)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.VrfProof) > 0 {
+		i -= len(m.VrfProof)
+		copy(dAtA[i:], m.VrfProof)
+		i = encodeVarint(dAtA, i, uint64(len(m.VrfProof)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Proposer != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Proposer))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BatchHash) > 0 {
+		i -= len(m.BatchHash)
+		copy(dAtA[i:], m.BatchHash)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LocalList) > 0 {
+		for iNdEx := len(m.LocalList) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.LocalList[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.LocalList)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SeqNo != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SeqNo))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RequestList) > 0 {
+		for iNdEx := len(m.RequestList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequestList[iNdEx])
+			copy(dAtA[i:], m.RequestList[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RequestList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RequestHashList) > 0 {
+		for iNdEx := len(m.RequestHashList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequestHashList[iNdEx])
+			copy(dAtA[i:], m.RequestHashList[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RequestHashList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchMissingRequest) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchMissingRequest) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *FetchMissingRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.MissingRequestHashes) > 0 {
+		for k := range m.MissingRequestHashes {
+			v := m.MissingRequestHashes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchMissingResponse) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchMissingResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *FetchMissingResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Status != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MissingRequests) > 0 {
+		for k := range m.MissingRequests {
+			v := m.MissingRequests[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MissingRequestHashes) > 0 {
+		for k := range m.MissingRequestHashes {
+			v := m.MissingRequestHashes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SequenceNumber != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchPQCRequest) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchPQCRequest) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *FetchPQCRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.H != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.H))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchPQCResponse) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchPQCResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *FetchPQCResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.CmtSet) > 0 {
+		for iNdEx := len(m.CmtSet) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.CmtSet[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreSet) > 0 {
+		for iNdEx := len(m.PreSet) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.PreSet[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PrepreSet) > 0 {
+		for iNdEx := len(m.PrepreSet) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.PrepreSet[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncState) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncState) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *SyncState) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.AuthorP2PNodeId) > 0 {
+		i -= len(m.AuthorP2PNodeId)
+		copy(dAtA[i:], m.AuthorP2PNodeId)
+		i = encodeVarint(dAtA, i, uint64(len(m.AuthorP2PNodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncStateResponse) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncStateResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *SyncStateResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.SignedCheckpoint != nil {
+		size, err := m.SignedCheckpoint.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.View != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotManifest) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotManifest) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *SnapshotManifest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarint(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChunkHashes) > 0 {
+		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChunkHashes[iNdEx])
+			copy(dAtA[i:], m.ChunkHashes[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.ChunkHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarint(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotManifestRequest) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotManifestRequest) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *SnapshotManifestRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarint(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotManifestResponse) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotManifestResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *SnapshotManifestResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Manifest != nil {
+		size, err := m.Manifest.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotChunkRequest) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotChunkRequest) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *SnapshotChunkRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Index != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarint(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotChunkResponse) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotChunkResponse) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *SnapshotChunkResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarint(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Index != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarint(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochChangeRequest) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochChangeRequest) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *EpochChangeRequest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.AuthorP2PNodeId) > 0 {
+		i -= len(m.AuthorP2PNodeId)
+		copy(dAtA[i:], m.AuthorP2PNodeId)
+		i = encodeVarint(dAtA, i, uint64(len(m.AuthorP2PNodeId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TargetEpoch != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.TargetEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Author != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Author))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pset) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pset) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Pset) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Set) > 0 {
+		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Set[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Cset) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cset) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Cset) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Set) > 0 {
+		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Set[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Checkpoint_ExecuteState) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Checkpoint_ExecuteState) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Checkpoint_ExecuteState) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.VrfOutput) > 0 {
+		i -= len(m.VrfOutput)
+		copy(dAtA[i:], m.VrfOutput)
+		i = encodeVarint(dAtA, i, uint64(len(m.VrfOutput)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchDigest) > 0 {
+		i -= len(m.BatchDigest)
+		copy(dAtA[i:], m.BatchDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.BatchDigest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarint(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Checkpoint) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Checkpoint) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Checkpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ReputationPenalties) > 0 {
+		for iNdEx := len(m.ReputationPenalties) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.ReputationPenalties[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ValidatorKeys) > 0 {
+		for iNdEx := len(m.ValidatorKeys) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.ValidatorKeys[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ViewChange != nil {
+		size, err := m.ViewChange.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NeedUpdateEpoch {
+		i--
+		if m.NeedUpdateEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecuteState != nil {
+		size, err := m.ExecuteState.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedCheckpoint) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedCheckpoint) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *SignedCheckpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarint(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Author != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Author))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Checkpoint != nil {
+		size, err := m.Checkpoint.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorInfo) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorInfo) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *ValidatorInfo) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarint(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.KeyVersion != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.KeyVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.P2PId) > 0 {
+		i -= len(m.P2PId)
+		copy(dAtA[i:], m.P2PId)
+		i = encodeVarint(dAtA, i, uint64(len(m.P2PId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumCheckpoint) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumCheckpoint) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *QuorumCheckpoint) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ValidatorSet) > 0 {
+		for k := range m.ValidatorSet {
+			v := m.ValidatorSet[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Signatures) > 0 {
+		for k := range m.Signatures {
+			v := m.Signatures[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Checkpoint != nil {
+		size, err := m.Checkpoint.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochChangeProof) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochChangeProof) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *EpochChangeProof) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.GenesisBlockDigest) > 0 {
+		i -= len(m.GenesisBlockDigest)
+		copy(dAtA[i:], m.GenesisBlockDigest)
+		i = encodeVarint(dAtA, i, uint64(len(m.GenesisBlockDigest)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Author != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Author))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.More != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.More))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochChanges) > 0 {
+		for iNdEx := len(m.EpochChanges) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.EpochChanges[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochChange) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochChange) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *EpochChange) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.LowWatermark != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.LowWatermark))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Validators != nil {
+		size, err := m.Validators.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Checkpoint != nil {
+		size, err := m.Checkpoint.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumValidators) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumValidators) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *QuorumValidators) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Validators[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumValidator) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumValidator) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *QuorumValidator) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarint(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsensusMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sov(uint64(m.Type))
+	}
+	if m.From != 0 {
+		n += 1 + sov(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sov(uint64(m.To))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sov(uint64(m.Epoch))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sov(uint64(m.Nonce))
+	}
+	if m.Compression != 0 {
+		n += 1 + sov(uint64(m.Compression))
+	}
+	if m.AcceptCompression != 0 {
+		n += 1 + sov(uint64(m.AcceptCompression))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *NullRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *PrePrepare) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sov(uint64(m.SequenceNumber))
+	}
+	l = len(m.BatchDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.HashBatch != nil {
+		l = m.HashBatch.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.BftTimeCommits) > 0 {
+		for _, e := range m.BftTimeCommits {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Prepare) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sov(uint64(m.SequenceNumber))
+	}
+	l = len(m.BatchDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Commit) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sov(uint64(m.SequenceNumber))
+	}
+	l = len(m.BatchDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sov(uint64(m.Timestamp))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *AggregatedVotes) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if len(m.Prepares) > 0 {
+		for _, e := range m.Prepares {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *DecryptionShare) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sov(uint64(m.SequenceNumber))
+	}
+	l = len(m.BatchDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *HotStuffQC) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if m.Height != 0 {
+		n += 1 + sov(uint64(m.Height))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for k, v := range m.Signatures {
+			_ = k
+			_ = v
+			l = 1 + len(v) + sov(uint64(len(v)))
+			mapEntrySize := 1 + sov(uint64(k)) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *HotStuffBlock) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if m.Height != 0 {
+		n += 1 + sov(uint64(m.Height))
+	}
+	l = len(m.ParentHash)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Justify != nil {
+		l = m.Justify.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.BatchDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.HashBatch != nil {
+		l = m.HashBatch.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Proposer != 0 {
+		n += 1 + sov(uint64(m.Proposer))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *HotStuffProposal) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.Block != nil {
+		l = m.Block.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *HotStuffVote) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if m.Height != 0 {
+		n += 1 + sov(uint64(m.Height))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *HotStuffNewView) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if m.HighQc != nil {
+		l = m.HighQc.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *HotStuffFetchBlock) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *HotStuffFetchBlockResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.Block != nil {
+		l = m.Block.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ReBroadcastRequestSet) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if len(m.Requests) > 0 {
+		for _, b := range m.Requests {
+			l = len(b)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *HashBatch) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequestHashList) > 0 {
+		for _, s := range m.RequestHashList {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.DeDuplicateRequestHashList) > 0 {
+		for _, s := range m.DeDuplicateRequestHashList {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sov(uint64(m.Timestamp))
+	}
+	if m.Proposer != 0 {
+		n += 1 + sov(uint64(m.Proposer))
+	}
+	l = len(m.VrfProof)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.KeyRotations) > 0 {
+		for _, e := range m.KeyRotations {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.BftTime != 0 {
+		n += 1 + sov(uint64(m.BftTime))
+	}
+	if len(m.ReceiveTimes) > 0 {
+		l = 0
+		for _, e := range m.ReceiveTimes {
+			l += sov(uint64(e))
+		}
+		n += 1 + sov(uint64(l)) + l
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *FetchCheckpoint) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sov(uint64(m.SequenceNumber))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ViewChange) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Basis != nil {
+		l = m.Basis.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sov(uint64(m.Timestamp))
+	}
+	if m.Recovery {
+		n += 2
+	}
+	if m.LeaderTransfer {
+		n += 2
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *LeaderTransfer) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sov(uint64(m.SequenceNumber))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *KeyRotation) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.KeyVersion != 0 {
+		n += 1 + sov(uint64(m.KeyVersion))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ValidatorKeyState) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyVersion != 0 {
+		n += 1 + sov(uint64(m.KeyVersion))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.PrevKeyVersion != 0 {
+		n += 1 + sov(uint64(m.PrevKeyVersion))
+	}
+	l = len(m.PrevPubKey)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.PrevUntil != 0 {
+		n += 1 + sov(uint64(m.PrevUntil))
+	}
+	if m.Pending != nil {
+		l = m.Pending.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ValidatorDynamicInfo) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		for _, e := range m.Info {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *VcBasis) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if m.H != 0 {
+		n += 1 + sov(uint64(m.H))
+	}
+	if len(m.Pset) > 0 {
+		for _, e := range m.Pset {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.Qset) > 0 {
+		for _, e := range m.Qset {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.Cset) > 0 {
+		for _, e := range m.Cset {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.IfNotRecoverValidatorDynamicInfo) > 0 {
+		for _, e := range m.IfNotRecoverValidatorDynamicInfo {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.IfRecoverValidatorDynamicInfo) > 0 {
+		for _, e := range m.IfRecoverValidatorDynamicInfo {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *VcPq) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SequenceNumber != 0 {
+		n += 1 + sov(uint64(m.SequenceNumber))
+	}
+	l = len(m.BatchDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *QuorumViewChange) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if len(m.ViewChanges) > 0 {
+		for _, e := range m.ViewChanges {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *NodeDynamicInfo) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sov(uint64(m.Id))
+	}
+	if m.ConsensusVotingPower != 0 {
+		n += 1 + sov(uint64(m.ConsensusVotingPower))
+	}
+	if m.ConsensusVotingPowerReduced {
+		n += 2
+	}
+	if m.ConsensusVotingPowerReduceView != 0 {
+		n += 1 + sov(uint64(m.ConsensusVotingPowerReduceView))
+	}
+	if m.ReputationPenalty != 0 {
+		n += 1 + sov(uint64(m.ReputationPenalty))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *NewView) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if len(m.Xset) > 0 {
+		for _, e := range m.Xset {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.ViewChangeSet != nil {
+		l = m.ViewChangeSet.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.QuorumCheckpoint != nil {
+		l = m.QuorumCheckpoint.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.FromId != 0 {
+		n += 1 + sov(uint64(m.FromId))
+	}
+	if m.AutoTermUpdate {
+		n += 2
+	}
+	if len(m.ValidatorDynamicInfo) > 0 {
+		for _, e := range m.ValidatorDynamicInfo {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *FetchView) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *RecoveryResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewView != nil {
+		l = m.NewView.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.InitialCheckpoint != nil {
+		l = m.InitialCheckpoint.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.GenesisBlockDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *FetchBatchRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	l = len(m.BatchDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *FetchBatchResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.Batch != nil {
+		l = m.Batch.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.BatchDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *RequestBatch) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequestHashList) > 0 {
+		for _, s := range m.RequestHashList {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RequestList) > 0 {
+		for _, b := range m.RequestList {
+			l = len(b)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sov(uint64(m.Timestamp))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sov(uint64(m.SeqNo))
+	}
+	if len(m.LocalList) > 0 {
+		n += 1 + sov(uint64(len(m.LocalList))) + len(m.LocalList)*1
+	}
+	l = len(m.BatchHash)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Proposer != 0 {
+		n += 1 + sov(uint64(m.Proposer))
+	}
+	l = len(m.VrfProof)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.VrfOutput)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.KeyRotations) > 0 {
+		for _, e := range m.KeyRotations {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.BftTime != 0 {
+		n += 1 + sov(uint64(m.BftTime))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *FetchMissingRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sov(uint64(m.SequenceNumber))
+	}
+	l = len(m.BatchDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.MissingRequestHashes) > 0 {
+		for k, v := range m.MissingRequestHashes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + len(v) + sov(uint64(len(v)))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *FetchMissingResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sov(uint64(m.SequenceNumber))
+	}
+	l = len(m.BatchDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.MissingRequestHashes) > 0 {
+		for k, v := range m.MissingRequestHashes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + len(v) + sov(uint64(len(v)))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MissingRequests) > 0 {
+		for k, v := range m.MissingRequests {
+			_ = k
+			_ = v
+			l = 1 + len(v) + sov(uint64(len(v)))
+			mapEntrySize := 1 + sov(uint64(k)) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sov(uint64(m.Status))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *FetchPQCRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.H != 0 {
+		n += 1 + sov(uint64(m.H))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *FetchPQCResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if len(m.PrepreSet) > 0 {
+		for _, e := range m.PrepreSet {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.PreSet) > 0 {
+		for _, e := range m.PreSet {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.CmtSet) > 0 {
+		for _, e := range m.CmtSet {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *SyncState) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuthorP2PNodeId)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *SyncStateResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.View != 0 {
+		n += 1 + sov(uint64(m.View))
+	}
+	if m.SignedCheckpoint != nil {
+		l = m.SignedCheckpoint.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *SnapshotManifest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sov(uint64(m.Height))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.ChunkHashes) > 0 {
+		for _, b := range m.ChunkHashes {
+			l = len(b)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *SnapshotManifestRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.Height != 0 {
+		n += 1 + sov(uint64(m.Height))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *SnapshotManifestResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.Manifest != nil {
+		l = m.Manifest.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *SnapshotChunkRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.Height != 0 {
+		n += 1 + sov(uint64(m.Height))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sov(uint64(m.Index))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *SnapshotChunkResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sov(uint64(m.ReplicaId))
+	}
+	if m.Height != 0 {
+		n += 1 + sov(uint64(m.Height))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sov(uint64(m.Index))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *EpochChangeRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Author != 0 {
+		n += 1 + sov(uint64(m.Author))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sov(uint64(m.StartEpoch))
+	}
+	if m.TargetEpoch != 0 {
+		n += 1 + sov(uint64(m.TargetEpoch))
+	}
+	l = len(m.AuthorP2PNodeId)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Pset) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Set) > 0 {
+		for _, e := range m.Set {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Cset) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Set) > 0 {
+		for _, e := range m.Set {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Checkpoint_ExecuteState) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sov(uint64(m.Height))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.BatchDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.VrfOutput)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Checkpoint) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sov(uint64(m.Epoch))
+	}
+	if m.ExecuteState != nil {
+		l = m.ExecuteState.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.NeedUpdateEpoch {
+		n += 2
+	}
+	if m.ViewChange != nil {
+		l = m.ViewChange.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.ValidatorKeys) > 0 {
+		for _, e := range m.ValidatorKeys {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.ReputationPenalties) > 0 {
+		for _, e := range m.ReputationPenalties {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *SignedCheckpoint) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Author != 0 {
+		n += 1 + sov(uint64(m.Author))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ValidatorInfo) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sov(uint64(m.Id))
+	}
+	l = len(m.P2PId)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.KeyVersion != 0 {
+		n += 1 + sov(uint64(m.KeyVersion))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *QuorumCheckpoint) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for k, v := range m.Signatures {
+			_ = k
+			_ = v
+			l = 1 + len(v) + sov(uint64(len(v)))
+			mapEntrySize := 1 + sov(uint64(k)) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ValidatorSet) > 0 {
+		for k, v := range m.ValidatorSet {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + sov(uint64(k)) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *EpochChangeProof) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochChanges) > 0 {
+		for _, e := range m.EpochChanges {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.More != 0 {
+		n += 1 + sov(uint64(m.More))
+	}
+	if m.Author != 0 {
+		n += 1 + sov(uint64(m.Author))
+	}
+	l = len(m.GenesisBlockDigest)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *EpochChange) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Validators != nil {
+		l = m.Validators.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.LowWatermark != 0 {
+		n += 1 + sov(uint64(m.LowWatermark))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *QuorumValidators) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *QuorumValidator) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sov(uint64(m.Id))
+	}
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ConsensusMessage) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= CompressionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptCompression", wireType)
+			}
+			m.AcceptCompression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptCompression |= CompressionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NullRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NullRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrePrepare) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrePrepare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrePrepare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HashBatch == nil {
+				m.HashBatch = &HashBatch{}
+			}
+			if err := m.HashBatch.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BftTimeCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BftTimeCommits = append(m.BftTimeCommits, &Commit{})
+			if err := m.BftTimeCommits[len(m.BftTimeCommits)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Prepare) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Prepare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Prepare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commit) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregatedVotes) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregatedVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregatedVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prepares = append(m.Prepares, &Prepare{})
+			if err := m.Prepares[len(m.Prepares)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits, &Commit{})
+			if err := m.Commits[len(m.Commits)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecryptionShare) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecryptionShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecryptionShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotStuffQC) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotStuffQC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotStuffQC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signatures == nil {
+				m.Signatures = make(map[uint64][]byte)
+			}
+			var mapkey uint64
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLength
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Signatures[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotStuffBlock) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotStuffBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotStuffBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justify", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Justify == nil {
+				m.Justify = &HotStuffQC{}
+			}
+			if err := m.Justify.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HashBatch == nil {
+				m.HashBatch = &HashBatch{}
+			}
+			if err := m.HashBatch.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			m.Proposer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Proposer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotStuffProposal) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotStuffProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotStuffProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &HotStuffBlock{}
+			}
+			if err := m.Block.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotStuffVote) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotStuffVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotStuffVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotStuffNewView) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotStuffNewView: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotStuffNewView: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighQc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HighQc == nil {
+				m.HighQc = &HotStuffQC{}
+			}
+			if err := m.HighQc.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	l = len(m.PeerId)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	n += len(m.unknownFields)
-	return n
+	return nil
 }
-
-func (m *ConsensusMessage) UnmarshalVT(dAtA []byte) error {
+func (m *HotStuffFetchBlock) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7680,17 +13811,17 @@ func (m *ConsensusMessage) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: HotStuffFetchBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HotStuffFetchBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
 			}
-			m.Type = 0
+			m.ReplicaId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7700,16 +13831,16 @@ func (m *ConsensusMessage) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= Type(b&0x7F) << shift
+				m.ReplicaId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			m.From = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7719,54 +13850,80 @@ func (m *ConsensusMessage) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.From |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
 			}
-			m.To = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.To |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotStuffFetchBlockResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotStuffFetchBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotStuffFetchBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
 			}
-			m.View = 0
+			m.ReplicaId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7776,16 +13933,16 @@ func (m *ConsensusMessage) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.View |= uint64(b&0x7F) << shift
+				m.ReplicaId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7795,45 +13952,28 @@ func (m *ConsensusMessage) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if m.Block == nil {
+				m.Block = &HotStuffBlock{}
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Block.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -7856,7 +13996,7 @@ func (m *ConsensusMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NullRequest) UnmarshalVT(dAtA []byte) error {
+func (m *ReBroadcastRequestSet) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7879,10 +14019,10 @@ func (m *NullRequest) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NullRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReBroadcastRequestSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReBroadcastRequestSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7904,6 +14044,38 @@ func (m *NullRequest) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, make([]byte, postIndex-iNdEx))
+			copy(m.Requests[len(m.Requests)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -7926,7 +14098,7 @@ func (m *NullRequest) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrePrepare) UnmarshalVT(dAtA []byte) error {
+func (m *HashBatch) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7949,17 +14121,17 @@ func (m *PrePrepare) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrePrepare: wiretype end group for non-group")
+			return fmt.Errorf("proto: HashBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrePrepare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HashBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHashList", wireType)
 			}
-			m.ReplicaId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7969,16 +14141,61 @@ func (m *PrePrepare) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReplicaId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestHashList = append(m.RequestHashList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeDuplicateRequestHashList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeDuplicateRequestHashList = append(m.DeDuplicateRequestHashList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.View = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7988,16 +14205,16 @@ func (m *PrePrepare) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.View |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			m.SequenceNumber = 0
+			m.Proposer = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8007,16 +14224,16 @@ func (m *PrePrepare) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SequenceNumber |= uint64(b&0x7F) << shift
+				m.Proposer |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VrfProof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8026,27 +14243,29 @@ func (m *PrePrepare) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchDigest = string(dAtA[iNdEx:postIndex])
+			m.VrfProof = append(m.VrfProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.VrfProof == nil {
+				m.VrfProof = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HashBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8073,13 +14292,106 @@ func (m *PrePrepare) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HashBatch == nil {
-				m.HashBatch = &HashBatch{}
-			}
-			if err := m.HashBatch.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyRotations = append(m.KeyRotations, &KeyRotation{})
+			if err := m.KeyRotations[len(m.KeyRotations)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BftTime", wireType)
+			}
+			m.BftTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BftTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReceiveTimes = append(m.ReceiveTimes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReceiveTimes) == 0 {
+					m.ReceiveTimes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReceiveTimes = append(m.ReceiveTimes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveTimes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -8102,7 +14414,7 @@ func (m *PrePrepare) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Prepare) UnmarshalVT(dAtA []byte) error {
+func (m *FetchCheckpoint) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8125,10 +14437,10 @@ func (m *Prepare) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Prepare: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Prepare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8151,25 +14463,6 @@ func (m *Prepare) UnmarshalVT(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
-			}
-			m.View = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.View |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
 			}
@@ -8188,38 +14481,6 @@ func (m *Prepare) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BatchDigest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -8242,7 +14503,7 @@ func (m *Prepare) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commit) UnmarshalVT(dAtA []byte) error {
+func (m *ViewChange) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8265,17 +14526,17 @@ func (m *Commit) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+			return fmt.Errorf("proto: ViewChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ViewChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basis", wireType)
 			}
-			m.ReplicaId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8285,16 +14546,33 @@ func (m *Commit) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReplicaId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Basis == nil {
+				m.Basis = &VcBasis{}
+			}
+			if err := m.Basis.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			m.View = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8304,16 +14582,31 @@ func (m *Commit) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.View |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.SequenceNumber = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8323,16 +14616,36 @@ func (m *Commit) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SequenceNumber |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recovery = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderTransfer", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8342,24 +14655,12 @@ func (m *Commit) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BatchDigest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.LeaderTransfer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -8382,7 +14683,7 @@ func (m *Commit) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReBroadcastRequestSet) UnmarshalVT(dAtA []byte) error {
+func (m *LeaderTransfer) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8405,10 +14706,10 @@ func (m *ReBroadcastRequestSet) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReBroadcastRequestSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaderTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReBroadcastRequestSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaderTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8431,8 +14732,46 @@ func (m *ReBroadcastRequestSet) UnmarshalVT(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8459,8 +14798,10 @@ func (m *ReBroadcastRequestSet) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, make([]byte, postIndex-iNdEx))
-			copy(m.Requests[len(m.Requests)-1], dAtA[iNdEx:postIndex])
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8484,7 +14825,7 @@ func (m *ReBroadcastRequestSet) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HashBatch) UnmarshalVT(dAtA []byte) error {
+func (m *KeyRotation) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8507,17 +14848,17 @@ func (m *HashBatch) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HashBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HashBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHashList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
 			}
-			var stringLen uint64
+			m.ReplicaId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8527,29 +14868,16 @@ func (m *HashBatch) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReplicaId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestHashList = append(m.RequestHashList, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeDuplicateRequestHashList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyVersion", wireType)
 			}
-			var stringLen uint64
+			m.KeyVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8559,29 +14887,16 @@ func (m *HashBatch) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.KeyVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeDuplicateRequestHashList = append(m.DeDuplicateRequestHashList, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			m.Timestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8591,16 +14906,31 @@ func (m *HashBatch) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			m.Proposer = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8610,11 +14940,26 @@ func (m *HashBatch) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Proposer |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -8637,7 +14982,7 @@ func (m *HashBatch) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FetchCheckpoint) UnmarshalVT(dAtA []byte) error {
+func (m *ValidatorKeyState) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8660,17 +15005,17 @@ func (m *FetchCheckpoint) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FetchCheckpoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorKeyState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FetchCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorKeyState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyVersion", wireType)
 			}
-			m.ReplicaId = 0
+			m.KeyVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8680,16 +15025,16 @@ func (m *FetchCheckpoint) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReplicaId |= uint64(b&0x7F) << shift
+				m.KeyVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			m.SequenceNumber = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8699,67 +15044,31 @@ func (m *FetchCheckpoint) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SequenceNumber |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skip(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLength
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ViewChange) UnmarshalVT(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflow
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ViewChange: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ViewChange: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Basis", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevKeyVersion", wireType)
 			}
-			var msglen int
+			m.PrevKeyVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8769,31 +15078,14 @@ func (m *ViewChange) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrevKeyVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Basis == nil {
-				m.Basis = &VcBasis{}
-			}
-			if err := m.Basis.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevPubKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8820,16 +15112,16 @@ func (m *ViewChange) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			m.PrevPubKey = append(m.PrevPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevPubKey == nil {
+				m.PrevPubKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevUntil", wireType)
 			}
-			m.Timestamp = 0
+			m.PrevUntil = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8839,16 +15131,16 @@ func (m *ViewChange) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.PrevUntil |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8858,12 +15150,28 @@ func (m *ViewChange) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Recovery = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pending == nil {
+				m.Pending = &KeyRotation{}
+			}
+			if err := m.Pending.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -9163,7 +15471,247 @@ func (m *VcBasis) UnmarshalVT(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IfNotRecoverValidatorDynamicInfo", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IfNotRecoverValidatorDynamicInfo = append(m.IfNotRecoverValidatorDynamicInfo, &NodeDynamicInfo{})
+			if err := m.IfNotRecoverValidatorDynamicInfo[len(m.IfNotRecoverValidatorDynamicInfo)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfRecoverValidatorDynamicInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IfRecoverValidatorDynamicInfo = append(m.IfRecoverValidatorDynamicInfo, &NodeDynamicInfo{})
+			if err := m.IfRecoverValidatorDynamicInfo[len(m.IfRecoverValidatorDynamicInfo)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VcPq) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VcPq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VcPq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuorumViewChange) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumViewChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumViewChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -9173,29 +15721,14 @@ func (m *VcBasis) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ReplicaId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IfNotRecoverValidatorDynamicInfo = append(m.IfNotRecoverValidatorDynamicInfo, &NodeDynamicInfo{})
-			if err := m.IfNotRecoverValidatorDynamicInfo[len(m.IfNotRecoverValidatorDynamicInfo)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfRecoverValidatorDynamicInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9222,8 +15755,8 @@ func (m *VcBasis) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IfRecoverValidatorDynamicInfo = append(m.IfRecoverValidatorDynamicInfo, &NodeDynamicInfo{})
-			if err := m.IfRecoverValidatorDynamicInfo[len(m.IfRecoverValidatorDynamicInfo)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			m.ViewChanges = append(m.ViewChanges, &ViewChange{})
+			if err := m.ViewChanges[len(m.ViewChanges)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9249,7 +15782,7 @@ func (m *VcBasis) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VcPq) UnmarshalVT(dAtA []byte) error {
+func (m *NodeDynamicInfo) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9272,17 +15805,17 @@ func (m *VcPq) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VcPq: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeDynamicInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VcPq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeDynamicInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.SequenceNumber = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -9292,16 +15825,16 @@ func (m *VcPq) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SequenceNumber |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusVotingPower", wireType)
 			}
-			var stringLen uint64
+			m.ConsensusVotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -9311,29 +15844,55 @@ func (m *VcPq) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ConsensusVotingPower |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusVotingPowerReduced", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ConsensusVotingPowerReduced = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusVotingPowerReduceView", wireType)
 			}
-			m.BatchDigest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.ConsensusVotingPowerReduceView = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusVotingPowerReduceView |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputationPenalty", wireType)
 			}
-			m.View = 0
+			m.ReputationPenalty = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -9343,7 +15902,7 @@ func (m *VcPq) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.View |= uint64(b&0x7F) << shift
+				m.ReputationPenalty |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9370,7 +15929,7 @@ func (m *VcPq) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuorumViewChange) UnmarshalVT(dAtA []byte) error {
+func (m *NewView) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9393,10 +15952,10 @@ func (m *QuorumViewChange) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuorumViewChange: wiretype end group for non-group")
+			return fmt.Errorf("proto: NewView: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuorumViewChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NewView: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9419,8 +15978,27 @@ func (m *QuorumViewChange) UnmarshalVT(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ViewChanges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Xset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9447,67 +16025,88 @@ func (m *QuorumViewChange) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ViewChanges = append(m.ViewChanges, &ViewChange{})
-			if err := m.ViewChanges[len(m.ViewChanges)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			m.Xset = append(m.Xset, &VcPq{})
+			if err := m.Xset[len(m.Xset)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skip(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewChangeSet", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLength
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NodeDynamicInfo) UnmarshalVT(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflow
+			if m.ViewChangeSet == nil {
+				m.ViewChangeSet = &QuorumViewChange{}
 			}
-			if iNdEx >= l {
+			if err := m.ViewChangeSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.QuorumCheckpoint == nil {
+				m.QuorumCheckpoint = &QuorumCheckpoint{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NodeDynamicInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeDynamicInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if err := m.QuorumCheckpoint.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Id = 0
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -9517,16 +16116,31 @@ func (m *NodeDynamicInfo) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
 			}
-			m.ConsensusVotingPower = 0
+			m.FromId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -9536,14 +16150,14 @@ func (m *NodeDynamicInfo) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConsensusVotingPower |= int64(b&0x7F) << shift
+				m.FromId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusVotingPowerReduced", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoTermUpdate", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9560,12 +16174,12 @@ func (m *NodeDynamicInfo) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
-			m.ConsensusVotingPowerReduced = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusVotingPowerReduceView", wireType)
+			m.AutoTermUpdate = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDynamicInfo", wireType)
 			}
-			m.ConsensusVotingPowerReduceView = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -9575,11 +16189,26 @@ func (m *NodeDynamicInfo) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConsensusVotingPowerReduceView |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDynamicInfo = append(m.ValidatorDynamicInfo, &NodeDynamicInfo{})
+			if err := m.ValidatorDynamicInfo[len(m.ValidatorDynamicInfo)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -9602,7 +16231,7 @@ func (m *NodeDynamicInfo) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewView) UnmarshalVT(dAtA []byte) error {
+func (m *FetchView) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9625,10 +16254,10 @@ func (m *NewView) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewView: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchView: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewView: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchView: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9669,43 +16298,60 @@ func (m *NewView) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Xset", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLength
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Xset = append(m.Xset, &VcPq{})
-			if err := m.Xset[len(m.Xset)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ViewChangeSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewView", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9732,16 +16378,16 @@ func (m *NewView) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ViewChangeSet == nil {
-				m.ViewChangeSet = &QuorumViewChange{}
+			if m.NewView == nil {
+				m.NewView = &NewView{}
 			}
-			if err := m.ViewChangeSet.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewView.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCheckpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialCheckpoint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9768,18 +16414,18 @@ func (m *NewView) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QuorumCheckpoint == nil {
-				m.QuorumCheckpoint = &QuorumCheckpoint{}
+			if m.InitialCheckpoint == nil {
+				m.InitialCheckpoint = &SignedCheckpoint{}
 			}
-			if err := m.QuorumCheckpoint.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialCheckpoint.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisBlockDigest", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -9789,50 +16435,80 @@ func (m *NewView) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
+			m.GenesisBlockDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.FromId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FromId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
 			}
-		case 8:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBatchRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoTermUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
 			}
-			var v int
+			m.ReplicaId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -9842,17 +16518,16 @@ func (m *NewView) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ReplicaId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AutoTermUpdate = bool(v != 0)
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDynamicInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -9862,25 +16537,23 @@ func (m *NewView) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDynamicInfo = append(m.ValidatorDynamicInfo, &NodeDynamicInfo{})
-			if err := m.ValidatorDynamicInfo[len(m.ValidatorDynamicInfo)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BatchDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9904,7 +16577,7 @@ func (m *NewView) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FetchView) UnmarshalVT(dAtA []byte) error {
+func (m *FetchBatchResponse) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9927,10 +16600,10 @@ func (m *FetchView) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FetchView: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FetchView: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9953,10 +16626,10 @@ func (m *FetchView) UnmarshalVT(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			m.View = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -9966,11 +16639,60 @@ func (m *FetchView) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.View |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &RequestBatch{}
+			}
+			if err := m.Batch.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -9993,7 +16715,7 @@ func (m *FetchView) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecoveryResponse) UnmarshalVT(dAtA []byte) error {
+func (m *RequestBatch) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10016,17 +16738,17 @@ func (m *RecoveryResponse) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecoveryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewView", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHashList", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -10036,33 +16758,29 @@ func (m *RecoveryResponse) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewView == nil {
-				m.NewView = &NewView{}
-			}
-			if err := m.NewView.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RequestHashList = append(m.RequestHashList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialCheckpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestList", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -10072,31 +16790,135 @@ func (m *RecoveryResponse) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitialCheckpoint == nil {
-				m.InitialCheckpoint = &SignedCheckpoint{}
-			}
-			if err := m.InitialCheckpoint.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RequestList = append(m.RequestList, make([]byte, postIndex-iNdEx))
+			copy(m.RequestList[len(m.RequestList)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNo", wireType)
+			}
+			m.SeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNo |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LocalList = append(m.LocalList, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.LocalList) == 0 {
+					m.LocalList = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LocalList = append(m.LocalList, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalList", wireType)
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisBlockDigest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10124,64 +16946,13 @@ func (m *RecoveryResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisBlockDigest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skip(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLength
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FetchBatchRequest) UnmarshalVT(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflow
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FetchBatchRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FetchBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BatchHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			m.ReplicaId = 0
+			m.Proposer = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -10191,16 +16962,16 @@ func (m *FetchBatchRequest) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReplicaId |= uint64(b&0x7F) << shift
+				m.Proposer |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VrfProof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -10210,80 +16981,31 @@ func (m *FetchBatchRequest) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchDigest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skip(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLength
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FetchBatchResponse) UnmarshalVT(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflow
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.VrfProof = append(m.VrfProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.VrfProof == nil {
+				m.VrfProof = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FetchBatchResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FetchBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VrfOutput", wireType)
 			}
-			m.ReplicaId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -10293,14 +17015,29 @@ func (m *FetchBatchResponse) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReplicaId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VrfOutput = append(m.VrfOutput[:0], dAtA[iNdEx:postIndex]...)
+			if m.VrfOutput == nil {
+				m.VrfOutput = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10327,18 +17064,16 @@ func (m *FetchBatchResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Batch == nil {
-				m.Batch = &RequestBatch{}
-			}
-			if err := m.Batch.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyRotations = append(m.KeyRotations, &KeyRotation{})
+			if err := m.KeyRotations[len(m.KeyRotations)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BftTime", wireType)
 			}
-			var stringLen uint64
+			m.BftTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -10348,24 +17083,11 @@ func (m *FetchBatchResponse) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BftTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BatchDigest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -10388,7 +17110,7 @@ func (m *FetchBatchResponse) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestBatch) UnmarshalVT(dAtA []byte) error {
+func (m *FetchMissingRequest) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10411,17 +17133,17 @@ func (m *RequestBatch) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchMissingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchMissingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHashList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
 			}
-			var stringLen uint64
+			m.ReplicaId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -10431,61 +17153,16 @@ func (m *RequestBatch) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReplicaId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestHashList = append(m.RequestHashList, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestList", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestList = append(m.RequestList, make([]byte, postIndex-iNdEx))
-			copy(m.RequestList[len(m.RequestList)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
 			}
-			m.Timestamp = 0
+			m.View = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -10495,103 +17172,33 @@ func (m *RequestBatch) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.View |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeqNo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
 			}
-			m.SeqNo = 0
+			m.SequenceNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SeqNo |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.LocalList = append(m.LocalList, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLength
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLength
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.LocalList) == 0 {
-					m.LocalList = make([]bool, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.LocalList = append(m.LocalList, bool(v != 0))
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalList", wireType)
 			}
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10619,13 +17226,13 @@ func (m *RequestBatch) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchHash = string(dAtA[iNdEx:postIndex])
+			m.BatchDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingRequestHashes", wireType)
 			}
-			m.Proposer = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -10635,11 +17242,105 @@ func (m *RequestBatch) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Proposer |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MissingRequestHashes == nil {
+				m.MissingRequestHashes = make(map[uint64]string)
+			}
+			var mapkey uint64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MissingRequestHashes[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -10662,7 +17363,7 @@ func (m *RequestBatch) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FetchMissingRequest) UnmarshalVT(dAtA []byte) error {
+func (m *FetchMissingResponse) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10685,10 +17386,10 @@ func (m *FetchMissingRequest) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FetchMissingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchMissingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FetchMissingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchMissingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10767,22 +17468,135 @@ func (m *FetchMissingRequest) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BatchDigest = string(dAtA[iNdEx:postIndex])
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingRequestHashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MissingRequestHashes == nil {
+				m.MissingRequestHashes = make(map[uint64]string)
+			}
+			var mapkey uint64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MissingRequestHashes[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissingRequestHashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10809,11 +17623,11 @@ func (m *FetchMissingRequest) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MissingRequestHashes == nil {
-				m.MissingRequestHashes = make(map[uint64]string)
+			if m.MissingRequests == nil {
+				m.MissingRequests = make(map[uint64][]byte)
 			}
 			var mapkey uint64
-			var mapvalue string
+			var mapvalue []byte
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -10848,7 +17662,7 @@ func (m *FetchMissingRequest) UnmarshalVT(dAtA []byte) error {
 						}
 					}
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapbyteLen uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflow
@@ -10858,24 +17672,25 @@ func (m *FetchMissingRequest) UnmarshalVT(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapbyteLen |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
 						return ErrInvalidLength
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
 						return ErrInvalidLength
 					}
-					if postStringIndexmapvalue > l {
+					if postbytesIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skip(dAtA[iNdEx:])
@@ -10891,8 +17706,27 @@ func (m *FetchMissingRequest) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MissingRequestHashes[mapkey] = mapvalue
+			m.MissingRequests[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= FetchMissingResponse_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -10915,7 +17749,7 @@ func (m *FetchMissingRequest) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FetchMissingResponse) UnmarshalVT(dAtA []byte) error {
+func (m *FetchPQCRequest) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10938,10 +17772,10 @@ func (m *FetchMissingResponse) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FetchMissingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchPQCRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FetchMissingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchPQCRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10965,9 +17799,9 @@ func (m *FetchMissingResponse) UnmarshalVT(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field H", wireType)
 			}
-			m.View = 0
+			m.H = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -10977,16 +17811,67 @@ func (m *FetchMissingResponse) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.View |= uint64(b&0x7F) << shift
+				m.H |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchPQCResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchPQCResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchPQCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
 			}
-			m.SequenceNumber = 0
+			m.ReplicaId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -10996,16 +17881,16 @@ func (m *FetchMissingResponse) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SequenceNumber |= uint64(b&0x7F) << shift
+				m.ReplicaId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchDigest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepreSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -11015,27 +17900,29 @@ func (m *FetchMissingResponse) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchDigest = string(dAtA[iNdEx:postIndex])
+			m.PrepreSet = append(m.PrepreSet, &PrePrepare{})
+			if err := m.PrepreSet[len(m.PrepreSet)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissingRequestHashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11062,93 +17949,14 @@ func (m *FetchMissingResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MissingRequestHashes == nil {
-				m.MissingRequestHashes = make(map[uint64]string)
-			}
-			var mapkey uint64
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLength
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLength
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skip(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLength
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.PreSet = append(m.PreSet, &Prepare{})
+			if err := m.PreSet[len(m.PreSet)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.MissingRequestHashes[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissingRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CmtSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11175,96 +17983,188 @@ func (m *FetchMissingResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MissingRequests == nil {
-				m.MissingRequests = make(map[uint64][]byte)
+			m.CmtSet = append(m.CmtSet, &Commit{})
+			if err := m.CmtSet[len(m.CmtSet)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey uint64
-			var mapvalue []byte
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncState) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorP2PNodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapbyteLen uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
-						return ErrInvalidLength
-					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
-						return ErrInvalidLength
-					}
-					if postbytesIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skip(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLength
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.MissingRequests[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorP2PNodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStateResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedCheckpoint", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -11274,11 +18174,28 @@ func (m *FetchMissingResponse) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= FetchMissingResponse_Status(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignedCheckpoint == nil {
+				m.SignedCheckpoint = &SignedCheckpoint{}
+			}
+			if err := m.SignedCheckpoint.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -11301,7 +18218,7 @@ func (m *FetchMissingResponse) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FetchPQCRequest) UnmarshalVT(dAtA []byte) error {
+func (m *SnapshotManifest) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11324,17 +18241,17 @@ func (m *FetchPQCRequest) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FetchPQCRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotManifest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FetchPQCRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotManifest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.ReplicaId = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -11344,16 +18261,16 @@ func (m *FetchPQCRequest) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReplicaId |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field H", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			m.H = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -11363,11 +18280,90 @@ func (m *FetchPQCRequest) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.H |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
+			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -11390,7 +18386,7 @@ func (m *FetchPQCRequest) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FetchPQCResponse) UnmarshalVT(dAtA []byte) error {
+func (m *SnapshotManifestRequest) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11413,10 +18409,10 @@ func (m *FetchPQCResponse) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FetchPQCResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotManifestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FetchPQCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotManifestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11439,10 +18435,10 @@ func (m *FetchPQCResponse) UnmarshalVT(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepreSet", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -11452,31 +18448,16 @@ func (m *FetchPQCResponse) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PrepreSet = append(m.PrepreSet, &PrePrepare{})
-			if err := m.PrepreSet[len(m.PrepreSet)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -11486,29 +18467,97 @@ func (m *FetchPQCResponse) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreSet = append(m.PreSet, &Prepare{})
-			if err := m.PreSet[len(m.PreSet)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotManifestResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotManifestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CmtSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11535,8 +18584,10 @@ func (m *FetchPQCResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CmtSet = append(m.CmtSet, &Commit{})
-			if err := m.CmtSet[len(m.CmtSet)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Manifest == nil {
+				m.Manifest = &SnapshotManifest{}
+			}
+			if err := m.Manifest.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11562,7 +18613,7 @@ func (m *FetchPQCResponse) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncState) UnmarshalVT(dAtA []byte) error {
+func (m *SnapshotChunkRequest) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11581,19 +18632,57 @@ func (m *SyncState) UnmarshalVT(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotChunkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorP2PNodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11621,8 +18710,27 @@ func (m *SyncState) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthorP2PNodeId = string(dAtA[iNdEx:postIndex])
+			m.Digest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -11645,7 +18753,7 @@ func (m *SyncState) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStateResponse) UnmarshalVT(dAtA []byte) error {
+func (m *SnapshotChunkResponse) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11668,10 +18776,10 @@ func (m *SyncStateResponse) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotChunkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11695,9 +18803,9 @@ func (m *SyncStateResponse) UnmarshalVT(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.View = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -11707,16 +18815,16 @@ func (m *SyncStateResponse) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.View |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedCheckpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -11726,26 +18834,75 @@ func (m *SyncStateResponse) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SignedCheckpoint == nil {
-				m.SignedCheckpoint = &SignedCheckpoint{}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			if err := m.SignedCheckpoint.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -12192,6 +19349,40 @@ func (m *Checkpoint_ExecuteState) UnmarshalVT(dAtA []byte) error {
 			}
 			m.BatchDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VrfOutput", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VrfOutput = append(m.VrfOutput[:0], dAtA[iNdEx:postIndex]...)
+			if m.VrfOutput == nil {
+				m.VrfOutput = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -12354,6 +19545,74 @@ func (m *Checkpoint) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorKeys = append(m.ValidatorKeys, &ValidatorInfo{})
+			if err := m.ValidatorKeys[len(m.ValidatorKeys)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputationPenalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputationPenalties = append(m.ReputationPenalties, &NodeDynamicInfo{})
+			if err := m.ReputationPenalties[len(m.ReputationPenalties)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -12596,6 +19855,59 @@ func (m *ValidatorInfo) UnmarshalVT(dAtA []byte) error {
 			}
 			m.P2PId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyVersion", wireType)
+			}
+			m.KeyVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -42,6 +42,12 @@ const (
 	highWatermarkTimer    = "highWatermarkTimer"    // timer for nodes to find the problem of missing too much checkpoint
 	fetchViewTimer        = "fetchViewTimer"        // timer for nodes to fetch view periodically
 	noTxBatchTimer        = "noTxBatchTimer"        // timer for primary triggering package a batch which no transaction to send pre-prepare
+	fastPathTimer         = "fastPathTimer"         // timer for nodes to wait for prepares from all replicas before falling back to commit phase
+	hotstuffViewTimer     = "hotstuffViewTimer"     // timer for chained HotStuff nodes to wait for a proposal of current view
+	fetchTimer            = "fetchTimer"            // timer for nodes to retry in-flight fetches of missing batches or txs
+	snapshotSyncTimer     = "snapshotSyncTimer"     // timer for nodes to retry requests of snapshot sync without response
+	stateUpdateTimer      = "stateUpdateTimer"      // timer for nodes to re-issue state update without progress
+	decryptionShareTimer  = "decryptionShareTimer"  // timer for validators to resend decryption shares of committed batches not decrypted
 )
 
 // constant default
@@ -59,6 +65,11 @@ const (
 	DefaultCheckPoolTimeout        = 3 * time.Minute
 	DefaultFetchCheckpointTimeout  = 5 * time.Second
 	DefaultFetchViewTimeout        = 1 * time.Second
+	DefaultFastPathTimeout         = 100 * time.Millisecond
+	DefaultHotStuffViewTimeout     = 2 * time.Second
+	DefaultFetchTimeout            = 1 * time.Second
+	DefaultSnapshotSyncTimeout     = 5 * time.Second
+	DefaultDecryptionShareTimeout  = 1 * time.Second
 
 	// default k value
 	DefaultK = 10
@@ -76,6 +87,12 @@ const (
 	CoreFindNextPrepareBatchesEvent
 	CoreHighWatermarkEvent
 	CoreNoTxBatchTimerEvent
+	CoreFastPathTimerEvent
+	CoreHotStuffViewTimerEvent
+	CoreFetchTimerEvent
+	CoreStateUpdateProgressEvent
+	CoreStateUpdateTimerEvent
+	CoreDecryptionShareTimerEvent
 
 	// 2.view change
 	ViewChangeTimerEvent
@@ -88,6 +105,8 @@ const (
 	RecoveryInitEvent
 	RecoverySyncStateRspTimerEvent
 	RecoverySyncStateRestartTimerEvent
+	RecoverySnapshotSyncTimerEvent
+	RecoverySnapshotServeEvent
 
 	// 4.epoch mgr service
 	FetchCheckpointEvent
@@ -115,12 +134,17 @@ var canProcessEventsWhenWaitCheckpoint = map[int]struct{}{
 var canProcessMsgsWhenWaitCheckpoint = map[consensus.Type]struct{}{
 	// CoreRbftService
 	consensus.Type_FETCH_MISSING_REQUEST: {},
+	consensus.Type_KEY_ROTATION:          {},
 
 	// RecoveryService
-	consensus.Type_SYNC_STATE:          {},
-	consensus.Type_SYNC_STATE_RESPONSE: {},
-	consensus.Type_FETCH_PQC_REQUEST:   {},
-	consensus.Type_FETCH_PQC_RESPONSE:  {},
+	consensus.Type_SYNC_STATE:                 {},
+	consensus.Type_SYNC_STATE_RESPONSE:        {},
+	consensus.Type_FETCH_PQC_REQUEST:          {},
+	consensus.Type_FETCH_PQC_RESPONSE:         {},
+	consensus.Type_SNAPSHOT_MANIFEST_REQUEST:  {},
+	consensus.Type_SNAPSHOT_MANIFEST_RESPONSE: {},
+	consensus.Type_SNAPSHOT_CHUNK_REQUEST:     {},
+	consensus.Type_SNAPSHOT_CHUNK_RESPONSE:    {},
 
 	// EpochMgrService
 	consensus.Type_FETCH_CHECKPOINT:     {},
@@ -158,6 +182,7 @@ var validatorAcceptMsgsFromNonValidator = map[consensus.Type]struct{}{
 	consensus.Type_FETCH_BATCH_REQUEST:     {},
 	consensus.Type_FETCH_PQC_REQUEST:       {},
 	consensus.Type_FETCH_MISSING_REQUEST:   {},
+	consensus.Type_HOTSTUFF_FETCH_BLOCK:    {},
 	consensus.Type_SYNC_STATE:              {},
 	consensus.Type_EPOCH_CHANGE_REQUEST:    {},
 }
@@ -212,6 +237,10 @@ type RequestBatch[T any, Constraint types.TXConstraint[T]] struct {
 	LocalList       []bool
 	BatchHash       string
 	Proposer        uint64
+	VRFProof        []byte
+	VRFOutput       []byte
+	KeyRotations    []*consensus.KeyRotation
+	BFTTime         int64
 }
 
 func (b *RequestBatch[T, Constraint]) ToPB() (*consensus.RequestBatch, error) {
@@ -227,6 +256,10 @@ func (b *RequestBatch[T, Constraint]) ToPB() (*consensus.RequestBatch, error) {
 		LocalList:       b.LocalList,
 		BatchHash:       b.BatchHash,
 		Proposer:        b.Proposer,
+		VrfProof:        b.VRFProof,
+		VrfOutput:       b.VRFOutput,
+		KeyRotations:    b.KeyRotations,
+		BftTime:         b.BFTTime,
 	}, nil
 }
 
@@ -242,9 +275,25 @@ func (b *RequestBatch[T, Constraint]) FromPB(pb *consensus.RequestBatch) error {
 	b.LocalList = pb.LocalList
 	b.BatchHash = pb.BatchHash
 	b.Proposer = pb.Proposer
+	b.VRFProof = pb.VrfProof
+	b.VRFOutput = pb.VrfOutput
+	b.KeyRotations = pb.KeyRotations
+	b.BFTTime = pb.BftTime
 	return nil
 }
 
+// hashBatch returns the batch in the form carried by pre-prepare.
+func (b *RequestBatch[T, Constraint]) hashBatch() *consensus.HashBatch {
+	return &consensus.HashBatch{
+		RequestHashList: b.RequestHashList,
+		Timestamp:       b.Timestamp,
+		Proposer:        b.Proposer,
+		VrfProof:        b.VRFProof,
+		KeyRotations:    b.KeyRotations,
+		BftTime:         b.BFTTime,
+	}
+}
+
 func (b *RequestBatch[T, Constraint]) Marshal() ([]byte, error) {
 	pbData, err := b.ToPB()
 	if err != nil {
@@ -308,6 +357,11 @@ type msgCert struct {
 	committedTime   int64                         // committed time
 	sentExecute     bool                          // track whether sent execute event to executor module before or not
 	isConfig        bool                          // track whether current batch is a config batch
+
+	sentAggregatedCommits bool   // track whether primary broadcast aggregated commits in linear vote aggregation mode
+	fastPathTimerKey      string // key of the running fast path timer of this batch
+	fastPathTimeout       bool   // track whether fast path timer expired and fall back to commit phase
+	fastCommitted         bool   // track whether this batch is committed by fast path without commit phase
 }
 
 // ----------checkpoint related structs------------------
@@ -340,6 +394,9 @@ const (
 	ReqGetWatermarkEvent = iota
 	NotifyGenBatchEvent
 	NotifyFindNextBatchEvent
+	ReqTransferLeadershipEvent
+	ReqLeaderScheduleEvent
+	ReqRotateKeyEvent
 )
 
 // MiscEvent represents misc event sent by local modules
@@ -352,6 +409,21 @@ type ReqGetWatermarkMsg struct {
 	ch chan uint64
 }
 
+type ReqTransferLeadershipMsg struct {
+	ch chan error
+}
+
+type ReqRotateKeyMsg struct {
+	pubKey []byte
+	ch     chan error
+}
+
+type ReqLeaderScheduleMsg struct {
+	fromView uint64
+	count    uint64
+	ch       chan *LeaderSchedule
+}
+
 type NotifyFindNextBatchMsg struct {
 	hashes []string
 }
@@ -0,0 +1,197 @@
+package rbft
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+const (
+	// minCompressPayloadSize is the min payload size of consensus messages to compress.
+	minCompressPayloadSize = 4 << 10
+
+	// maxDecompressedPayloadSize limits the payload size after decompressed by the built-in flate
+	// compressor to avoid decompression bomb.
+	maxDecompressedPayloadSize = 256 << 20
+)
+
+// compressibleMsgs are consensus messages whose payload may be large enough to compress.
+var compressibleMsgs = map[consensus.Type]struct{}{
+	consensus.Type_REBROADCAST_REQUEST_SET: {},
+	consensus.Type_FETCH_BATCH_RESPONSE:    {},
+	consensus.Type_FETCH_MISSING_RESPONSE:  {},
+	consensus.Type_FETCH_PQC_RESPONSE:      {},
+	consensus.Type_EPOCH_CHANGE_PROOF:      {},
+	consensus.Type_SNAPSHOT_CHUNK_RESPONSE: {},
+}
+
+// PayloadCompressor compresses the payload of large consensus messages, the compression type is
+// carried in consensus messages so that receivers can decompress them with the same compressor.
+// Every message also advertises the compression type accepted by its sender, and messages are only
+// compressed for peers advertising the same type, so that nodes with different compressors or
+// without compression can work together.
+type PayloadCompressor interface {
+	// Type returns the compression type of the compressor.
+	Type() consensus.CompressionType
+
+	// Compress compresses the given payload.
+	Compress(data []byte) ([]byte, error)
+
+	// Decompress decompresses the given payload.
+	Decompress(data []byte) ([]byte, error)
+}
+
+type flateCompressor struct {
+	level int
+}
+
+// NewFlateCompressor returns a PayloadCompressor implemented by compress/flate with the given level.
+func NewFlateCompressor(level int) PayloadCompressor {
+	return &flateCompressor{level: level}
+}
+
+func (c *flateCompressor) Type() consensus.CompressionType {
+	return consensus.CompressionType_FLATE
+}
+
+func (c *flateCompressor) Compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, c.level)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(data); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *flateCompressor) Decompress(data []byte) ([]byte, error) {
+	r := flate.NewReader(bytes.NewReader(data))
+	defer r.Close()
+	res, err := io.ReadAll(io.LimitReader(r, maxDecompressedPayloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(res) > maxDecompressedPayloadSize {
+		return nil, errors.Errorf("decompressed payload exceeds %d bytes", maxDecompressedPayloadSize)
+	}
+	return res, nil
+}
+
+// peerCompression is the compression type advertised by a peer.
+type peerCompression struct {
+	p2pID       string
+	compression consensus.CompressionType
+}
+
+// recordCompression records the compression type advertised by the given validator, it should only be
+// called in the event loop after the sender of message is checked.
+func (m *peerManager) recordCompression(from uint64, compression consensus.CompressionType) {
+	if m.compressor == nil {
+		return
+	}
+	m.compressionLock.RLock()
+	p, ok := m.peerCompressions[from]
+	m.compressionLock.RUnlock()
+	if ok && p.compression == compression {
+		return
+	}
+	n, err := m.chainConfig.getNodeInfo(from)
+	if err != nil {
+		return
+	}
+
+	m.compressionLock.Lock()
+	defer m.compressionLock.Unlock()
+	m.peerCompressions[from] = peerCompression{p2pID: n.P2PNodeID, compression: compression}
+}
+
+// peerAcceptCompression checks if the peer with given p2p id accepts the compression type of local
+// compressor. An empty p2p id means a broadcast, which requires all other validators to accept it.
+func (m *peerManager) peerAcceptCompression(p2pID string) bool {
+	m.compressionLock.RLock()
+	defer m.compressionLock.RUnlock()
+
+	if p2pID == "" {
+		for id := range m.chainConfig.ValidatorSet {
+			if id == m.chainConfig.SelfID {
+				continue
+			}
+			if p, ok := m.peerCompressions[id]; !ok || p.compression != m.compressor.Type() {
+				return false
+			}
+		}
+		return true
+	}
+	for _, p := range m.peerCompressions {
+		if p.p2pID == p2pID {
+			return p.compression == m.compressor.Type()
+		}
+	}
+	return false
+}
+
+// compress returns the message to send to the peer with given p2p id, or all peers if it's empty, whose
+// payload is compressed if the message is compressible, the receivers accept the compression type and
+// the payload gets smaller after compressed. The given message is not modified as it may be reused by
+// caller.
+func (m *peerManager) compress(msg *consensus.ConsensusMessage, p2pID string) *consensus.ConsensusMessage {
+	if m.compressor == nil || len(msg.Payload) < minCompressPayloadSize {
+		return msg
+	}
+	if _, ok := compressibleMsgs[msg.Type]; !ok {
+		return msg
+	}
+	if !m.peerAcceptCompression(p2pID) {
+		return msg
+	}
+
+	compressed, err := m.compressor.Compress(msg.Payload)
+	if err != nil {
+		m.logger.Warningf("Compress msg[%s] failed, send it uncompressed: %v", msg.Type.String(), err)
+		return msg
+	}
+	if len(compressed) >= len(msg.Payload) {
+		return msg
+	}
+	m.metrics.compressedPayloadBytes.With("type", msg.Type.String(), "stage", "before").Add(float64(len(msg.Payload)))
+	m.metrics.compressedPayloadBytes.With("type", msg.Type.String(), "stage", "after").Add(float64(len(compressed)))
+	return &consensus.ConsensusMessage{
+		Type:              msg.Type,
+		From:              msg.From,
+		To:                msg.To,
+		Epoch:             msg.Epoch,
+		View:              msg.View,
+		Payload:           compressed,
+		Nonce:             msg.Nonce,
+		Compression:       m.compressor.Type(),
+		AcceptCompression: msg.AcceptCompression,
+		Signature:         msg.Signature,
+	}
+}
+
+// decompress decompresses the payload of the given message in place. As decompression is costly, it
+// should only be called after the message passes the sender checks and rate limiter.
+func (m *peerManager) decompress(msg *consensus.ConsensusMessage) error {
+	if msg.Compression == consensus.CompressionType_NONE {
+		return nil
+	}
+	if m.compressor == nil || m.compressor.Type() != msg.Compression {
+		return errors.Errorf("unsupported compression type %s", msg.Compression.String())
+	}
+	payload, err := m.compressor.Decompress(msg.Payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decompress msg[%s]", msg.Type.String())
+	}
+	msg.Payload = payload
+	msg.Compression = consensus.CompressionType_NONE
+	return nil
+}
@@ -0,0 +1,105 @@
+package rbft
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+func TestPeerManager_compress(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	m := rbfts[0].peerMgr
+	payload := bytes.Repeat([]byte("payload"), minCompressPayloadSize)
+	msg := &consensus.ConsensusMessage{Type: consensus.Type_FETCH_BATCH_RESPONSE, Payload: payload}
+
+	// not compressed without compressor.
+	assert.Equal(t, msg, m.compress(msg, ""))
+
+	// not compressed for peers not advertising the same compression type.
+	m.compressor = NewFlateCompressor(-1)
+	n2, _ := m.chainConfig.getNodeInfo(2)
+	assert.Equal(t, msg, m.compress(msg, n2.P2PNodeID))
+	m.recordCompression(2, consensus.CompressionType_ZSTD)
+	assert.Equal(t, msg, m.compress(msg, n2.P2PNodeID))
+
+	m.recordCompression(2, consensus.CompressionType_FLATE)
+	compressed := m.compress(msg, n2.P2PNodeID)
+	assert.Equal(t, consensus.CompressionType_FLATE, compressed.Compression)
+	assert.Less(t, len(compressed.Payload), len(payload))
+	// message of caller is not modified.
+	assert.Equal(t, consensus.CompressionType_NONE, msg.Compression)
+	assert.Equal(t, payload, msg.Payload)
+
+	assert.Nil(t, m.decompress(compressed))
+	assert.Equal(t, consensus.CompressionType_NONE, compressed.Compression)
+	assert.Equal(t, payload, compressed.Payload)
+
+	// small or incompressible messages are not compressed.
+	small := &consensus.ConsensusMessage{Type: consensus.Type_FETCH_BATCH_RESPONSE, Payload: []byte("payload")}
+	assert.Equal(t, small, m.compress(small, n2.P2PNodeID))
+	prepare := &consensus.ConsensusMessage{Type: consensus.Type_PREPARE, Payload: payload}
+	assert.Equal(t, prepare, m.compress(prepare, n2.P2PNodeID))
+
+	// broadcast messages are only compressed if all validators accept it.
+	assert.Equal(t, msg, m.compress(msg, ""))
+	m.recordCompression(3, consensus.CompressionType_FLATE)
+	m.recordCompression(4, consensus.CompressionType_FLATE)
+	assert.Equal(t, consensus.CompressionType_FLATE, m.compress(msg, "").Compression)
+
+	// message of unsupported compression type is rejected.
+	compressed = m.compress(msg, n2.P2PNodeID)
+	compressed.Compression = consensus.CompressionType_ZSTD
+	assert.NotNil(t, m.decompress(compressed))
+	compressed.Compression = consensus.CompressionType_FLATE
+	m.compressor = nil
+	assert.NotNil(t, m.decompress(compressed))
+}
+
+func TestPeerManager_broadcastCompressed(t *testing.T) {
+	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	for _, r := range rbfts {
+		r.peerMgr.compressor = NewFlateCompressor(-1)
+	}
+
+	txs := make([]*consensus.FltTransaction, 200)
+	for i := range txs {
+		txs[i] = newTx()
+	}
+	reqSet := &RequestSet[consensus.FltTransaction, *consensus.FltTransaction]{Requests: txs}
+
+	// not compressed before other validators advertise the compression type.
+	rbfts[1].broadcastReqSet(reqSet)
+	msg := nodes[1].broadcastMessageCache
+	assert.Equal(t, consensus.CompressionType_NONE, msg.Compression)
+	assert.Equal(t, consensus.CompressionType_FLATE, msg.AcceptCompression)
+
+	for _, i := range []int{0, 2, 3} {
+		rbfts[i].processEvent(msg)
+	}
+	for _, i := range []int{0, 2, 3} {
+		rbfts[i].broadcastReqSet(reqSet)
+		rbfts[1].processEvent(nodes[i].broadcastMessageCache)
+	}
+	rbfts[1].broadcastReqSet(reqSet)
+	msg = nodes[1].broadcastMessageCache
+	assert.Equal(t, consensus.CompressionType_FLATE, msg.Compression)
+
+	// messages are decompressed in event loop after checked.
+	rbfts[2].step(msg.ctx, msg.ConsensusMessage)
+	var ev consensusEvent
+	for ev == nil {
+		if wrapper, ok := (<-rbfts[2].recvChan).(*consensusMessageWrapper); ok {
+			ev = wrapper
+		}
+	}
+	assert.Equal(t, consensus.CompressionType_FLATE, ev.(*consensusMessageWrapper).Compression)
+	rbfts[2].processEvent(ev)
+	assert.Equal(t, consensus.CompressionType_NONE, ev.(*consensusMessageWrapper).Compression)
+	set := &RequestSet[consensus.FltTransaction, *consensus.FltTransaction]{}
+	assert.Nil(t, set.Unmarshal(ev.(*consensusMessageWrapper).Payload))
+	assert.Equal(t, len(txs), len(set.Requests))
+}
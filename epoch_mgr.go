@@ -46,9 +46,19 @@ type epochManager struct {
 	// epoch related service
 	epochService EpochService
 
+	// delete epoch state of pruned epochs, nil if not implemented by epoch service
+	pruner EpochStatePruner
+
 	// It is persisted after updating to epochs
 	epochProofCache map[uint64]*consensus.EpochChange
 
+	// max number of epochs kept in epochProofCache and checkpointCache
+	proofCacheSize int
+
+	// quorum checkpoints of the latest epochs, which may be read out of the event loop
+	checkpointCache     map[uint64]*consensus.QuorumCheckpoint
+	checkpointCacheLock sync.RWMutex
+
 	// peer pool
 	peerMgr *peerManager
 
@@ -63,17 +73,24 @@ type epochManager struct {
 }
 
 func newEpochManager(chainConfig *ChainConfig, c Config, pp *peerManager, epochService EpochService, storage Storage) *epochManager {
+	proofCacheSize := c.EpochProofCacheSize
+	if proofCacheSize <= 0 {
+		proofCacheSize = MaxNumEpochEndingCheckpoint
+	}
 	em := &epochManager{
 		chainConfig:          chainConfig,
 		configBatchToCheck:   nil,
 		configBatchToExecute: uint64(0),
 		epochService:         epochService,
 		epochProofCache:      make(map[uint64]*consensus.EpochChange),
+		proofCacheSize:       proofCacheSize,
+		checkpointCache:      make(map[uint64]*consensus.QuorumCheckpoint),
 		peerMgr:              pp,
 		storage:              storage,
 		logger:               c.Logger,
 		config:               c,
 	}
+	em.pruner, _ = epochService.(EpochStatePruner)
 
 	return em
 }
@@ -176,6 +193,8 @@ func (rbft *rbftImpl[T, Constraint]) turnIntoEpoch() {
 	rbft.stopFetchViewTimer()
 	rbft.vcMgr = newVcManager(rbft.config)
 	rbft.recoveryMgr = newRecoveryMgr(rbft.config)
+	// commits of previous epoch can't be verified by validators of new epoch.
+	rbft.batchMgr.bftTimeCommits = nil
 
 	// set the latest epoch
 	rbft.updateEpochInfo(newEpoch, newValidatorSet)
@@ -188,6 +207,7 @@ func (rbft *rbftImpl[T, Constraint]) turnIntoEpoch() {
 	// initial view 0 in new epoch.
 	rbft.persistNewView(initialNewView, true)
 	rbft.logger.Infof("Replica %d persist view=%d after epoch change", rbft.chainConfig.SelfID, rbft.chainConfig.View)
+	rbft.updateTxForwardPrimary()
 
 	// clean cached old epoch proof
 	for epoch := range rbft.epochMgr.epochProofCache {
@@ -225,7 +245,11 @@ func (rbft *rbftImpl[T, Constraint]) updateEpochInfo(epochInfo *kittypes.EpochIn
 		rbft.logger.Criticalf("Replica %d failed to check epoch info for epoch %d from ledger: %v", rbft.chainConfig.SelfID, epochInfo.Epoch, err)
 		return
 	}
-	rbft.chainConfig.ResetRecentBlockNum(rbft.chainConfig.LastCheckpointExecBlockHeight)
+	// keep the timeout penalties of validators across epochs.
+	rbft.restoreReputationPenalties()
+	if err := rbft.chainConfig.ResetRecentBlockNum(rbft.chainConfig.LastCheckpointExecBlockHeight); err != nil {
+		rbft.logger.Errorf("Replica %d failed to reset recent blocks for epoch %d: %v", rbft.chainConfig.SelfID, epochInfo.Epoch, err)
+	}
 	newRole := "validator"
 	if rbft.chainConfig.isValidator() {
 		newRole = "candidate"
@@ -266,6 +290,10 @@ func (em *epochManager) checkEpoch(msg *consensus.ConsensusMessage) consensusEve
 			"current epoch %d, remote epoch %d", em.chainConfig.SelfID, msg.Type, msg.From, currentEpoch, remoteEpoch)
 		// first process epoch sync response with higher epoch.
 		if msg.Type == consensus.Type_EPOCH_CHANGE_PROOF {
+			if err := em.peerMgr.decompress(msg); err != nil {
+				em.logger.Warningf("Replica %d reject msg from %d: %v", em.chainConfig.SelfID, msg.From, err)
+				return nil
+			}
 			proof := &consensus.EpochChangeProof{}
 			if uErr := proof.UnmarshalVT(msg.Payload); uErr != nil {
 				em.logger.Warningf("Unmarshal EpochChangeProof failed: %s", uErr)
@@ -425,6 +453,29 @@ func (em *epochManager) pagingGetEpochChangeProof(startEpoch, endEpoch, pageLimi
 	}, nil
 }
 
+// getEpochChangeProof returns the epoch change proof from startEpoch to endEpoch for external clients,
+// endEpoch is capped at the epoch after the latest persisted epoch change. It only reads persisted
+// quorum checkpoints, so it's safe to be called out of the event loop.
+func (em *epochManager) getEpochChangeProof(startEpoch, endEpoch uint64) (*consensus.EpochChangeProof, error) {
+	latest, err := em.getLatestEpochIndex()
+	if err != nil {
+		return nil, err
+	}
+	if endEpoch > latest+1 {
+		endEpoch = latest + 1
+	}
+	if startEpoch >= endEpoch {
+		return nil, errors.Errorf("illegal epoch change from %d to %d, latest epoch change %d", startEpoch, endEpoch, latest)
+	}
+
+	proof, err := em.pagingGetEpochChangeProof(startEpoch, endEpoch, MaxNumEpochEndingCheckpoint)
+	if err != nil {
+		return nil, err
+	}
+	proof.GenesisBlockDigest = em.config.GenesisBlockDigest
+	return proof, nil
+}
+
 func (em *epochManager) verifyEpochChangeProof(proof *consensus.EpochChangeProof) error {
 	// Skip any stale checkpoints in the proof prefix. Note that with
 	// the assertion above, we are guaranteed there is at least one
@@ -492,10 +543,82 @@ func (em *epochManager) persistEpochQuorumCheckpoint(c *consensus.QuorumCheckpoi
 	if err = em.epochService.StoreEpochState(indexKey, data); err != nil {
 		em.logger.Errorf("Persist epoch index %d failed with err: %s ", c.Checkpoint.Epoch, err)
 	}
+
+	em.cacheQuorumCheckpoint(c)
+	em.pruneEpochQuorumCheckpoint(c.Checkpoint.Epoch)
+}
+
+// cacheQuorumCheckpoint keeps the QuorumCheckpoint in memory and evicts the ones out of the latest
+// proofCacheSize epochs.
+func (em *epochManager) cacheQuorumCheckpoint(c *consensus.QuorumCheckpoint) {
+	em.checkpointCacheLock.Lock()
+	defer em.checkpointCacheLock.Unlock()
+
+	epoch := c.Checkpoint.Epoch
+	em.checkpointCache[epoch] = c
+	for e := range em.checkpointCache {
+		if e+uint64(em.proofCacheSize) <= epoch {
+			delete(em.checkpointCache, e)
+		}
+	}
+}
+
+// pruneEpochQuorumCheckpoint deletes the QuorumCheckpoint out of the latest EpochProofRetention epochs
+// from storage after persisting the given epoch.
+func (em *epochManager) pruneEpochQuorumCheckpoint(latest uint64) {
+	retention := em.config.EpochProofRetention
+	if retention == 0 || latest < retention || em.pruner == nil {
+		return
+	}
+	epoch := latest - retention
+	em.checkpointCacheLock.Lock()
+	delete(em.checkpointCache, epoch)
+	em.checkpointCacheLock.Unlock()
+	if err := em.pruner.DelEpochState(fmt.Sprintf("%s%d", EpochStatePrefix, epoch)); err != nil {
+		em.logger.Warningf("Prune epoch %d quorum chkpt failed with err: %s ", epoch, err)
+		return
+	}
+	em.logger.Debugf("Replica %d pruned epoch %d quorum chkpt", em.chainConfig.SelfID, epoch)
+}
+
+// cacheEpochProof caches the epoch change synced from others to persist after state update. Only the
+// latest proofCacheSize epoch changes are cached, as state update always goes to the last one.
+func (em *epochManager) cacheEpochProof(ec *consensus.EpochChange) {
+	epoch := ec.Checkpoint.Epoch()
+	em.epochProofCache[epoch] = ec
+	if len(em.epochProofCache) <= em.proofCacheSize {
+		return
+	}
+	lowest := epoch
+	for e := range em.epochProofCache {
+		if e < lowest {
+			lowest = e
+		}
+	}
+	delete(em.epochProofCache, lowest)
+}
+
+// getLatestEpochIndex returns the epoch of the latest persisted QuorumCheckpoint
+func (em *epochManager) getLatestEpochIndex() (uint64, error) {
+	raw, err := em.epochService.ReadEpochState(EpochIndexKey)
+	if err != nil {
+		return 0, errors.WithMessage(err, "failed to read latest epoch index")
+	}
+	if len(raw) != 8 {
+		return 0, errors.Errorf("invalid latest epoch index %x", raw)
+	}
+	return binary.BigEndian.Uint64(raw), nil
 }
 
 // persistDelCheckpoint get QuorumCheckpoint with the given epoch
 func (em *epochManager) getEpochQuorumCheckpoint(epoch uint64) (*consensus.QuorumCheckpoint, error) {
+	em.checkpointCacheLock.RLock()
+	cached, ok := em.checkpointCache[epoch]
+	em.checkpointCacheLock.RUnlock()
+	if ok {
+		return cached.CloneVT(), nil
+	}
+
 	key := fmt.Sprintf("%s%d", EpochStatePrefix, epoch)
 
 	raw, err := em.epochService.ReadEpochState(key)
@@ -1,10 +1,14 @@
 package rbft
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 
+	"github.com/axiomesh/axiom-bft/common"
 	"github.com/axiomesh/axiom-bft/common/consensus"
 	"github.com/axiomesh/axiom-bft/types"
 )
@@ -72,3 +76,119 @@ func TestEpoch_recvFetchCheckpoint_SendBackStableCheckpoint(t *testing.T) {
 	assert.Nil(t, ret)
 	assert.Equal(t, consensusMsg, nodes[0].unicastMessageCache.ConsensusMessage)
 }
+
+func newEpochStateTestManager(t *testing.T, c Config) (*epochManager, map[string][]byte) {
+	ctrl := gomock.NewController(t)
+	states := make(map[string][]byte)
+	ext := NewMockExternalStack[consensus.FltTransaction, *consensus.FltTransaction](ctrl)
+	ext.EXPECT().StoreEpochState(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, value []byte) error {
+		states[key] = value
+		return nil
+	}).AnyTimes()
+	ext.EXPECT().ReadEpochState(gomock.Any()).DoAndReturn(func(key string) ([]byte, error) {
+		value, ok := states[key]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return value, nil
+	}).AnyTimes()
+	pruner := NewMockEpochStatePruner(ctrl)
+	pruner.EXPECT().DelEpochState(gomock.Any()).DoAndReturn(func(key string) error {
+		delete(states, key)
+		return nil
+	}).AnyTimes()
+	epochService := struct {
+		EpochService
+		EpochStatePruner
+	}{ext, pruner}
+	c.Logger = common.NewSimpleLogger()
+	return newEpochManager(&ChainConfig{EpochDerivedData: EpochDerivedData{SelfID: 1}}, c, nil, epochService, nil), states
+}
+
+func persistTestEpochQuorumCheckpoints(em *epochManager, from, to uint64) {
+	for epoch := from; epoch <= to; epoch++ {
+		em.persistEpochQuorumCheckpoint(&consensus.QuorumCheckpoint{
+			Checkpoint: &consensus.Checkpoint{
+				Epoch:        epoch,
+				ExecuteState: &consensus.Checkpoint_ExecuteState{Height: epoch * 100, Digest: "digest"},
+			},
+		})
+	}
+}
+
+func TestEpoch_getEpochChangeProof(t *testing.T) {
+	em, _ := newEpochStateTestManager(t, Config{GenesisBlockDigest: "genesis"})
+
+	// no epoch change yet.
+	_, err := em.getEpochChangeProof(1, 2)
+	assert.NotNil(t, err)
+
+	latest := uint64(MaxNumEpochEndingCheckpoint + 10)
+	persistTestEpochQuorumCheckpoints(em, 1, latest)
+
+	c, err := em.getEpochQuorumCheckpoint(3)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(300), c.Height())
+	_, err = em.getEpochQuorumCheckpoint(latest + 1)
+	assert.NotNil(t, err)
+
+	proof, err := em.getEpochChangeProof(3, 6)
+	assert.Nil(t, err)
+	assert.Len(t, proof.EpochChanges, 3)
+	assert.Equal(t, uint64(3), proof.EpochChanges[0].GetCheckpoint().Epoch())
+	assert.Equal(t, uint64(0), proof.More)
+	assert.Equal(t, "genesis", proof.GenesisBlockDigest)
+
+	// paginated by MaxNumEpochEndingCheckpoint.
+	proof, err = em.getEpochChangeProof(1, latest+1)
+	assert.Nil(t, err)
+	assert.Len(t, proof.EpochChanges, MaxNumEpochEndingCheckpoint)
+	assert.Equal(t, latest+1, proof.More)
+
+	// end epoch is capped at the latest epoch change.
+	proof, err = em.getEpochChangeProof(latest-1, latest+100)
+	assert.Nil(t, err)
+	assert.Len(t, proof.EpochChanges, 2)
+	assert.Equal(t, uint64(0), proof.More)
+
+	_, err = em.getEpochChangeProof(latest+1, latest+2)
+	assert.NotNil(t, err)
+}
+
+func TestEpoch_epochProofRetention(t *testing.T) {
+	em, states := newEpochStateTestManager(t, Config{EpochProofCacheSize: 2, EpochProofRetention: 3})
+	persistTestEpochQuorumCheckpoints(em, 1, 5)
+
+	// only the latest EpochProofRetention epochs are kept in storage.
+	for epoch := uint64(1); epoch <= 5; epoch++ {
+		_, ok := states[fmt.Sprintf("%s%d", EpochStatePrefix, epoch)]
+		assert.Equal(t, epoch > 2, ok)
+	}
+	_, err := em.getEpochQuorumCheckpoint(2)
+	assert.NotNil(t, err)
+	_, err = em.getEpochChangeProof(2, 6)
+	assert.NotNil(t, err)
+	proof, err := em.getEpochChangeProof(3, 6)
+	assert.Nil(t, err)
+	assert.Len(t, proof.EpochChanges, 3)
+
+	// only the latest EpochProofCacheSize epochs are kept in memory, older ones are read from storage.
+	assert.Len(t, em.checkpointCache, 2)
+	delete(states, fmt.Sprintf("%s%d", EpochStatePrefix, 5))
+	c, err := em.getEpochQuorumCheckpoint(5)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(500), c.Height())
+	c, err = em.getEpochQuorumCheckpoint(3)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(300), c.Height())
+
+	// epoch changes synced from others are limited too.
+	for epoch := uint64(6); epoch <= 8; epoch++ {
+		em.cacheEpochProof(&consensus.EpochChange{Checkpoint: &consensus.QuorumCheckpoint{
+			Checkpoint: &consensus.Checkpoint{Epoch: epoch},
+		}})
+	}
+	assert.Len(t, em.epochProofCache, 2)
+	assert.Contains(t, em.epochProofCache, uint64(8))
+	assert.NotContains(t, em.epochProofCache, uint64(6))
+}
@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/axiomesh/axiom-bft/common/consensus"
 	"github.com/axiomesh/axiom-bft/types"
 	"github.com/axiomesh/axiom-kit/txpool"
@@ -27,6 +29,10 @@ import (
 // executor manages exec related params
 type executor struct {
 	lastExec uint64
+
+	// lastExecTimestamp is the timestamp of the latest executed batch with txs, it's loaded
+	// from ledger after restart or state update if BFT time is enabled.
+	lastExecTimestamp int64
 }
 
 // newExecutor initializes an instance of executor
@@ -83,6 +89,7 @@ func initMsgEventMap() {
 	eventCreators[consensus.Type_PRE_PREPARE] = func() consensus.Message { return &consensus.PrePrepare{} }
 	eventCreators[consensus.Type_PREPARE] = func() consensus.Message { return &consensus.Prepare{} }
 	eventCreators[consensus.Type_COMMIT] = func() consensus.Message { return &consensus.Commit{} }
+	eventCreators[consensus.Type_AGGREGATED_VOTES] = func() consensus.Message { return &consensus.AggregatedVotes{} }
 	eventCreators[consensus.Type_SIGNED_CHECKPOINT] = func() consensus.Message { return &consensus.SignedCheckpoint{} }
 	eventCreators[consensus.Type_FETCH_CHECKPOINT] = func() consensus.Message { return &consensus.FetchCheckpoint{} }
 	eventCreators[consensus.Type_VIEW_CHANGE] = func() consensus.Message { return &consensus.ViewChange{} }
@@ -98,9 +105,21 @@ func initMsgEventMap() {
 	eventCreators[consensus.Type_FETCH_MISSING_RESPONSE] = func() consensus.Message { return &consensus.FetchMissingResponse{} }
 	eventCreators[consensus.Type_SYNC_STATE] = func() consensus.Message { return &consensus.SyncState{} }
 	eventCreators[consensus.Type_SYNC_STATE_RESPONSE] = func() consensus.Message { return &consensus.SyncStateResponse{} }
+	eventCreators[consensus.Type_SNAPSHOT_MANIFEST_REQUEST] = func() consensus.Message { return &consensus.SnapshotManifestRequest{} }
+	eventCreators[consensus.Type_SNAPSHOT_MANIFEST_RESPONSE] = func() consensus.Message { return &consensus.SnapshotManifestResponse{} }
+	eventCreators[consensus.Type_SNAPSHOT_CHUNK_REQUEST] = func() consensus.Message { return &consensus.SnapshotChunkRequest{} }
+	eventCreators[consensus.Type_SNAPSHOT_CHUNK_RESPONSE] = func() consensus.Message { return &consensus.SnapshotChunkResponse{} }
 	eventCreators[consensus.Type_EPOCH_CHANGE_REQUEST] = func() consensus.Message { return &consensus.EpochChangeRequest{} }
 	eventCreators[consensus.Type_EPOCH_CHANGE_PROOF] = func() consensus.Message { return &consensus.EpochChangeProof{} }
+	eventCreators[consensus.Type_LEADER_TRANSFER] = func() consensus.Message { return &consensus.LeaderTransfer{} }
 	eventCreators[consensus.Type_REBROADCAST_REQUEST_SET] = func() consensus.Message { return &consensus.ReBroadcastRequestSet{} }
+	eventCreators[consensus.Type_HOTSTUFF_PROPOSAL] = func() consensus.Message { return &consensus.HotStuffProposal{} }
+	eventCreators[consensus.Type_HOTSTUFF_VOTE] = func() consensus.Message { return &consensus.HotStuffVote{} }
+	eventCreators[consensus.Type_HOTSTUFF_NEW_VIEW] = func() consensus.Message { return &consensus.HotStuffNewView{} }
+	eventCreators[consensus.Type_HOTSTUFF_FETCH_BLOCK] = func() consensus.Message { return &consensus.HotStuffFetchBlock{} }
+	eventCreators[consensus.Type_HOTSTUFF_FETCH_BLOCK_RESPONSE] = func() consensus.Message { return &consensus.HotStuffFetchBlockResponse{} }
+	eventCreators[consensus.Type_DECRYPTION_SHARE] = func() consensus.Message { return &consensus.DecryptionShare{} }
+	eventCreators[consensus.Type_KEY_ROTATION] = func() consensus.Message { return &consensus.KeyRotation{} }
 }
 
 // dispatchLocalEvent dispatches local Event to corresponding handles using its service type
@@ -129,6 +148,12 @@ func (rbft *rbftImpl[T, Constraint]) dispatchMiscEvent(e *MiscEvent) consensusEv
 		return rbft.handleNotifyGenBatchEvent()
 	case NotifyFindNextBatchEvent:
 		return rbft.handleNotifyFindNextBatchEvent(e.Event.(*NotifyFindNextBatchMsg).hashes)
+	case ReqTransferLeadershipEvent:
+		return rbft.handleReqTransferLeadershipEvent(e.Event.(*ReqTransferLeadershipMsg))
+	case ReqLeaderScheduleEvent:
+		return rbft.handleReqLeaderScheduleEvent(e.Event.(*ReqLeaderScheduleMsg))
+	case ReqRotateKeyEvent:
+		return rbft.handleReqRotateKeyEvent(e.Event.(*ReqRotateKeyMsg))
 	default:
 		rbft.logger.Errorf("Not Supported event: %v", e)
 		return nil
@@ -184,6 +209,7 @@ func (rbft *rbftImpl[T, Constraint]) handleNotifyFindNextBatchEvent(completionMi
 		}
 		delete(rbft.storeMgr.missingBatchesInFetching, batchHash)
 	}
+	rbft.updatePipelineMetrics()
 	return nil
 }
 
@@ -205,7 +231,7 @@ func (rbft *rbftImpl[T, Constraint]) handleNotifyGenBatchEvent() consensusEvent
 		return nil
 	}
 	rbft.stopBatchTimer()
-	batch, err := rbft.batchMgr.requestPool.GenerateRequestBatch(txpool.GenBatchSizeEvent)
+	batch, err := rbft.batchMgr.generateRequestBatch(txpool.GenBatchSizeEvent)
 	if err != nil {
 		rbft.logger.Debugf("Replica %d generate batch error: %s", rbft.chainConfig.SelfID, err)
 	} else {
@@ -229,6 +255,35 @@ func (rbft *rbftImpl[T, Constraint]) handleReqGetWatermarkEvent(e *ReqGetWaterma
 	return nil
 }
 
+func (rbft *rbftImpl[T, Constraint]) handleReqLeaderScheduleEvent(e *ReqLeaderScheduleMsg) consensusEvent {
+	e.ch <- rbft.getLeaderSchedule(e.fromView, e.count)
+	return nil
+}
+
+func (rbft *rbftImpl[T, Constraint]) handleReqTransferLeadershipEvent(e *ReqTransferLeadershipMsg) consensusEvent {
+	if !rbft.isPrimary(rbft.chainConfig.SelfID) {
+		e.ch <- errors.New("only primary can transfer leadership")
+		return nil
+	}
+	if !rbft.isNormal() {
+		e.ch <- errors.New("primary is in abnormal status")
+		return nil
+	}
+	if rbft.vcMgr.leaderTransferring {
+		e.ch <- errors.New("leader transfer is already in progress")
+		return nil
+	}
+
+	rbft.logger.Noticef("Replica %d start to transfer leadership in view %d", rbft.chainConfig.SelfID, rbft.chainConfig.View)
+	// stop proposing new batches, in-flight batches will be committed as usual.
+	rbft.vcMgr.leaderTransferring = true
+	rbft.stopBatchTimer()
+	rbft.stopNoTxBatchTimer()
+	e.ch <- nil
+
+	return rbft.maybeSendLeaderTransfer()
+}
+
 // handleCoreRbftEvent handles core RBFT service events
 func (rbft *rbftImpl[T, Constraint]) handleCoreRbftEvent(e *LocalEvent) consensusEvent {
 	switch e.EventType {
@@ -282,7 +337,7 @@ func (rbft *rbftImpl[T, Constraint]) handleCoreRbftEvent(e *LocalEvent) consensu
 						"so we don't generate a batch, interval: %f", rbft.chainConfig.SelfID, interval)
 					return nil
 				}
-				batch, err := rbft.batchMgr.requestPool.GenerateRequestBatch(txpool.GenBatchTimeoutEvent)
+				batch, err := rbft.batchMgr.generateRequestBatch(txpool.GenBatchTimeoutEvent)
 				if err != nil {
 					rbft.logger.Warningf("Replica %d failed to generate batch, err: %v", rbft.chainConfig.SelfID, err)
 				} else {
@@ -344,7 +399,7 @@ func (rbft *rbftImpl[T, Constraint]) handleCoreRbftEvent(e *LocalEvent) consensu
 
 		// call requestPool module to generate a tx batch
 		if rbft.inPrimaryTerm() {
-			batch, err := rbft.batchMgr.requestPool.GenerateRequestBatch(txpool.GenBatchNoTxTimeoutEvent)
+			batch, err := rbft.batchMgr.generateRequestBatch(txpool.GenBatchNoTxTimeoutEvent)
 			if err != nil {
 				rbft.logger.Warningf("Replica %d failed to generate no-tx batch, err: %v", rbft.chainConfig.SelfID, err)
 			} else {
@@ -366,6 +421,37 @@ func (rbft *rbftImpl[T, Constraint]) handleCoreRbftEvent(e *LocalEvent) consensu
 
 		return nil
 
+	case CoreFastPathTimerEvent:
+		idx, ok := e.Event.(msgID)
+		if !ok {
+			rbft.logger.Error("fast path batch index parsing error")
+			return nil
+		}
+		if rbft.atomicIn(InViewChange) {
+			rbft.logger.Debugf("Replica %d is in viewChange, ignore the fast path timer event", rbft.chainConfig.SelfID)
+			return nil
+		}
+		cert, ok := rbft.storeMgr.certStore[idx]
+		if !ok || cert.fastPathTimerKey == "" {
+			return nil
+		}
+		cert.fastPathTimerKey = ""
+		cert.fastPathTimeout = true
+
+		rbft.logger.Debugf("Replica %d fast path timer expired for view=%d/seqNo=%d, fall back to commit phase",
+			rbft.chainConfig.SelfID, idx.v, idx.n)
+		rbft.metrics.fastPathFallbackCounter.Add(float64(1))
+		return rbft.maybeSendCommit(context.TODO(), idx.v, idx.n, idx.d)
+
+	case CoreFetchTimerEvent:
+		key, ok := e.Event.(fetchKey)
+		if !ok {
+			rbft.logger.Error("fetch key parsing error")
+			return nil
+		}
+		rbft.handleFetchTimerEvent(key)
+		return nil
+
 	case CoreNullRequestTimerEvent:
 		rbft.handleNullRequestTimerEvent()
 		return nil
@@ -385,6 +471,18 @@ func (rbft *rbftImpl[T, Constraint]) handleCoreRbftEvent(e *LocalEvent) consensu
 	case CoreStateUpdatedEvent:
 		return rbft.recvStateUpdatedEvent(e.Event.(*types.ServiceSyncState))
 
+	case CoreStateUpdateProgressEvent:
+		rbft.recvStateUpdateProgress(e.Event.(*types.StateUpdateProgress))
+		return nil
+
+	case CoreStateUpdateTimerEvent:
+		rbft.handleStateUpdateTimerEvent()
+		return nil
+
+	case CoreDecryptionShareTimerEvent:
+		rbft.handleDecryptionShareTimerEvent()
+		return nil
+
 	case CoreCheckpointBlockExecutedEvent:
 		rbft.recvCheckpointBlockExecutedEvent(e.Event.(*types.ServiceState))
 		return nil
@@ -468,6 +566,17 @@ func (rbft *rbftImpl[T, Constraint]) handleRecoveryEvent(e *LocalEvent) consensu
 			return nil
 		}
 		return rbft.restartSyncState()
+	case RecoverySnapshotSyncTimerEvent:
+		rbft.handleSnapshotSyncTimerEvent()
+		return nil
+	case RecoverySnapshotServeEvent:
+		serve, ok := e.Event.(*snapshotServeMsg)
+		if !ok {
+			rbft.logger.Errorf("Replica %d parsing error, snapshot serve msg", rbft.chainConfig.SelfID)
+			return nil
+		}
+		rbft.peerMgr.unicast(context.TODO(), serve.msg, serve.to)
+		return nil
 	default:
 		rbft.logger.Errorf("Invalid recovery service events : %v", e)
 		return nil
@@ -554,6 +663,7 @@ func (rbft *rbftImpl[T, Constraint]) handleViewChangeEvent(e *LocalEvent) consen
 			rbft.startCheckPoolTimer()
 		}
 		rbft.maybeSetNormal()
+		rbft.updateTxForwardPrimary()
 		rbft.logger.Trace(consensus.TagNameViewChange, consensus.TagStageFinish, consensus.TagContentViewChange{
 			Node: rbft.chainConfig.SelfID,
 			View: rbft.chainConfig.View,
@@ -660,7 +770,7 @@ func (rbft *rbftImpl[T, Constraint]) handleEpochMgrEvent(e *LocalEvent) consensu
 		for _, ec := range proof.GetEpochChanges() {
 			// TODO: support restore
 			rbft.logger.Debugf("Replica %d sync epoch proof with epoch %d, height %d, hash %s", rbft.chainConfig.SelfID, ec.Checkpoint.Epoch(), ec.Checkpoint.Height(), ec.Checkpoint.Digest())
-			rbft.epochMgr.epochProofCache[ec.Checkpoint.Epoch()] = ec
+			rbft.epochMgr.cacheEpochProof(ec)
 		}
 		rbft.logger.Noticef("Replica %d try epoch sync to height %d, epoch %d", rbft.chainConfig.SelfID,
 			quorumCheckpoint.Height(), quorumCheckpoint.NextEpoch())
@@ -729,6 +839,8 @@ func (rbft *rbftImpl[T, Constraint]) dispatchMsgToService(e consensusEvent) int
 		return CoreRbftService
 	case *consensus.Commit:
 		return CoreRbftService
+	case *consensus.AggregatedVotes:
+		return CoreRbftService
 	case *consensus.FetchMissingRequest:
 		return CoreRbftService
 	case *consensus.FetchMissingResponse:
@@ -737,6 +849,10 @@ func (rbft *rbftImpl[T, Constraint]) dispatchMsgToService(e consensusEvent) int
 		return CoreRbftService
 	case *consensus.ReBroadcastRequestSet:
 		return CoreRbftService
+	case *consensus.DecryptionShare:
+		return CoreRbftService
+	case *consensus.KeyRotation:
+		return CoreRbftService
 
 		// view change service
 	case *consensus.ViewChange:
@@ -753,6 +869,8 @@ func (rbft *rbftImpl[T, Constraint]) dispatchMsgToService(e consensusEvent) int
 		return ViewChangeService
 	case *consensus.QuorumViewChange:
 		return ViewChangeService
+	case *consensus.LeaderTransfer:
+		return ViewChangeService
 
 		// recovery service
 	case *consensus.FetchPQCRequest:
@@ -763,6 +881,14 @@ func (rbft *rbftImpl[T, Constraint]) dispatchMsgToService(e consensusEvent) int
 		return RecoveryService
 	case *consensus.SyncStateResponse:
 		return RecoveryService
+	case *consensus.SnapshotManifestRequest:
+		return RecoveryService
+	case *consensus.SnapshotManifestResponse:
+		return RecoveryService
+	case *consensus.SnapshotChunkRequest:
+		return RecoveryService
+	case *consensus.SnapshotChunkResponse:
+		return RecoveryService
 	case *consensus.FetchCheckpoint:
 		return EpochMgrService
 	case *consensus.EpochChangeRequest:
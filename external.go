@@ -56,6 +56,30 @@ type Crypto interface {
 	Verify(nodeID uint64, signature []byte, msg []byte) error
 }
 
+// VRF is an optional extension of Crypto which provides verifiable random function, it's required if
+// Config.EnableVRFSeed is set.
+type VRF interface {
+	// VRFProve computes the VRF output of msg with the private key of local node and its proof.
+	VRFProve(msg []byte) (output []byte, proof []byte, err error)
+
+	// VRFVerify verifies the VRF proof of msg generated by the given node, returns the VRF output
+	// if verify successfully.
+	VRFVerify(nodeID uint64, msg []byte, proof []byte) ([]byte, error)
+}
+
+// KeyRotator is an optional extension of Crypto which supports rotating the consensus key of validators
+// without removing them from the validator set, it's required if Config.EnableKeyRotation is set.
+// Signatures of a validator which never rotated its key are still verified by Crypto.Verify.
+type KeyRotator interface {
+	// VerifyWithKey verifies signature signed with msg by the given public key announced in a key
+	// rotation, return nil if verify successfully.
+	VerifyWithKey(pubKey []byte, signature []byte, msg []byte) error
+
+	// ActivateKey informs application layer to sign with the key of given version announced by
+	// Node.RotateKey from now on.
+	ActivateKey(version uint64, pubKey []byte) error
+}
+
 // ServiceOutbound is the application service invoked by RBFT library which includes two core events:
 //  1. Execute is invoked when RBFT core has achieved consensus on txs with batch number seqNo,
 //     which will be submitted to application service. After application submitted the given batch,
@@ -71,7 +95,10 @@ type ServiceOutbound[T any, Constraint kittypes.TXConstraint[T]] interface {
 	Execute(txs []*T, localList []bool, seqNo uint64, timestamp int64, proposerNodeID uint64)
 
 	// StateUpdate informs application layer to catch up to given seqNo with specified state digest.
-	// epochChanges should be provided when the sync request has a backwardness of epoch changes
+	// epochChanges should be provided when the sync request has a backwardness of epoch changes.
+	// Users can report progress with ServiceInbound.ReportStateUpdateProgress, StateUpdate may be invoked
+	// again to the same or a newer target if the progress carries an error, or if there is no progress in
+	// StateUpdateTimeout when it's set, in which case the previous one should be cancelled.
 	StateUpdate(localLowWatermark, seqNo uint64, digest string, checkpoints []*consensus.SignedCheckpoint, epochChanges ...*consensus.EpochChange)
 
 	// SendFilterEvent posts some impotent events to application layer.
@@ -79,6 +106,24 @@ type ServiceOutbound[T any, Constraint kittypes.TXConstraint[T]] interface {
 	SendFilterEvent(informType types.InformType, message ...any)
 }
 
+// BatchValidator is an optional extension of ServiceOutbound, batches proposed by primary are voted
+// without application check if it's not implemented.
+type BatchValidator[T any, Constraint kittypes.TXConstraint[T]] interface {
+	// ValidateBatch asks application layer to check a batch proposed by primary before voting on it,
+	// e.g. timestamp, config tx placement or nonce, replica will not prepare a rejected batch and
+	// will request a view change instead.
+	ValidateBatch(txs []*T, seqNo uint64, timestamp int64, proposerNodeID uint64) error
+}
+
+// StateUpdateTracker is an optional extension of ServiceOutbound, which is invoked rather than StateUpdate
+// if it's implemented.
+type StateUpdateTracker interface {
+	// TrackedStateUpdate works like StateUpdate, with the id of the request which increases with every
+	// request. Progress of the request should be reported with the same id, so that reports of superseded
+	// requests are ignored.
+	TrackedStateUpdate(requestID, localLowWatermark, seqNo uint64, digest string, checkpoints []*consensus.SignedCheckpoint, epochChanges ...*consensus.EpochChange)
+}
+
 // EpochService provides service for epoch management.
 type EpochService interface {
 	GetCurrentEpochInfo() (*kittypes.EpochInfo, error)
@@ -87,6 +132,12 @@ type EpochService interface {
 	ReadEpochState(key string) ([]byte, error)
 }
 
+// EpochStatePruner is an optional extension of EpochService which deletes epoch state, it's required if
+// Config.EpochProofRetention is set.
+type EpochStatePruner interface {
+	DelEpochState(key string) error
+}
+
 // NodeService provides service for node management.
 type NodeService interface {
 	GetNodeInfo(nodeID uint64) (*NodeInfo, error)
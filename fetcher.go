@@ -0,0 +1,286 @@
+package rbft
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-kit/types"
+)
+
+// fetchMaxBackoffShift limits the timeout of fetch retries to 2^fetchMaxBackoffShift times FetchTimeout.
+const fetchMaxBackoffShift = 4
+
+type fetchType int
+
+const (
+	fetchTypeBatch fetchType = iota // missing batch after view change
+	fetchTypeTxs                    // missing txs of a pre-prepare
+)
+
+func (t fetchType) String() string {
+	if t == fetchTypeBatch {
+		return "batch"
+	}
+	return "txs"
+}
+
+// fetchKey identifies an in-flight fetch.
+type fetchKey struct {
+	typ    fetchType
+	digest string
+}
+
+// fetchTask is an in-flight fetch of a missing batch or missing txs of a batch.
+type fetchTask struct {
+	key fetchKey
+
+	// request sent to peers, which is the same in every attempt.
+	msg *consensus.ConsensusMessage
+
+	// peer tried first, which is the primary proposed the batch for missing txs.
+	preferred uint64
+
+	// missing tx hashes by index in batch, only for missing txs.
+	missingTxHashes map[uint64]string
+
+	// peers the request has been sent to, responses are only accepted from them, and a peer is removed
+	// once it returned an invalid response.
+	asked map[uint64]struct{}
+
+	// peers tried in current round, all candidates can be tried again once all of them are tried.
+	tried map[uint64]struct{}
+
+	// peers which returned invalid responses, never tried again unless all candidates are excluded.
+	excluded map[uint64]struct{}
+
+	attempt  int
+	timerKey string
+}
+
+// fetcher keeps the in-flight fetches of missing batches and txs, spreads them across peers and
+// retries them against other peers with exponential backoff. It's only accessed in the event loop.
+type fetcher struct {
+	peers    int
+	inFlight map[fetchKey]*fetchTask
+
+	// cursor of candidates to start the next fetch, so that fetches are spread across peers.
+	cursor int
+}
+
+func newFetcher(c Config) *fetcher {
+	return &fetcher{
+		peers:    c.FetchPeers,
+		inFlight: make(map[fetchKey]*fetchTask),
+	}
+}
+
+func (f *fetcher) enabled() bool {
+	return f.peers > 0
+}
+
+func (f *fetcher) get(typ fetchType, digest string) *fetchTask {
+	return f.inFlight[fetchKey{typ: typ, digest: digest}]
+}
+
+// selectPeers selects at most n peers not tried yet by the task from the given candidates, the
+// preferred peer goes first and others are taken in turn from the cursor.
+func (f *fetcher) selectPeers(task *fetchTask, candidates []uint64, n int) []uint64 {
+	available := make([]uint64, 0, len(candidates))
+	for _, id := range candidates {
+		if _, ok := task.excluded[id]; !ok {
+			available = append(available, id)
+		}
+	}
+	if len(available) == 0 {
+		// all candidates returned invalid responses, try them again as some may have been in a
+		// different state before.
+		task.excluded = make(map[uint64]struct{})
+		available = candidates
+	}
+	if len(available) == 0 {
+		return nil
+	}
+
+	untried := func(id uint64) bool {
+		_, ok := task.tried[id]
+		return !ok
+	}
+	if !lo.ContainsBy(available, untried) {
+		task.tried = make(map[uint64]struct{})
+	}
+
+	var selected []uint64
+	if lo.Contains(available, task.preferred) && untried(task.preferred) {
+		selected = append(selected, task.preferred)
+	}
+	for i := 0; i < len(available) && len(selected) < n; i++ {
+		id := available[(f.cursor+i)%len(available)]
+		if untried(id) && !lo.Contains(selected, id) {
+			selected = append(selected, id)
+		}
+	}
+	f.cursor = (f.cursor + len(selected)) % len(available)
+	return selected
+}
+
+// fetchCandidates returns the validators to fetch from in ascending order, except self.
+func (rbft *rbftImpl[T, Constraint]) fetchCandidates() []uint64 {
+	candidates := make([]uint64, 0, len(rbft.chainConfig.ValidatorDynamicInfoMap))
+	for id := range rbft.chainConfig.ValidatorDynamicInfoMap {
+		if id != rbft.chainConfig.SelfID {
+			candidates = append(candidates, id)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i] < candidates[j]
+	})
+	return candidates
+}
+
+// startFetch starts an in-flight fetch if the same fetch is not in flight.
+func (rbft *rbftImpl[T, Constraint]) startFetch(task *fetchTask) {
+	if _, ok := rbft.fetcher.inFlight[task.key]; ok {
+		return
+	}
+	task.asked = make(map[uint64]struct{})
+	task.tried = make(map[uint64]struct{})
+	task.excluded = make(map[uint64]struct{})
+	rbft.fetcher.inFlight[task.key] = task
+	rbft.metrics.fetchInFlightGauge.Set(float64(len(rbft.fetcher.inFlight)))
+
+	rbft.sendFetch(task, rbft.fetcher.peers)
+	rbft.startFetchTimer(task, false)
+}
+
+// sendFetch sends the request of the task to at most n peers not tried yet.
+func (rbft *rbftImpl[T, Constraint]) sendFetch(task *fetchTask, n int) {
+	peers := rbft.fetcher.selectPeers(task, rbft.fetchCandidates(), n)
+	rbft.logger.Debugf("Replica %d fetch %s %s from replicas %v, attempt %d", rbft.chainConfig.SelfID,
+		task.key.typ, task.key.digest, peers, task.attempt)
+	for _, id := range peers {
+		task.asked[id] = struct{}{}
+		task.tried[id] = struct{}{}
+		if task.key.typ == fetchTypeTxs && rbft.isPipelining() {
+			rbft.peerMgr.unicastAsync(context.TODO(), task.msg.CloneVT(), id)
+			continue
+		}
+		rbft.peerMgr.unicast(context.TODO(), task.msg.CloneVT(), id)
+	}
+}
+
+// startFetchTimer starts the retry timer of the task, whose timeout doubles with each attempt. The
+// timeout of a retry after invalid responses is 2^fetchMaxBackoffShift times shorter, which also
+// doubles with each attempt up to FetchTimeout.
+func (rbft *rbftImpl[T, Constraint]) startFetchTimer(task *fetchTask, invalid bool) {
+	shift := task.attempt
+	if shift > fetchMaxBackoffShift {
+		shift = fetchMaxBackoffShift
+	}
+	timeout := rbft.timerMgr.getTimeoutValue(fetchTimer) * time.Duration(1<<shift)
+	if invalid {
+		timeout >>= fetchMaxBackoffShift
+	}
+	event := &LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreFetchTimerEvent,
+		Event:     task.key,
+	}
+	task.timerKey = rbft.timerMgr.createTimer(fetchTimer, timeout, event)
+}
+
+// finishFetch removes the task from in-flight fetches.
+func (rbft *rbftImpl[T, Constraint]) finishFetch(task *fetchTask) {
+	rbft.timerMgr.stopOneTimer(fetchTimer, task.timerKey)
+	delete(rbft.fetcher.inFlight, task.key)
+	rbft.metrics.fetchInFlightGauge.Set(float64(len(rbft.fetcher.inFlight)))
+}
+
+// isFetchNeeded checks if what the task fetches is still missing.
+func (rbft *rbftImpl[T, Constraint]) isFetchNeeded(task *fetchTask) bool {
+	if task.key.typ == fetchTypeBatch {
+		_, ok := rbft.storeMgr.missingReqBatches[task.key.digest]
+		return ok
+	}
+	_, ok := rbft.storeMgr.missingBatchesInFetching[task.key.digest]
+	return ok
+}
+
+// handleFetchTimerEvent retries the fetch against other peers if it's still needed.
+func (rbft *rbftImpl[T, Constraint]) handleFetchTimerEvent(key fetchKey) {
+	task, ok := rbft.fetcher.inFlight[key]
+	if !ok {
+		return
+	}
+	if !rbft.isFetchNeeded(task) {
+		rbft.logger.Debugf("Replica %d no longer needs to fetch %s %s", rbft.chainConfig.SelfID, key.typ, key.digest)
+		rbft.finishFetch(task)
+		return
+	}
+
+	task.attempt++
+	rbft.logger.Infof("Replica %d fetch %s %s timeout, retry against other peers, attempt %d",
+		rbft.chainConfig.SelfID, key.typ, key.digest, task.attempt)
+	rbft.metrics.fetchRetryCounter.With("type", key.typ.String()).Add(float64(1))
+	rbft.sendFetch(task, rbft.fetcher.peers)
+	rbft.startFetchTimer(task, false)
+}
+
+// rejectFetchResponse excludes the peer which returned an invalid response from the task. Only one
+// response is rejected from each peer asked, and the request is not sent to other peers right away so
+// that invalid responses can't flood peers with requests. Instead, once all peers asked returned invalid
+// responses, the task is retried after a backoff rather than waiting for the whole timeout.
+func (rbft *rbftImpl[T, Constraint]) rejectFetchResponse(task *fetchTask, from uint64, err error) {
+	if _, ok := task.asked[from]; !ok {
+		return
+	}
+	rbft.logger.Warningf("Replica %d received invalid fetch %s response %s from replica %d: %v",
+		rbft.chainConfig.SelfID, task.key.typ, task.key.digest, from, err)
+	rbft.metrics.invalidFetchResponseCounter.With("type", task.key.typ.String()).Add(float64(1))
+	delete(task.asked, from)
+	task.excluded[from] = struct{}{}
+	if len(task.asked) == 0 {
+		rbft.timerMgr.stopOneTimer(fetchTimer, task.timerKey)
+		rbft.startFetchTimer(task, true)
+	}
+}
+
+// verifyFetchedBatch checks if the fetched batch matches the known digest.
+func verifyFetchedBatch[T any, Constraint types.TXConstraint[T]](digest string, batch *RequestBatch[T, Constraint]) error {
+	if d := calculateBatchDigest(batch.hashBatch()); d != digest {
+		return errors.Errorf("mismatch batch digest, expected %s, got %s", digest, d)
+	}
+	if err := checkKeyRotationsSorted(batch.KeyRotations); err != nil {
+		return err
+	}
+	if len(batch.RequestList) != len(batch.RequestHashList) {
+		return errors.Errorf("mismatch length of txs %d and tx hashes %d", len(batch.RequestList), len(batch.RequestHashList))
+	}
+	for i, tx := range batch.RequestList {
+		if hash := Constraint(tx).RbftGetTxHash(); hash != batch.RequestHashList[i] {
+			return errors.Errorf("mismatch tx hash at %d, expected %s, got %s", i, batch.RequestHashList[i], hash)
+		}
+	}
+	return nil
+}
+
+// verifyFetchedTxs checks if the fetched txs match the missing tx hashes.
+func verifyFetchedTxs[T any, Constraint types.TXConstraint[T]](missingTxHashes map[uint64]string, txs map[uint64]*T) error {
+	if len(txs) != len(missingTxHashes) {
+		return errors.Errorf("mismatch number of txs, expected %d, got %d", len(missingTxHashes), len(txs))
+	}
+	for i, expected := range missingTxHashes {
+		tx, ok := txs[i]
+		if !ok {
+			return errors.Errorf("missing tx at %d", i)
+		}
+		if hash := Constraint(tx).RbftGetTxHash(); hash != expected {
+			return errors.Errorf("mismatch tx hash at %d, expected %s, got %s", i, expected, hash)
+		}
+	}
+	return nil
+}
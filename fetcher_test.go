@@ -0,0 +1,150 @@
+package rbft
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+func TestFetcher_selectPeers(t *testing.T) {
+	f := newFetcher(Config{FetchPeers: 2})
+	candidates := []uint64{1, 2, 3}
+	newTask := func(preferred uint64) *fetchTask {
+		return &fetchTask{
+			preferred: preferred,
+			tried:     make(map[uint64]struct{}),
+			excluded:  make(map[uint64]struct{}),
+		}
+	}
+
+	// preferred peer goes first.
+	task := newTask(3)
+	assert.Equal(t, []uint64{3, 1}, f.selectPeers(task, candidates, 2))
+	for _, id := range []uint64{3, 1} {
+		task.tried[id] = struct{}{}
+	}
+	// untried peers are taken first, then all peers are available again.
+	assert.Equal(t, []uint64{2}, f.selectPeers(task, candidates, 2))
+	task.tried[2] = struct{}{}
+	assert.Len(t, f.selectPeers(task, candidates, 2), 2)
+
+	// fetches are spread across peers.
+	f.cursor = 0
+	assert.Equal(t, []uint64{1, 2}, f.selectPeers(newTask(0), candidates, 2))
+	assert.Equal(t, []uint64{3, 1}, f.selectPeers(newTask(0), candidates, 2))
+
+	// peers returned invalid responses are excluded.
+	task = newTask(0)
+	task.excluded[1] = struct{}{}
+	task.excluded[2] = struct{}{}
+	assert.Equal(t, []uint64{3}, f.selectPeers(task, candidates, 2))
+	task.excluded[3] = struct{}{}
+	assert.Len(t, f.selectPeers(task, candidates, 2), 2)
+	assert.Empty(t, task.excluded)
+}
+
+func TestRBFT_fetchRequestBatchesFromMultiplePeers(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	rbft := rbfts[0]
+	rbft.config.FetchPeers = 2
+	rbft.fetcher = newFetcher(rbft.config)
+	ext := rbft.external.(*testExternal[consensus.FltTransaction, *consensus.FltTransaction])
+
+	tx := newTx()
+	batch := &RequestBatch[consensus.FltTransaction, *consensus.FltTransaction]{
+		RequestHashList: []string{tx.RbftGetTxHash()},
+		RequestList:     []*consensus.FltTransaction{tx},
+		Timestamp:       1,
+		SeqNo:           1,
+		LocalList:       []bool{false},
+	}
+	digest := calculateMD5Hash(batch.RequestHashList, batch.Timestamp)
+	batch.BatchHash = digest
+	rbft.storeMgr.missingReqBatches[digest] = batch.SeqNo
+
+	listenRequests := func(n int) {
+		for n > 0 {
+			// skip messages of other nodes in the shared cluster channel.
+			rvc := <-ext.ListenMsg()
+			if rvc.msg.Type == consensus.Type_FETCH_BATCH_REQUEST {
+				assert.Equal(t, rbft.chainConfig.SelfID, rvc.msg.From)
+				n--
+			}
+		}
+	}
+
+	// request is sent to 2 peers rather than broadcast.
+	rbft.fetchRequestBatches()
+	listenRequests(2)
+	task := rbft.fetcher.get(fetchTypeBatch, digest)
+	assert.NotNil(t, task)
+	asked := lo.Keys(task.asked)
+	assert.Len(t, asked, 2)
+
+	// retry against the remaining peer after timeout.
+	rbft.handleFetchTimerEvent(task.key)
+	assert.Equal(t, 1, task.attempt)
+	listenRequests(1)
+	remaining, _ := lo.Difference(lo.Keys(task.asked), asked)
+	assert.Len(t, remaining, 1)
+
+	// invalid batch is rejected, and the peer is not asked again.
+	invalid := &RequestBatch[consensus.FltTransaction, *consensus.FltTransaction]{
+		RequestHashList: []string{"tx-hash"},
+		RequestList:     []*consensus.FltTransaction{tx},
+		Timestamp:       1,
+		SeqNo:           1,
+		LocalList:       []bool{false},
+	}
+	pbInvalid, err := invalid.ToPB()
+	assert.Nil(t, err)
+	timerKey := task.timerKey
+	rbft.recvFetchBatchResponse(&consensus.FetchBatchResponse{Batch: pbInvalid, BatchDigest: digest, ReplicaId: remaining[0]})
+	assert.Contains(t, task.excluded, remaining[0])
+	assert.NotContains(t, task.asked, remaining[0])
+	assert.NotContains(t, rbft.storeMgr.batchStore, digest)
+	// other peers are not asked right away.
+	assert.Len(t, task.tried, 3)
+	assert.Equal(t, timerKey, task.timerKey)
+
+	// responses from peers not asked are ignored.
+	rbft.recvFetchBatchResponse(&consensus.FetchBatchResponse{Batch: pbInvalid, BatchDigest: digest, ReplicaId: remaining[0]})
+	rbft.recvFetchBatchResponse(&consensus.FetchBatchResponse{Batch: pbInvalid, BatchDigest: digest, ReplicaId: 100})
+	assert.Len(t, task.excluded, 1)
+
+	// retry after backoff once all peers asked returned invalid responses.
+	for _, id := range asked {
+		rbft.recvFetchBatchResponse(&consensus.FetchBatchResponse{Batch: pbInvalid, BatchDigest: digest, ReplicaId: id})
+	}
+	assert.Empty(t, task.asked)
+	assert.NotEqual(t, timerKey, task.timerKey)
+	rbft.handleFetchTimerEvent(task.key)
+	listenRequests(2)
+	assert.Len(t, task.asked, 2)
+
+	// valid batch finishes the fetch.
+	pbBatch, err := batch.ToPB()
+	assert.Nil(t, err)
+	rbft.recvFetchBatchResponse(&consensus.FetchBatchResponse{Batch: pbBatch, BatchDigest: digest, ReplicaId: lo.Keys(task.asked)[0]})
+	assert.Contains(t, rbft.storeMgr.batchStore, digest)
+	assert.NotContains(t, rbft.storeMgr.missingReqBatches, digest)
+	assert.Nil(t, rbft.fetcher.get(fetchTypeBatch, digest))
+}
+
+func TestFetcher_verifyFetchedTxs(t *testing.T) {
+	tx1, tx2 := newTx(), newTx()
+	missing := map[uint64]string{0: tx1.RbftGetTxHash(), 2: tx2.RbftGetTxHash()}
+
+	assert.Nil(t, verifyFetchedTxs[consensus.FltTransaction, *consensus.FltTransaction](missing,
+		map[uint64]*consensus.FltTransaction{0: tx1, 2: tx2}))
+	assert.NotNil(t, verifyFetchedTxs[consensus.FltTransaction, *consensus.FltTransaction](missing,
+		map[uint64]*consensus.FltTransaction{0: tx1}))
+	assert.NotNil(t, verifyFetchedTxs[consensus.FltTransaction, *consensus.FltTransaction](missing,
+		map[uint64]*consensus.FltTransaction{0: tx2, 2: tx1}))
+	assert.NotNil(t, verifyFetchedTxs[consensus.FltTransaction, *consensus.FltTransaction](missing,
+		map[uint64]*consensus.FltTransaction{0: tx1, 1: tx2}))
+}
@@ -17,10 +17,14 @@ package rbft
 import (
 	"context"
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"hash"
 	"math"
+	"sort"
+	"time"
 
 	"github.com/samber/lo"
 	"golang.org/x/crypto/sha3"
@@ -83,6 +87,10 @@ func (rbft *rbftImpl[T, Constraint]) beyondRange(n uint64) bool {
 
 // inPrimaryTerm check is in primary term, only true can send prePrepare
 func (rbft *rbftImpl[T, Constraint]) inPrimaryTerm() bool {
+	// primary is handing over its leadership, don't propose any more.
+	if rbft.vcMgr.leaderTransferring {
+		return false
+	}
 	if !rbft.chainConfig.isProposerElectionTypeWRF() {
 		return rbft.batchMgr.seqNo < rbft.chainConfig.EpochInfo.StartBlock+rbft.chainConfig.EpochInfo.EpochPeriod-1
 	}
@@ -159,6 +167,9 @@ func (rbft *rbftImpl[T, Constraint]) committed(v uint64, n uint64, d string) boo
 	}
 
 	cert := rbft.storeMgr.certStore[msgID{v: v, n: n, d: d}]
+	if cert.fastCommitted {
+		return true
+	}
 
 	cmtCount := len(cert.commit)
 
@@ -183,9 +194,59 @@ func (rbft *rbftImpl[T, Constraint]) broadcastReqSet(set *RequestSet[T, Constrai
 		Type:    consensus.Type_REBROADCAST_REQUEST_SET,
 		Payload: payload,
 	}
+	if targets, ok := rbft.txForwardTargets(); ok {
+		rbft.logger.Debugf("Replica %d forward request set to primaries %v", rbft.chainConfig.SelfID, targets)
+		for _, to := range targets {
+			rbft.peerMgr.unicast(context.TODO(), consensusMsg.CloneVT(), to)
+		}
+		return
+	}
 	rbft.peerMgr.broadcast(context.TODO(), consensusMsg)
 }
 
+// txForwardTargets returns the current and next primary which request sets should be forwarded to
+// except self, and false if request sets should be broadcast to all nodes, that's, forwarding is
+// disabled, node is abnormal, or the pending request sets have not been re-forwarded to current
+// primary yet.
+func (rbft *rbftImpl[T, Constraint]) txForwardTargets() ([]uint64, bool) {
+	if !rbft.config.ForwardTxsToPrimary {
+		return nil, false
+	}
+	if !rbft.isNormal() || rbft.atomicIn(InConfChange) {
+		return nil, false
+	}
+
+	primaryID := rbft.chainConfig.PrimaryID
+	if primaryID != rbft.txForwardPrimaryID {
+		return nil, false
+	}
+
+	nextPrimaryID := rbft.chainConfig.calPrimaryIDByView(rbft.chainConfig.View+1, rbft.chainConfig.ValidatorDynamicInfoMap)
+	var targets []uint64
+	for _, id := range []uint64{primaryID, nextPrimaryID} {
+		if id != rbft.chainConfig.SelfID && !lo.Contains(targets, id) {
+			targets = append(targets, id)
+		}
+	}
+	return targets, true
+}
+
+// updateTxForwardPrimary re-forwards the pending request sets once the primary changed, as the new
+// primary may miss the request sets forwarded to the old one. It's called when a new view is applied,
+// request sets are broadcast until the node is normal and the pending request sets are re-forwarded.
+func (rbft *rbftImpl[T, Constraint]) updateTxForwardPrimary() {
+	if !rbft.config.ForwardTxsToPrimary || rbft.txForwardPrimaryID == rbft.chainConfig.PrimaryID {
+		return
+	}
+	if !rbft.isNormal() || rbft.atomicIn(InConfChange) {
+		return
+	}
+	rbft.logger.Debugf("Replica %d found primary changed from %d to %d, re-forward pending request sets",
+		rbft.chainConfig.SelfID, rbft.txForwardPrimaryID, rbft.chainConfig.PrimaryID)
+	rbft.txForwardPrimaryID = rbft.chainConfig.PrimaryID
+	rbft.processOutOfDateReqs(false)
+}
+
 // =============================================================================
 // helper functions for timer
 // =============================================================================
@@ -708,15 +769,77 @@ func (rbft *rbftImpl[T, Constraint]) stopNamespace() {
 
 // calculateMD5Hash calculate hash by MD5
 func calculateMD5Hash(list []string, timestamp int64) string {
+	return hex.EncodeToString(md5HashBatch(list, timestamp).Sum(nil))
+}
+
+func md5HashBatch(list []string, timestamp int64) hash.Hash {
 	h := md5.New()
-	for _, hash := range list {
-		_, _ = h.Write([]byte(hash))
+	for _, txHash := range list {
+		_, _ = h.Write([]byte(txHash))
 	}
 	if timestamp > 0 {
 		b := make([]byte, 8)
 		binary.LittleEndian.PutUint64(b, uint64(timestamp))
 		_, _ = h.Write(b)
 	}
+	return h
+}
+
+// hasDigestExtension returns whether the batch has fields set by primary which are covered by the batch
+// digest besides txs and timestamp.
+func hasDigestExtension(batch *consensus.HashBatch) bool {
+	return batch.BftTime != 0 || len(batch.VrfProof) != 0 || len(batch.KeyRotations) != 0
+}
+
+// hashKeyRotations returns the hash of the key rotations ordered by a batch, which are sorted by replica.
+func hashKeyRotations(rotations []*consensus.KeyRotation) []byte {
+	h := sha256.New()
+	for _, kr := range rotations {
+		pubKeyHash := sha256.Sum256(kr.PubKey)
+		sigHash := sha256.Sum256(kr.Signature)
+		_, _ = h.Write(binary.LittleEndian.AppendUint64(nil, kr.ReplicaId))
+		_, _ = h.Write(binary.LittleEndian.AppendUint64(nil, kr.KeyVersion))
+		_, _ = h.Write(pubKeyHash[:])
+		_, _ = h.Write(sigHash[:])
+	}
+	return h.Sum(nil)
+}
+
+// checkKeyRotationsSorted checks if the key rotations ordered by a batch are sorted by replica without
+// duplicates, as proposed by primary, so that replicas order them in the same way.
+func checkKeyRotationsSorted(rotations []*consensus.KeyRotation) error {
+	for i := 1; i < len(rotations); i++ {
+		if rotations[i].ReplicaId <= rotations[i-1].ReplicaId {
+			return fmt.Errorf("key rotations are not sorted by replica at %d", i)
+		}
+	}
+	return nil
+}
+
+// calculateBatchDigest calculates the digest of a batch. Besides txs and timestamp, it covers the
+// fields set by primary which replicas execute the batch with, so that replicas agreeing on the
+// digest in prepare and commit also agree on them. It's the same as calculateMD5Hash if none of
+// these fields is set, i.e. the hash generated by request pool.
+func calculateBatchDigest(batch *consensus.HashBatch) string {
+	if !hasDigestExtension(batch) {
+		return calculateMD5Hash(batch.RequestHashList, batch.Timestamp)
+	}
+	h := md5HashBatch(batch.RequestHashList, batch.Timestamp)
+	if batch.BftTime != 0 {
+		_, _ = h.Write([]byte("bft-time"))
+		_, _ = h.Write(binary.LittleEndian.AppendUint64(nil, uint64(batch.BftTime)))
+	}
+	if len(batch.VrfProof) != 0 {
+		// the VRF output is verified against the proposer, so both of them are covered.
+		proofHash := sha256.Sum256(batch.VrfProof)
+		_, _ = h.Write([]byte("vrf-proof"))
+		_, _ = h.Write(binary.LittleEndian.AppendUint64(nil, batch.Proposer))
+		_, _ = h.Write(proofHash[:])
+	}
+	if len(batch.KeyRotations) != 0 {
+		_, _ = h.Write([]byte("key-rotations"))
+		_, _ = h.Write(hashKeyRotations(batch.KeyRotations))
+	}
 	return hex.EncodeToString(h.Sum(nil))
 }
 
@@ -749,7 +872,9 @@ func (rbft *rbftImpl[T, Constraint]) generateSignedCheckpoint(state *types.Servi
 			Height:      state.MetaState.Height,
 			Digest:      state.MetaState.Digest,
 			BatchDigest: batchDigest,
+			VrfOutput:   rbft.vrfOutput(state.MetaState.Height, batchDigest),
 		},
+		ValidatorKeys: rbft.checkpointValidatorKeys(state.MetaState.Height),
 	}
 	if rbft.chainConfig.isProposerElectionTypeWRF() {
 		// proof viewchanged by term update
@@ -803,6 +928,9 @@ func (rbft *rbftImpl[T, Constraint]) generateSignedCheckpoint(state *types.Servi
 
 	if isConfig {
 		checkpoint.NeedUpdateEpoch = true
+		if rbft.chainConfig.isProposerElectionTypeReputation() {
+			checkpoint.ReputationPenalties = rbft.chainConfig.checkpointReputationPenalties()
+		}
 		rbft.logger.Noticef("Replica %d generate a config checkpoint, new epoch: %d",
 			rbft.chainConfig.SelfID, checkpoint.Epoch+1)
 	}
@@ -835,7 +963,7 @@ func (rbft *rbftImpl[T, Constraint]) signCheckpoint(checkpoint *consensus.Checkp
 // verifySignedCheckpoint returns whether given signedCheckpoint contains a valid signature.
 func (rbft *rbftImpl[T, Constraint]) verifySignedCheckpoint(signedCheckpoint *consensus.SignedCheckpoint) error {
 	msg := signedCheckpoint.Checkpoint.Hash()
-	return rbft.external.Verify(signedCheckpoint.GetAuthor(), signedCheckpoint.Signature, msg)
+	return rbft.verifySignature(signedCheckpoint.GetAuthor(), signedCheckpoint.Signature, msg)
 }
 
 // syncConfigCheckpoint posts config checkpoint out and wait for its completion synchronously.
@@ -844,9 +972,12 @@ func (rbft *rbftImpl[T, Constraint]) syncConfigCheckpoint(checkpointHeight uint6
 	rbft.epochMgr.configBatchToCheck = nil
 	validatorSet := lo.MapValues(rbft.chainConfig.ValidatorSet, func(_ int64, id uint64) *consensus.ValidatorInfo {
 		if nodeInfo, err := rbft.chainConfig.getNodeInfo(id); err == nil {
+			key := rbft.keys.get(id)
 			return &consensus.ValidatorInfo{
-				Id:    id,
-				P2PId: nodeInfo.P2PNodeID,
+				Id:         id,
+				P2PId:      nodeInfo.P2PNodeID,
+				KeyVersion: key.KeyVersion,
+				PubKey:     key.PubKey,
 			}
 		}
 		rbft.logger.Errorf("Replica %d not found node info in nodeInfoMap with id %d", rbft.chainConfig.SelfID, id)
@@ -909,7 +1040,7 @@ func (rbft *rbftImpl[T, Constraint]) verifySignedViewChange(vc *consensus.ViewCh
 	if hErr != nil {
 		return hErr
 	}
-	return rbft.external.Verify(replicaID, vc.Signature, hash)
+	return rbft.verifySignature(replicaID, vc.Signature, hash)
 }
 
 func (rbft *rbftImpl[T, Constraint]) calculateViewChangeHash(vc *consensus.ViewChange) ([]byte, error) {
@@ -926,6 +1057,49 @@ func (rbft *rbftImpl[T, Constraint]) calculateViewChangeHash(vc *consensus.ViewC
 	return hash, nil
 }
 
+// signLeaderTransfer generates a signature of certain LeaderTransfer message.
+func (rbft *rbftImpl[T, Constraint]) signLeaderTransfer(lt *consensus.LeaderTransfer) ([]byte, error) {
+	hash, hErr := rbft.calculateLeaderTransferHash(lt)
+	if hErr != nil {
+		return nil, hErr
+	}
+	sig, sErr := rbft.external.Sign(hash)
+	if sErr != nil {
+		rbft.logger.Warningf("Replica %d sign leader transfer failed: %s", rbft.chainConfig.SelfID, sErr)
+		rbft.stopNamespace()
+		return nil, sErr
+	}
+	return sig, nil
+}
+
+// verifyLeaderTransfer returns whether given LeaderTransfer contains a valid signature.
+func (rbft *rbftImpl[T, Constraint]) verifyLeaderTransfer(lt *consensus.LeaderTransfer) error {
+	hash, hErr := rbft.calculateLeaderTransferHash(lt)
+	if hErr != nil {
+		return hErr
+	}
+	return rbft.verifySignature(lt.ReplicaId, lt.Signature, hash)
+}
+
+func (rbft *rbftImpl[T, Constraint]) calculateLeaderTransferHash(lt *consensus.LeaderTransfer) ([]byte, error) {
+	hasher := sha3.NewLegacyKeccak256()
+	unsigned := &consensus.LeaderTransfer{
+		ReplicaId:      lt.ReplicaId,
+		View:           lt.View,
+		SequenceNumber: lt.SequenceNumber,
+	}
+	raw, err := unsigned.MarshalVTStrict()
+	if err != nil {
+		return nil, err
+	}
+	_, err = hasher.Write(raw)
+	if err != nil {
+		return nil, err
+	}
+	hash := hasher.Sum(nil)
+	return hash, nil
+}
+
 // signNewView generates a signature of certain NewView message.
 func (rbft *rbftImpl[T, Constraint]) signNewView(nv *consensus.NewView) ([]byte, error) {
 	hash, hErr := rbft.calculateNewViewHash(nv)
@@ -952,7 +1126,7 @@ func (rbft *rbftImpl[T, Constraint]) verifySignedNewView(nv *consensus.NewView)
 	if nv.AutoTermUpdate {
 		from = nv.FromId
 	}
-	return hash, rbft.external.Verify(from, nv.Signature, hash)
+	return hash, rbft.verifySignature(from, nv.Signature, hash)
 }
 
 func (rbft *rbftImpl[T, Constraint]) calculateNewViewHash(nv *consensus.NewView) ([]byte, error) {
@@ -1019,3 +1193,225 @@ func GetEpochQuorumCheckpoint(getEpochStateFn func(key []byte) []byte, epoch uin
 	}
 	return c, nil
 }
+
+// signPrepare generates a signature of certain Prepare message, only used in linear vote aggregation mode.
+func (rbft *rbftImpl[T, Constraint]) signPrepare(prep *consensus.Prepare) ([]byte, error) {
+	return rbft.signVote(consensus.Type_PREPARE, prep.ReplicaId, prep.View, prep.SequenceNumber, prep.BatchDigest, 0)
+}
+
+// verifyPrepare returns whether given Prepare contains a valid signature.
+func (rbft *rbftImpl[T, Constraint]) verifyPrepare(prep *consensus.Prepare) error {
+	hash, hErr := rbft.calculateVoteHash(consensus.Type_PREPARE, prep.ReplicaId, prep.View, prep.SequenceNumber, prep.BatchDigest, 0)
+	if hErr != nil {
+		return hErr
+	}
+	return rbft.verifySignature(prep.ReplicaId, prep.Signature, hash)
+}
+
+// signCommit generates a signature of certain Commit message, only used in linear vote aggregation mode.
+func (rbft *rbftImpl[T, Constraint]) signCommit(commit *consensus.Commit) ([]byte, error) {
+	return rbft.signVote(consensus.Type_COMMIT, commit.ReplicaId, commit.View, commit.SequenceNumber, commit.BatchDigest, commit.Timestamp)
+}
+
+// verifyCommit returns whether given Commit contains a valid signature.
+func (rbft *rbftImpl[T, Constraint]) verifyCommit(commit *consensus.Commit) error {
+	hash, hErr := rbft.calculateVoteHash(consensus.Type_COMMIT, commit.ReplicaId, commit.View, commit.SequenceNumber, commit.BatchDigest, commit.Timestamp)
+	if hErr != nil {
+		return hErr
+	}
+	return rbft.verifySignature(commit.ReplicaId, commit.Signature, hash)
+}
+
+func (rbft *rbftImpl[T, Constraint]) signVote(typ consensus.Type, replicaID, v, n uint64, d string, timestamp int64) ([]byte, error) {
+	hash, hErr := rbft.calculateVoteHash(typ, replicaID, v, n, d, timestamp)
+	if hErr != nil {
+		return nil, hErr
+	}
+	sig, sErr := rbft.external.Sign(hash)
+	if sErr != nil {
+		rbft.logger.Warningf("Replica %d sign %s failed: %s", rbft.chainConfig.SelfID, typ, sErr)
+		rbft.stopNamespace()
+		return nil, sErr
+	}
+	return sig, nil
+}
+
+// calculateVoteHash calculates the hash of a prepare or commit vote, vote type is written
+// first as prepare and commit share the same layout, timestamp is only set for commits in BFT time.
+func (rbft *rbftImpl[T, Constraint]) calculateVoteHash(typ consensus.Type, replicaID, v, n uint64, d string, timestamp int64) ([]byte, error) {
+	hasher := sha3.NewLegacyKeccak256()
+	unsigned := &consensus.Commit{
+		ReplicaId:      replicaID,
+		View:           v,
+		SequenceNumber: n,
+		BatchDigest:    d,
+		Timestamp:      timestamp,
+	}
+	raw, err := unsigned.MarshalVTStrict()
+	if err != nil {
+		return nil, err
+	}
+	_, err = hasher.Write(binary.BigEndian.AppendUint32(nil, uint32(typ)))
+	if err != nil {
+		return nil, err
+	}
+	_, err = hasher.Write(raw)
+	if err != nil {
+		return nil, err
+	}
+	hash := hasher.Sum(nil)
+	return hash, nil
+}
+
+// isBFTTimeEnabled returns whether batches are executed with BFT time, which is computed from a commit
+// quorum certificate collected by primary in linear vote aggregation mode.
+func (rbft *rbftImpl[T, Constraint]) isBFTTimeEnabled() bool {
+	return rbft.config.EnableBFTTime && rbft.chainConfig.isLinearVoteAggregation() && !rbft.chainConfig.isFastPathEnabled()
+}
+
+// medianCommitTimestamp returns the median of timestamps in the given commits, which is bounded by
+// timestamps of correct replicas as long as there are at most f faulty commits.
+func medianCommitTimestamp(commits []*consensus.Commit) int64 {
+	if len(commits) == 0 {
+		return 0
+	}
+	timestamps := lo.Map(commits, func(commit *consensus.Commit, _ int) int64 {
+		return commit.Timestamp
+	})
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i] < timestamps[j]
+	})
+	return timestamps[len(timestamps)/2]
+}
+
+// batchExecTimestamp returns the timestamp to execute the committed batch with. If BFT time is enabled,
+// it's the BFT time agreed in the pre-prepare, or the timestamp of primary if the pre-prepare carries
+// no BFT time, e.g. no batch has been committed in current epoch, and it's kept strictly greater than
+// the timestamp of previous batch.
+func (rbft *rbftImpl[T, Constraint]) batchExecTimestamp(cert *msgCert) int64 {
+	timestamp := cert.prePrepare.HashBatch.Timestamp
+	if !rbft.isBFTTimeEnabled() {
+		return timestamp
+	}
+	if bftTime := cert.prePrepare.HashBatch.BftTime; bftTime != 0 {
+		timestamp = bftTime
+	}
+	if timestamp <= rbft.exec.lastExecTimestamp {
+		timestamp = rbft.exec.lastExecTimestamp + 1
+	}
+	return timestamp
+}
+
+// maxBlockTimestampLookback is the max number of blocks looked back for the timestamp of previous
+// batch. Blocks with txs older than that are far behind the clocks of replicas, so that BFT time of
+// next batch is always greater than their timestamps.
+const maxBlockTimestampLookback = 1024
+
+// loadBlockTimestamp returns the timestamp of the latest block with txs at or below the given height from
+// ledger, which is used as the timestamp of previous batch after restart or state update if BFT time is
+// enabled. At most maxBlockTimestampLookback blocks are looked back.
+func (rbft *rbftImpl[T, Constraint]) loadBlockTimestamp(height uint64) int64 {
+	if !rbft.isBFTTimeEnabled() {
+		return 0
+	}
+	for h := height; h > 0 && height-h < maxBlockTimestampLookback; h-- {
+		meta, err := rbft.external.GetBlockMeta(h)
+		if err != nil {
+			rbft.logger.Warningf("Replica %d failed to get block meta of height %d: %s", rbft.chainConfig.SelfID, h, err)
+			return 0
+		}
+		// empty blocks are executed without timestamp.
+		if meta.Timestamp != 0 {
+			return meta.Timestamp
+		}
+	}
+	return 0
+}
+
+// recordBFTTimeCommits records the given commit quorum certificate to compute the BFT time of the next
+// batch proposed by this replica as primary, if it's for a later batch than the recorded one.
+func (rbft *rbftImpl[T, Constraint]) recordBFTTimeCommits(commits []*consensus.Commit) {
+	if len(commits) == 0 {
+		return
+	}
+	recorded := rbft.batchMgr.bftTimeCommits
+	if len(recorded) != 0 && recorded[0].SequenceNumber >= commits[0].SequenceNumber {
+		return
+	}
+	rbft.batchMgr.bftTimeCommits = commits
+}
+
+// proposeBFTTime sets the BFT time of the batch to propose with the given seqNo, and returns the commits
+// it's computed from, which are carried by the pre-prepare. The batch is proposed without BFT time if no
+// later batch is committed with commit quorum certificate in current epoch.
+func (rbft *rbftImpl[T, Constraint]) proposeBFTTime(seqNo uint64, batch *RequestBatch[T, Constraint]) []*consensus.Commit {
+	batch.BFTTime = 0
+	commits := rbft.batchMgr.bftTimeCommits
+	if len(commits) == 0 || commits[0].SequenceNumber >= seqNo {
+		return nil
+	}
+	batch.BFTTime = medianCommitTimestamp(commits)
+	return commits
+}
+
+// checkBatchBFTTime checks whether the BFT time of a pre-prepare is the median timestamp of the carried
+// commit quorum certificate of a previous batch.
+func (rbft *rbftImpl[T, Constraint]) checkBatchBFTTime(preprep *consensus.PrePrepare) error {
+	bftTime := preprep.HashBatch.BftTime
+	commits := preprep.BftTimeCommits
+	if bftTime == 0 {
+		if len(commits) != 0 {
+			return fmt.Errorf("unexpected commits without BFT time")
+		}
+		return nil
+	}
+	if !rbft.isBFTTimeEnabled() {
+		return fmt.Errorf("unexpected BFT time as it's not enabled")
+	}
+	// batches which have been accepted before may be re-proposed in recovery or view change.
+	if _, ok := rbft.storeMgr.batchStore[preprep.BatchDigest]; ok || preprep.SequenceNumber <= rbft.exec.lastExec {
+		return nil
+	}
+	if len(commits) == 0 {
+		return fmt.Errorf("missing commits of BFT time")
+	}
+	if err := rbft.checkAggregatedCommits(commits); err != nil {
+		return fmt.Errorf("invalid commits of BFT time: %w", err)
+	}
+	if n := commits[0].SequenceNumber; n >= preprep.SequenceNumber {
+		return fmt.Errorf("commits of BFT time are for seqNo %d not before %d", n, preprep.SequenceNumber)
+	}
+	if median := medianCommitTimestamp(commits); median != bftTime {
+		return fmt.Errorf("BFT time %d is not the median timestamp %d of commits", bftTime, median)
+	}
+	return nil
+}
+
+// checkBatchTimestamp checks whether the timestamp of a pre-prepare is within the drift window of
+// local clock and strictly greater than the timestamp of previous batch.
+func (rbft *rbftImpl[T, Constraint]) checkBatchTimestamp(preprep *consensus.PrePrepare) error {
+	if rbft.config.MaxBatchTimestampDrift <= 0 || preprep.BatchDigest == "" {
+		return nil
+	}
+	// batches which have been accepted before may be re-proposed in recovery or view change.
+	if _, ok := rbft.storeMgr.batchStore[preprep.BatchDigest]; ok || preprep.SequenceNumber <= rbft.exec.lastExec {
+		return nil
+	}
+
+	timestamp := preprep.HashBatch.Timestamp
+	drift := time.Duration(time.Now().UnixNano() - timestamp)
+	if drift > rbft.config.MaxBatchTimestampDrift || drift < -rbft.config.MaxBatchTimestampDrift {
+		return fmt.Errorf("timestamp %d drifts %v from local clock", timestamp, drift)
+	}
+
+	prev := rbft.exec.lastExecTimestamp
+	if digest, ok := rbft.storeMgr.seqMap[preprep.SequenceNumber-1]; ok {
+		if batch, ok := rbft.storeMgr.batchStore[digest]; ok && batch.Timestamp > prev {
+			prev = batch.Timestamp
+		}
+	}
+	if timestamp <= prev {
+		return fmt.Errorf("timestamp %d is not greater than previous batch timestamp %d", timestamp, prev)
+	}
+	return nil
+}
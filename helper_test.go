@@ -515,3 +515,17 @@ func TestHelper_CheckIfNeedStateUpdate(t *testing.T) {
 func unMarshalVcBasis(vc *consensus.ViewChange) *consensus.VcBasis {
 	return vc.Basis
 }
+
+func TestHelper_medianCommitTimestamp(t *testing.T) {
+	assert.Equal(t, int64(0), medianCommitTimestamp(nil))
+
+	commits := []*consensus.Commit{
+		{ReplicaId: 1, Timestamp: 300},
+		{ReplicaId: 2, Timestamp: 100},
+		{ReplicaId: 3, Timestamp: 1 << 60},
+	}
+	assert.Equal(t, int64(300), medianCommitTimestamp(commits))
+
+	commits = append(commits, &consensus.Commit{ReplicaId: 4, Timestamp: 200})
+	assert.Equal(t, int64(300), medianCommitTimestamp(commits))
+}
@@ -0,0 +1,1844 @@
+package rbft
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/axiomesh/axiom-bft/common"
+	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-bft/types"
+	"github.com/axiomesh/axiom-kit/txpool"
+	kittypes "github.com/axiomesh/axiom-kit/types"
+)
+
+// maxHotStuffViewTimeoutMultiple limits the exponential backoff of the view timer.
+const maxHotStuffViewTimeoutMultiple = 8
+
+// hotstuffImpl is an alternative consensus engine which runs chained HotStuff:
+//  1. Every view has one leader rotated over the sorted validator set, which proposes one block
+//     extending the block certified by its highest QC.
+//  2. Replicas vote the proposal towards the leader of next view, which builds a QC from a quorum
+//     of votes and carries it in its own proposal.
+//  3. A block is committed once it's the head of a three-chain with direct parents, and locked
+//     once it's the head of a two-chain.
+//
+// Blocks without transactions keep the height of their parent and are only used to drive the chain,
+// so that the execution sequence of application is the same as RBFT. Txpool batching, checkpoints
+// and epoch change proofs are shared with RBFT.
+type hotstuffImpl[T any, Constraint kittypes.TXConstraint[T]] struct {
+	node *hotstuffNode[T, Constraint]
+
+	chainConfig *ChainConfig
+	config      Config
+	logger      common.Logger
+	external    ExternalStack[T, Constraint]
+	storage     Storage
+	validator   BatchValidator[T, Constraint]
+	keyRotator  KeyRotator
+	requestPool txpool.TxPool[T, Constraint]
+
+	peerMgr     *peerManager
+	timerMgr    *timerManager
+	epochMgr    *epochManager
+	rateLimiter *msgRateLimiter
+	nonceFilter *msgNonceFilter
+	metrics     *rbftMetrics
+	status      *statusManager
+
+	// keys are consensus keys of validators rotated in RBFT, learned from stable checkpoints.
+	keys *validatorKeys
+
+	recvChan chan consensusEvent
+	close    chan bool
+	wg       sync.WaitGroup
+
+	viewLock  sync.RWMutex
+	hLock     sync.RWMutex
+	epochLock sync.RWMutex
+
+	// validators sorted by id, used to rotate leader by view.
+	validators []uint64
+
+	// genesis block of current epoch which is certified by the genesis QC.
+	genesis *consensus.HotStuffBlock
+
+	// root is the latest committed block, blocks in view lower than root will be pruned.
+	root *consensus.HotStuffBlock
+
+	// detachedRoot is set if root is not committed in block tree but restored on the executed state
+	// after restart or state update, it may be replaced by a certified block fetched from peers.
+	detachedRoot bool
+
+	// blocks is the block tree indexed by block hash.
+	blocks map[string]*consensus.HotStuffBlock
+
+	// highQC is the highest QC ever seen, lockedQC is the QC of latest locked block.
+	highQC   *consensus.HotStuffQC
+	lockedQC *consensus.HotStuffQC
+
+	// lastVotedView is the latest view in which this replica has voted.
+	lastVotedView uint64
+
+	// proposedView is the latest view in which this replica has proposed as leader.
+	proposedView uint64
+
+	// votes collected as leader of next view, indexed by block hash.
+	votes map[string]map[uint64][]byte
+
+	// new views collected as leader, indexed by view.
+	newViews map[uint64]map[uint64]*consensus.HotStuffQC
+
+	// proposals waiting for missing txs, indexed by batch digest.
+	pendingProposals map[string]*consensus.HotStuffProposal
+
+	// proposals arrived before their parent, indexed by parent hash.
+	orphanProposals map[string][]*consensus.HotStuffProposal
+
+	// missing blocks being fetched, indexed by block hash with the view of QC certifying it.
+	fetchingBlocks map[string]uint64
+
+	// lastExec is the height of latest executed block.
+	lastExec uint64
+
+	// seqMap maps executed height to batch digest.
+	seqMap map[uint64]string
+
+	// localCheckpoints are checkpoints generated by self, indexed by height.
+	localCheckpoints map[uint64]*consensus.SignedCheckpoint
+
+	// checkpointStore collects checkpoints from validators, indexed by height and author.
+	checkpointStore map[uint64]map[uint64]*consensus.SignedCheckpoint
+
+	// viewTimeoutMultiple is the backoff multiple of view timer, reset after progress.
+	viewTimeoutMultiple time.Duration
+}
+
+func newHotStuff[T any, Constraint kittypes.TXConstraint[T]](c Config, external ExternalStack[T, Constraint], requestPool txpool.TxPool[T, Constraint]) (*hotstuffImpl[T, Constraint], error) {
+	// init message event converter
+	once.Do(initMsgEventMap)
+
+	chainConfig := &ChainConfig{
+		EpochInfo: nil,
+		EpochDerivedData: EpochDerivedData{
+			nodeInfoMap: make(map[uint64]NodeInfo),
+		},
+		DynamicChainConfig: DynamicChainConfig{
+			H:                           c.GenesisEpochInfo.StartBlock,
+			RecentBlockProcessorTracker: NewBlockProcessorTracker(external.GetBlockMeta),
+			ReputationTracker:           NewReputationTracker(external.GetBlockMeta),
+		},
+		SelfP2PNodeID:    c.SelfP2PNodeID,
+		logger:           c.Logger,
+		getNodeInfoFn:    external.GetNodeInfo,
+		getNodeIDByP2PID: external.GetNodeIDByP2PID,
+	}
+	hs := &hotstuffImpl[T, Constraint]{
+		chainConfig:         chainConfig,
+		config:              c,
+		logger:              c.Logger,
+		external:            external,
+		storage:             external,
+		requestPool:         requestPool,
+		recvChan:            make(chan consensusEvent, 1024),
+		close:               make(chan bool),
+		status:              newStatusMgr(),
+		viewTimeoutMultiple: 1,
+	}
+
+	if c.EpochProofRetention != 0 {
+		if _, ok := any(external).(EpochStatePruner); !ok {
+			return nil, errors.New("epoch service of external stack doesn't implement EpochStatePruner")
+		}
+	}
+
+	var err error
+	// new metrics instance
+	hs.metrics, err = newRBFTMetrics(c.MetricsProv)
+	if err != nil {
+		hs.metrics.unregisterMetrics()
+		hs.metrics = nil
+		return nil, err
+	}
+
+	hs.timerMgr = newTimerMgr(hs.recvChan, c)
+	hs.peerMgr = newPeerManager(chainConfig, hs.metrics, external, external, external, c, false)
+	hs.epochMgr = newEpochManager(chainConfig, c, hs.peerMgr, external, external)
+	hs.rateLimiter = newMsgRateLimiter(c, hs.metrics)
+	hs.nonceFilter = newMsgNonceFilter(c, external, hs.metrics)
+	hs.keys = newValidatorKeys(external, c.Logger)
+	if validator, ok := any(external).(BatchValidator[T, Constraint]); ok {
+		hs.validator = validator
+	}
+	if c.EnableKeyRotation {
+		keyRotator, ok := any(external).(KeyRotator)
+		if !ok {
+			return nil, errors.New("crypto of external stack doesn't implement KeyRotator")
+		}
+		hs.keyRotator = keyRotator
+	}
+
+	// use GenesisEpochInfo as default
+	hs.chainConfig.EpochInfo = c.GenesisEpochInfo
+	return hs, nil
+}
+
+func (hs *hotstuffImpl[T, Constraint]) init() error {
+	e, err := hs.external.GetEpochInfo(hs.config.LastServiceState.Epoch)
+	if err != nil {
+		hs.logger.Debugf("Replica %d failed to get current epoch from ledger: %v, will use genesis epoch info", hs.chainConfig.SelfID, err)
+		e = hs.config.GenesisEpochInfo
+	}
+	validatorSet, err := hs.external.GetValidatorSet()
+	if err != nil {
+		hs.logger.Errorf("Replica %d failed to get validator set from ledger: %v", hs.chainConfig.SelfID, err)
+		return err
+	}
+	if err = hs.updateEpochInfo(e, validatorSet); err != nil {
+		return err
+	}
+
+	hs.lastExec = hs.config.LastServiceState.MetaState.Height
+	hs.chainConfig.H = hs.lastExec
+	hs.resetBlockTree(hs.lastExec, hs.config.LastServiceState.MetaState.Digest)
+	hs.restoreSafetyState()
+	if hs.keyRotator != nil {
+		hs.keys.restore()
+	}
+
+	hs.timerMgr.newTimer(batchTimer, hs.config.BatchTimeout)
+	hs.timerMgr.newTimer(hotstuffViewTimer, hs.config.HotStuffViewTimeout)
+
+	hs.metrics.idGauge.Set(float64(hs.chainConfig.SelfID))
+	hs.metrics.epochGauge.Set(float64(hs.chainConfig.EpochInfo.Epoch))
+	hs.metrics.clusterSizeGauge.Set(float64(hs.chainConfig.N))
+	hs.metrics.quorumSizeGauge.Set(float64(hs.commonCaseQuorum()))
+
+	hs.logger.Infof("HotStuff current epoch = %v", hs.chainConfig.EpochInfo.Epoch)
+	hs.logger.Infof("HotStuff last exec block = %v", hs.lastExec)
+	hs.logger.Infof("HotStuff Max number of validating peers (N) = %v", hs.chainConfig.N)
+	hs.logger.Infof("HotStuff Max number of failing peers (f) = %v", hs.chainConfig.F)
+	hs.logger.Infof("HotStuff ID: %d", hs.chainConfig.SelfID)
+	hs.logger.Infof("HotStuff view timeout = %v", hs.timerMgr.getTimeoutValue(hotstuffViewTimer))
+	if hs.config.ThresholdDecrypter != nil {
+		hs.logger.Warningf("HotStuff does not support threshold decryption, txs are executed as they are")
+	}
+	if hs.config.EnableVRFSeed {
+		hs.logger.Warningf("HotStuff does not support vrf seed, leaders are rotated as usual")
+	}
+	if hs.config.InboundQueueSize > 0 {
+		hs.logger.Warningf("HotStuff does not support prioritized inbound scheduler, events are processed in FIFO")
+	}
+	if hs.config.SigVerifyWorkers > 0 {
+		hs.logger.Warningf("HotStuff does not support parallel signature verification, signatures are verified in event loop")
+	}
+	if hs.config.FetchPeers > 0 {
+		hs.logger.Warningf("HotStuff does not support multi-peer fetcher, missing txs are fetched from proposer")
+	}
+	if hs.config.SnapshotConsumer != nil || hs.config.SnapshotProvider != nil {
+		hs.logger.Warningf("HotStuff does not support snapshot sync, state is synced by ServiceOutbound.StateUpdate")
+	}
+	if hs.config.EnableKeyRotation {
+		hs.logger.Warningf("HotStuff does not support announcing key rotation, only keys rotated before are verified")
+	}
+
+	hs.requestPool.Init(txpool.ConsensusConfig{
+		SelfID:                hs.chainConfig.SelfID,
+		NotifyFindNextBatchFn: hs.node.NotifyFindNextBatch,
+		NotifyGenerateBatchFn: hs.node.NotifyGenBatch,
+	})
+	hs.status.atomicSetBit(Pending)
+	return nil
+}
+
+// start starts the chained HotStuff service.
+func (hs *hotstuffImpl[T, Constraint]) start() error {
+	hs.status.atomicClearBit(Pending)
+	hs.logger.Noticef("--------HotStuff starting, nodeID: %d--------", hs.chainConfig.SelfID)
+
+	go hs.listenEvent()
+
+	hs.startViewTimer()
+	hs.postMsg(&MiscEvent{EventType: NotifyGenBatchEvent})
+	return nil
+}
+
+// stop stops the chained HotStuff service.
+func (hs *hotstuffImpl[T, Constraint]) stop() {
+	hs.logger.Notice("HotStuff stopping...")
+	hs.status.atomicSetBit(Pending)
+	hs.status.atomicSetBit(Stopped)
+
+	select {
+	case <-hs.close:
+	default:
+		close(hs.close)
+	}
+
+	hs.timerMgr.Stop()
+	if hs.requestPool != nil {
+		hs.requestPool.Stop()
+	}
+	if hs.metrics != nil {
+		hs.metrics.unregisterMetrics()
+	}
+
+	hs.wg.Wait()
+	hs.logger.Notice("HotStuff stopped!")
+}
+
+// step receives and processes messages from other peers.
+func (hs *hotstuffImpl[T, Constraint]) step(ctx context.Context, msg *consensus.ConsensusMessage) {
+	if hs.status.atomicHasBit(Pending) {
+		hs.logger.Debugf("Replica %d is in pending status, reject consensus messages", hs.chainConfig.SelfID)
+		return
+	}
+	hs.postMsg(&consensusMessageWrapper{
+		ctx:              ctx,
+		ConsensusMessage: msg,
+	})
+}
+
+// postMsg posts messages to main loop.
+func (hs *hotstuffImpl[T, Constraint]) postMsg(msg any) {
+	select {
+	case hs.recvChan <- msg:
+	case <-hs.close:
+	}
+}
+
+// reportCheckpoint informs HotStuff a checkpoint block has been executed.
+func (hs *hotstuffImpl[T, Constraint]) reportCheckpoint(state *types.ServiceState) {
+	if hs.status.atomicHasBit(Pending) {
+		return
+	}
+	hs.epochLock.RLock()
+	epochInfo := hs.chainConfig.EpochInfo
+	hs.epochLock.RUnlock()
+	height := state.MetaState.Height
+	if isConfigBatch(height, epochInfo) || height%epochInfo.ConsensusParams.CheckpointPeriod == 0 {
+		go hs.postMsg(&LocalEvent{
+			Service:   CoreRbftService,
+			EventType: CoreCheckpointBlockExecutedEvent,
+			Event:     state,
+		})
+	}
+}
+
+// reportStateUpdated informs HotStuff a state update has been finished.
+func (hs *hotstuffImpl[T, Constraint]) reportStateUpdated(state *types.ServiceSyncState) {
+	go hs.postMsg(&LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreStateUpdatedEvent,
+		Event:     state,
+	})
+}
+
+// getStatus returns the current consensus status.
+func (hs *hotstuffImpl[T, Constraint]) getStatus() (status NodeStatus) {
+	hs.viewLock.RLock()
+	status.View = hs.chainConfig.View
+	hs.viewLock.RUnlock()
+
+	hs.hLock.RLock()
+	status.H = hs.chainConfig.H
+	hs.hLock.RUnlock()
+
+	hs.epochLock.RLock()
+	status.EpochInfo = hs.chainConfig.EpochInfo.Clone()
+	hs.epochLock.RUnlock()
+
+	status.ID = hs.chainConfig.SelfID
+	switch {
+	case hs.status.atomicHasBit(InEpochSyncing):
+		status.Status = InConfChange
+	case hs.status.atomicHasBit(StateTransferring):
+		status.Status = StateTransferring
+	case hs.status.atomicHasBit(Pending):
+		status.Status = Pending
+	default:
+		status.Status = Normal
+	}
+	return
+}
+
+// getLowWatermark returns the height of latest stable checkpoint.
+func (hs *hotstuffImpl[T, Constraint]) getLowWatermark() uint64 {
+	hs.hLock.RLock()
+	defer hs.hLock.RUnlock()
+	return hs.chainConfig.H
+}
+
+// listenEvent listens and dispatches messages according to their types.
+func (hs *hotstuffImpl[T, Constraint]) listenEvent() {
+	hs.wg.Add(1)
+	defer hs.wg.Done()
+	for {
+		select {
+		case <-hs.close:
+			hs.logger.Notice("exit HotStuff event listener")
+			return
+		case next := <-hs.recvChan:
+			for next != nil {
+				next = hs.processEvent(next)
+			}
+		}
+	}
+}
+
+// processEvent process consensus messages and local events.
+func (hs *hotstuffImpl[T, Constraint]) processEvent(ee consensusEvent) consensusEvent {
+	switch e := ee.(type) {
+	case *LocalEvent:
+		return hs.dispatchLocalEvent(e)
+	case *MiscEvent:
+		return hs.dispatchMiscEvent(e)
+	case *consensusMessageWrapper:
+		return hs.consensusMessageFilter(e.ctx, e.ConsensusMessage)
+	case error:
+		hs.logger.Debugf("Replica %d process event error: %s", hs.chainConfig.SelfID, e)
+		return nil
+	default:
+		hs.logger.Errorf("Can't recognize event type of %v.", e)
+		return nil
+	}
+}
+
+func (hs *hotstuffImpl[T, Constraint]) dispatchLocalEvent(e *LocalEvent) consensusEvent {
+	switch e.Service {
+	case CoreRbftService:
+		switch e.EventType {
+		case CoreBatchTimerEvent:
+			hs.timerMgr.stopTimer(batchTimer)
+			hs.tryPropose()
+		case CoreHotStuffViewTimerEvent:
+			hs.handleViewTimeout(e.Event.(uint64))
+		case CoreCheckpointBlockExecutedEvent:
+			hs.checkpoint(e.Event.(*types.ServiceState))
+		case CoreStateUpdatedEvent:
+			hs.recvStateUpdatedEvent(e.Event.(*types.ServiceSyncState))
+		}
+	case EpochMgrService:
+		if e.EventType == EpochSyncEvent {
+			hs.epochSync(e.Event.(*consensus.EpochChangeProof))
+		}
+	default:
+		hs.logger.Errorf("Not Supported event: %v", e)
+	}
+	return nil
+}
+
+func (hs *hotstuffImpl[T, Constraint]) dispatchMiscEvent(e *MiscEvent) consensusEvent {
+	switch e.EventType {
+	case NotifyGenBatchEvent:
+		hs.tryPropose()
+	case NotifyFindNextBatchEvent:
+		for _, hash := range e.Event.(*NotifyFindNextBatchMsg).hashes {
+			if proposal, ok := hs.pendingProposals[hash]; ok {
+				delete(hs.pendingProposals, hash)
+				hs.recvProposal(context.TODO(), proposal)
+			}
+		}
+	}
+	return nil
+}
+
+func (hs *hotstuffImpl[T, Constraint]) consensusMessageFilter(ctx context.Context, msg *consensus.ConsensusMessage) consensusEvent {
+	if msg.Epoch != hs.chainConfig.EpochInfo.Epoch && !consensus.ConsensusMsgWhiteList[msg.Type] {
+		return hs.epochMgr.checkEpoch(msg)
+	}
+	if hs.status.atomicHasBit(InEpochSyncing) {
+		hs.logger.Debugf("Replica %d is in epoch syncing status, reject consensus messages", hs.chainConfig.SelfID)
+		return nil
+	}
+	if hs.chainConfig.isValidator() && !hs.chainConfig.CheckValidator(msg.From) {
+		if _, ok := validatorAcceptMsgsFromNonValidator[msg.Type]; !ok {
+			hs.logger.Debugf("Replica %d not accept msg[%s] from non-validator %d",
+				hs.chainConfig.SelfID, msg.Type.String(), msg.From)
+			return nil
+		}
+	}
+	if msg.From != hs.chainConfig.SelfID && !hs.rateLimiter.allow(msg.From, msg.Type) {
+		return nil
+	}
+	// only validators are tracked by nonce, so that forged senders can't allocate nonce windows.
+	remoteValidator := msg.From != hs.chainConfig.SelfID && hs.chainConfig.CheckValidator(msg.From)
+	if remoteValidator {
+		hs.peerMgr.recordCompression(msg.From, msg.AcceptCompression)
+	}
+	if err := hs.peerMgr.decompress(msg); err != nil {
+		hs.logger.Warningf("Replica %d reject msg from %d: %v", hs.chainConfig.SelfID, msg.From, err)
+		return nil
+	}
+	if remoteValidator && hs.nonceFilter.tracks(msg.Nonce) {
+		if err := hs.verifySignature(msg.From, msg.Signature, envelopeDigest(msg)); err != nil {
+			hs.logger.Warningf("Replica %d reject msg from %d with invalid envelope signature: %v",
+				hs.chainConfig.SelfID, msg.From, err)
+			return nil
+		}
+		if !hs.nonceFilter.accept(msg.From, msg.Nonce) {
+			return nil
+		}
+	}
+
+	fn, ok := eventCreators[msg.Type]
+	if !ok {
+		hs.logger.Errorf("Replica %d receives unsupported message type %s", hs.chainConfig.SelfID, msg.Type)
+		return nil
+	}
+	event := fn()
+	if err := event.UnmarshalVT(msg.Payload); err != nil {
+		hs.logger.Errorf("Unmarshal error, can not unmarshal %v, error: %v", msg.Type, err)
+		return nil
+	}
+
+	switch et := event.(type) {
+	case *consensus.HotStuffProposal:
+		hs.recvProposal(ctx, et)
+	case *consensus.HotStuffVote:
+		hs.recvVote(et)
+	case *consensus.HotStuffNewView:
+		hs.recvNewView(et)
+	case *consensus.SignedCheckpoint:
+		hs.recvCheckpoint(et)
+	case *consensus.FetchMissingRequest:
+		hs.recvFetchMissingRequest(ctx, et)
+	case *consensus.FetchMissingResponse:
+		hs.recvFetchMissingResponse(ctx, et)
+	case *consensus.HotStuffFetchBlock:
+		hs.recvFetchBlock(ctx, et)
+	case *consensus.HotStuffFetchBlockResponse:
+		hs.recvFetchBlockResponse(ctx, et)
+	case *consensus.EpochChangeRequest:
+		_ = hs.epochMgr.processEpochChangeRequest(et)
+	case *consensus.EpochChangeProof:
+		return hs.epochMgr.processEpochChangeProof(et)
+	default:
+		hs.logger.Debugf("Replica %d ignore message type %s in chained HotStuff", hs.chainConfig.SelfID, msg.Type)
+	}
+	return nil
+}
+
+// =============================================================================
+// block tree and safety rules
+// =============================================================================
+
+// newHotStuffGenesis builds a block on the given executed state which is certified by a QC of view 0.
+func newHotStuffGenesis(height uint64, digest string) *consensus.HotStuffBlock {
+	genesis := &consensus.HotStuffBlock{
+		View:       0,
+		Height:     height,
+		ParentHash: digest,
+	}
+	genesis.Hash = genesis.CalculateHash()
+	genesis.Justify = &consensus.HotStuffQC{
+		View:      0,
+		Height:    height,
+		BlockHash: genesis.Hash,
+	}
+	return genesis
+}
+
+// resetBlockTree resets the block tree and the voting state of current epoch on the given executed state.
+func (hs *hotstuffImpl[T, Constraint]) resetBlockTree(height uint64, digest string) {
+	hs.genesis = hs.loadGenesis(height, digest)
+	hs.highQC = hs.genesis.Justify
+	hs.lockedQC = hs.genesis.Justify
+	hs.lastVotedView = 0
+	hs.proposedView = 0
+	hs.setView(1)
+	hs.rebuildBlockTree(height, digest)
+}
+
+// rebuildBlockTree drops uncommitted blocks and restarts the block tree on the given executed state,
+// the voting state is kept. Root is the genesis block if nothing has been executed in current epoch,
+// otherwise it's the persisted root on the executed height or a detached block on the executed state,
+// which is replaced by the first fetched ancestor within the executed height.
+func (hs *hotstuffImpl[T, Constraint]) rebuildBlockTree(height uint64, digest string) {
+	for _, blk := range hs.blocks {
+		if blk.View != 0 && !blk.IsEmpty() && blk.Height > height {
+			if err := hs.requestPool.RestoreOneBatch(blk.BatchDigest); err != nil {
+				hs.logger.Debugf("Replica %d restore batch %s failed: %s", hs.chainConfig.SelfID, blk.BatchDigest, err)
+			}
+		}
+	}
+
+	root := hs.genesis
+	hs.detachedRoot = false
+	if height != hs.genesis.Height {
+		if root = hs.restoreRoot(height); root == nil {
+			root = newHotStuffGenesis(height, digest)
+		}
+		hs.detachedRoot = true
+	}
+	hs.root = root
+	hs.blocks = map[string]*consensus.HotStuffBlock{hs.genesis.Hash: hs.genesis, root.Hash: root}
+	hs.votes = make(map[string]map[uint64][]byte)
+	hs.newViews = make(map[uint64]map[uint64]*consensus.HotStuffQC)
+	hs.pendingProposals = make(map[string]*consensus.HotStuffProposal)
+	hs.orphanProposals = make(map[string][]*consensus.HotStuffProposal)
+	hs.fetchingBlocks = make(map[string]uint64)
+	hs.logger.Infof("Replica %d rebuild block tree from root %s, detached: %v", hs.chainConfig.SelfID,
+		root.ID(), hs.detachedRoot)
+
+	// highQC is kept after state update, fetch its block to extend it.
+	if _, ok := hs.blocks[hs.highQC.BlockHash]; !ok {
+		hs.fetchBlock(context.TODO(), hs.highQC.BlockHash, hs.highQC.View, 0)
+	}
+}
+
+func (hs *hotstuffImpl[T, Constraint]) setView(view uint64) {
+	hs.viewLock.Lock()
+	hs.chainConfig.View = view
+	hs.viewLock.Unlock()
+	hs.metrics.viewGauge.Set(float64(view))
+}
+
+// getLeaderSchedule returns the leaders of count views from fromView, no node is excluded or punished
+// as the leader rotates over the sorted validator set.
+func (hs *hotstuffImpl[T, Constraint]) getLeaderSchedule(fromView uint64, count uint64) *LeaderSchedule {
+	hs.viewLock.RLock()
+	view := hs.chainConfig.View
+	hs.viewLock.RUnlock()
+
+	hs.epochLock.RLock()
+	defer hs.epochLock.RUnlock()
+	schedule := &LeaderSchedule{
+		Epoch:     hs.chainConfig.EpochInfo.Epoch,
+		View:      view,
+		FromView:  fromView,
+		Primaries: make([]uint64, 0, count),
+	}
+	for i := uint64(0); i < count; i++ {
+		schedule.Primaries = append(schedule.Primaries, hs.leaderOf(fromView+i))
+	}
+	return schedule
+}
+
+// leaderOf returns the leader of the given view.
+func (hs *hotstuffImpl[T, Constraint]) leaderOf(view uint64) uint64 {
+	return hs.validators[view%uint64(len(hs.validators))]
+}
+
+func (hs *hotstuffImpl[T, Constraint]) isLeader(view uint64) bool {
+	return hs.leaderOf(view) == hs.chainConfig.SelfID
+}
+
+func (hs *hotstuffImpl[T, Constraint]) commonCaseQuorum() int {
+	return (hs.chainConfig.N + hs.chainConfig.F + 2) / 2
+}
+
+func (hs *hotstuffImpl[T, Constraint]) oneCorrectQuorum() int {
+	return hs.chainConfig.F + 1
+}
+
+// extends returns whether block b extends the block with the given hash.
+func (hs *hotstuffImpl[T, Constraint]) extends(b *consensus.HotStuffBlock, hash string) bool {
+	for b != nil {
+		if b.Hash == hash {
+			return true
+		}
+		if b.Hash == hs.root.Hash {
+			return false
+		}
+		b = hs.blocks[b.ParentHash]
+	}
+	return false
+}
+
+// hasPendingBlock returns whether there is any uncommitted block with txs from the block with the given hash.
+func (hs *hotstuffImpl[T, Constraint]) hasPendingBlock(hash string) bool {
+	for b := hs.blocks[hash]; b != nil && b.Hash != hs.root.Hash; b = hs.blocks[b.ParentHash] {
+		if !b.IsEmpty() {
+			return true
+		}
+	}
+	return false
+}
+
+// needDriving returns whether a block extending the given parent is needed even without txs: either
+// there are uncommitted blocks with txs, or a block with txs among the last three ancestors was just
+// committed by self and other replicas need a child block carrying the QC to commit it too.
+func (hs *hotstuffImpl[T, Constraint]) needDriving(parentHash string) bool {
+	if hs.hasPendingBlock(parentHash) {
+		return true
+	}
+	b := hs.blocks[parentHash]
+	for i := 0; i < 3 && b != nil && b.View != 0; i++ {
+		if !b.IsEmpty() {
+			return true
+		}
+		b = hs.blocks[b.ParentHash]
+	}
+	return false
+}
+
+// safeNode is the voting rule of HotStuff: a block is safe if it extends the locked block, or its
+// justify QC is higher than the locked QC.
+func (hs *hotstuffImpl[T, Constraint]) safeNode(b *consensus.HotStuffBlock) bool {
+	return hs.extends(b, hs.lockedQC.BlockHash) || b.Justify.View > hs.lockedQC.View
+}
+
+// epochEndHeight returns the height of config block in current epoch.
+func (hs *hotstuffImpl[T, Constraint]) epochEndHeight() uint64 {
+	return hs.chainConfig.EpochInfo.StartBlock + hs.chainConfig.EpochInfo.EpochPeriod - 1
+}
+
+// updateQC updates highQC, lockedQC and commits blocks with the given verified QC.
+func (hs *hotstuffImpl[T, Constraint]) updateQC(qc *consensus.HotStuffQC) {
+	if qc.View > hs.highQC.View {
+		hs.highQC = qc
+	}
+
+	b2 := hs.blocks[qc.BlockHash]
+	if b2 == nil || b2.View == 0 {
+		return
+	}
+	b1 := hs.blocks[b2.Justify.BlockHash]
+	if b1 == nil {
+		return
+	}
+	if b2.Justify.View > hs.lockedQC.View {
+		hs.lockedQC = b2.Justify
+		hs.persistLockedQC()
+	}
+	if b1.View == 0 {
+		return
+	}
+	b0 := hs.blocks[b1.Justify.BlockHash]
+	if b0 == nil {
+		return
+	}
+	if b2.ParentHash == b1.Hash && b1.ParentHash == b0.Hash && b0.View > hs.root.View {
+		hs.commit(b0)
+	}
+}
+
+// commit commits block b with all its uncommitted ancestors and executes blocks with txs in order.
+// Root only moves to the last executed block, so that blocks after a height gap are committed again
+// once the gap is filled by state update.
+func (hs *hotstuffImpl[T, Constraint]) commit(b *consensus.HotStuffBlock) {
+	if !hs.extends(b, hs.root.Hash) {
+		hs.logger.Debugf("Replica %d cannot commit block %s as some ancestors are missing", hs.chainConfig.SelfID, b.ID())
+		return
+	}
+	var chain []*consensus.HotStuffBlock
+	for cur := b; cur.Hash != hs.root.Hash; cur = hs.blocks[cur.ParentHash] {
+		chain = append(chain, cur)
+	}
+	lo.Reverse(chain)
+
+	root := hs.root
+	for _, blk := range chain {
+		if blk.IsEmpty() || blk.Height <= hs.lastExec {
+			root = blk
+			continue
+		}
+		if blk.Height != hs.lastExec+1 {
+			hs.logger.Warningf("Replica %d cannot execute block %s as lastExec is %d, wait for state update",
+				hs.chainConfig.SelfID, blk.ID(), hs.lastExec)
+			break
+		}
+		txs, localList, missing, err := hs.requestPool.GetRequestsByHashList(blk.BatchDigest, blk.HashBatch.Timestamp,
+			blk.HashBatch.RequestHashList, blk.HashBatch.DeDuplicateRequestHashList)
+		if err != nil || len(missing) != 0 {
+			hs.logger.Errorf("Replica %d cannot find txs of committed block %s: %v", hs.chainConfig.SelfID, blk.ID(), err)
+			hs.stopNamespace()
+			return
+		}
+		hs.logger.Noticef("Replica %d committed block %s with %d txs", hs.chainConfig.SelfID, blk.ID(), len(txs))
+		hs.external.Execute(txs, localList, blk.Height, blk.HashBatch.Timestamp, blk.Proposer)
+		hs.lastExec = blk.Height
+		hs.seqMap[blk.Height] = blk.BatchDigest
+		hs.requestPool.RemoveBatches([]string{blk.BatchDigest})
+		hs.metrics.committedBlockNumber.Add(float64(1))
+		hs.metrics.committedTxs.Add(float64(len(txs)))
+		if isConfigBatch(blk.Height, hs.chainConfig.EpochInfo) {
+			hs.metrics.committedConfigBlockNumber.Add(float64(1))
+		}
+		root = blk
+	}
+	if root == hs.root {
+		return
+	}
+	hs.root = root
+	hs.detachedRoot = false
+	hs.persistRoot()
+	hs.pruneBlocks()
+}
+
+// pruneBlocks removes blocks which don't extend root, txs of discarded blocks are restored into pool.
+func (hs *hotstuffImpl[T, Constraint]) pruneBlocks() {
+	for hash, blk := range hs.blocks {
+		if blk.View >= hs.root.View {
+			continue
+		}
+		if blk.View != 0 && !blk.IsEmpty() && blk.Height > hs.lastExec {
+			if err := hs.requestPool.RestoreOneBatch(blk.BatchDigest); err != nil {
+				hs.logger.Debugf("Replica %d restore batch %s failed: %s", hs.chainConfig.SelfID, blk.BatchDigest, err)
+			}
+		}
+		delete(hs.blocks, hash)
+		delete(hs.votes, hash)
+	}
+	for digest, proposal := range hs.pendingProposals {
+		if proposal.Block.View < hs.root.View {
+			delete(hs.pendingProposals, digest)
+		}
+	}
+	for parent, proposals := range hs.orphanProposals {
+		proposals = lo.Filter(proposals, func(item *consensus.HotStuffProposal, _ int) bool {
+			return item.Block.View >= hs.root.View
+		})
+		if len(proposals) == 0 {
+			delete(hs.orphanProposals, parent)
+		} else {
+			hs.orphanProposals[parent] = proposals
+		}
+	}
+	for v := range hs.newViews {
+		if v < hs.root.View {
+			delete(hs.newViews, v)
+		}
+	}
+	for hash, v := range hs.fetchingBlocks {
+		if v < hs.root.View {
+			delete(hs.fetchingBlocks, hash)
+		}
+	}
+}
+
+// =============================================================================
+// proposal and vote
+// =============================================================================
+
+// tryPropose proposes a new block if self is the leader of current view and has a QC of last view
+// or a quorum of new views.
+func (hs *hotstuffImpl[T, Constraint]) tryPropose() {
+	view := hs.chainConfig.View
+	if !hs.chainConfig.isValidator() || !hs.isLeader(view) || hs.proposedView >= view {
+		return
+	}
+	if hs.highQC.View+1 != view && len(hs.newViews[view]) < hs.commonCaseQuorum() {
+		return
+	}
+	parent := hs.blocks[hs.highQC.BlockHash]
+	if parent == nil {
+		hs.logger.Warningf("Replica %d cannot propose in view %d as block %s of highQC is missing",
+			hs.chainConfig.SelfID, view, hs.highQC.BlockHash)
+		return
+	}
+
+	block := &consensus.HotStuffBlock{
+		View:       view,
+		Height:     parent.Height,
+		ParentHash: parent.Hash,
+		Justify:    hs.highQC,
+		Proposer:   hs.chainConfig.SelfID,
+	}
+	// stop packing txs after config block to wait for epoch change.
+	if parent.Height < hs.epochEndHeight() && hs.requestPool.HasPendingRequestInPool() {
+		batch, err := hs.requestPool.GenerateRequestBatch(txpool.GenBatchTimeoutEvent)
+		if err != nil {
+			hs.logger.Debugf("Replica %d generate batch failed: %s", hs.chainConfig.SelfID, err)
+		} else if batch != nil && len(batch.TxHashList) != 0 {
+			block.Height = parent.Height + 1
+			block.BatchDigest = batch.BatchHash
+			block.HashBatch = &consensus.HashBatch{
+				RequestHashList: batch.TxHashList,
+				Timestamp:       batch.Timestamp,
+				Proposer:        hs.chainConfig.SelfID,
+			}
+		}
+	}
+	// propose an empty block only to drive uncommitted blocks with txs.
+	if block.IsEmpty() && !hs.needDriving(parent.Hash) {
+		hs.timerMgr.softStartTimerWithNewTT(batchTimer, hs.timerMgr.getTimeoutValue(batchTimer), &LocalEvent{
+			Service:   CoreRbftService,
+			EventType: CoreBatchTimerEvent,
+		})
+		return
+	}
+	hs.timerMgr.stopTimer(batchTimer)
+	block.Hash = block.CalculateHash()
+	hs.proposedView = view
+
+	proposal := &consensus.HotStuffProposal{
+		ReplicaId: hs.chainConfig.SelfID,
+		Block:     block,
+	}
+	payload, err := proposal.MarshalVTStrict()
+	if err != nil {
+		hs.logger.Errorf("Marshal HotStuffProposal failed: %s", err)
+		return
+	}
+	hs.logger.Debugf("Leader %d propose block %s", hs.chainConfig.SelfID, block.ID())
+	hs.peerMgr.broadcast(context.TODO(), &consensus.ConsensusMessage{
+		Type:    consensus.Type_HOTSTUFF_PROPOSAL,
+		Payload: payload,
+	})
+	hs.processProposal(context.TODO(), proposal)
+}
+
+// recvProposal checks a proposal from leader and resolves its txs before processing it.
+func (hs *hotstuffImpl[T, Constraint]) recvProposal(ctx context.Context, proposal *consensus.HotStuffProposal) {
+	b := proposal.GetBlock()
+	if b == nil || b.Justify == nil {
+		hs.logger.Warningf("Replica %d received invalid proposal from %d", hs.chainConfig.SelfID, proposal.ReplicaId)
+		return
+	}
+	hs.logger.Debugf("Replica %d received proposal %s", hs.chainConfig.SelfID, b.ID())
+	if _, ok := hs.blocks[b.Hash]; ok {
+		return
+	}
+	if b.View < hs.root.View {
+		hs.logger.Debugf("Replica %d ignore proposal %s lower than committed view %d", hs.chainConfig.SelfID, b.ID(), hs.root.View)
+		return
+	}
+	if _, ok := hs.blocks[b.ParentHash]; !ok {
+		// the parent fetched by hash is certified by the justify QC.
+		if b.Justify.BlockHash != b.ParentHash || hs.verifyQC(b.Justify) != nil {
+			hs.logger.Warningf("Replica %d received proposal %s with invalid justify QC before its parent",
+				hs.chainConfig.SelfID, b.ID())
+			return
+		}
+		hs.logger.Debugf("Replica %d received proposal %s before its parent", hs.chainConfig.SelfID, b.ID())
+		hs.orphanProposals[b.ParentHash] = append(hs.orphanProposals[b.ParentHash], proposal)
+		if _, ok = hs.fetchingBlocks[b.ParentHash]; !ok {
+			hs.fetchBlock(ctx, b.ParentHash, b.Justify.View, proposal.ReplicaId)
+		}
+		return
+	}
+	if err := hs.checkProposal(proposal); err != nil {
+		hs.logger.Warningf("Replica %d received invalid proposal %s: %s", hs.chainConfig.SelfID, b.ID(), err)
+		return
+	}
+
+	if !b.IsEmpty() {
+		txs, _, missing, err := hs.requestPool.GetRequestsByHashList(b.BatchDigest, b.HashBatch.Timestamp,
+			b.HashBatch.RequestHashList, b.HashBatch.DeDuplicateRequestHashList)
+		if err != nil {
+			hs.logger.Warningf("Replica %d get txs of proposal %s failed: %s", hs.chainConfig.SelfID, b.ID(), err)
+			return
+		}
+		if len(missing) != 0 {
+			hs.pendingProposals[b.BatchDigest] = proposal
+			hs.fetchMissingTxs(ctx, b, missing)
+			return
+		}
+		// a rejected block is neither voted nor extended, leader of next view will time out.
+		if err = hs.validateBatch(txs, b.Height, b.HashBatch.Timestamp, b.Proposer); err != nil {
+			hs.logger.Warningf("Replica %d rejected proposal %s: %v", hs.chainConfig.SelfID, b.ID(), err)
+			hs.metrics.rejectedBatchCounter.Add(float64(1))
+			return
+		}
+	}
+	hs.processProposal(ctx, proposal)
+}
+
+// validateBatch asks application to check the txs of a proposal if external stack implements BatchValidator.
+func (hs *hotstuffImpl[T, Constraint]) validateBatch(txs []*T, height uint64, timestamp int64, proposer uint64) error {
+	if hs.validator == nil {
+		return nil
+	}
+	return hs.validator.ValidateBatch(txs, height, timestamp, proposer)
+}
+
+// checkProposal checks the proposer, hash, height and justify QC of a proposal.
+func (hs *hotstuffImpl[T, Constraint]) checkProposal(proposal *consensus.HotStuffProposal) error {
+	b := proposal.Block
+	if proposal.ReplicaId != b.Proposer || b.Proposer != hs.leaderOf(b.View) {
+		return fmt.Errorf("proposer %d is not the leader of view %d", b.Proposer, b.View)
+	}
+	if b.Hash != b.CalculateHash() {
+		return errors.New("mismatch block hash")
+	}
+	if b.Justify.BlockHash != b.ParentHash || b.Justify.View >= b.View {
+		return errors.New("justify QC doesn't certify parent")
+	}
+	if err := hs.verifyQC(b.Justify); err != nil {
+		return errors.WithMessage(err, "invalid justify QC")
+	}
+	parent := hs.blocks[b.ParentHash]
+	if parent == nil {
+		return fmt.Errorf("missing parent %s", b.ParentHash)
+	}
+	if b.IsEmpty() {
+		if b.Height != parent.Height || b.HashBatch != nil {
+			return errors.New("invalid empty block")
+		}
+		return nil
+	}
+	if parent.Height >= hs.epochEndHeight() {
+		return errors.New("propose txs after config block")
+	}
+	if b.Height != parent.Height+1 || b.HashBatch == nil || b.HashBatch.Proposer != b.Proposer {
+		return errors.New("invalid block height or batch")
+	}
+	if b.BatchDigest != calculateMD5Hash(b.HashBatch.RequestHashList, b.HashBatch.Timestamp) {
+		return errors.New("mismatch batch digest")
+	}
+	return nil
+}
+
+// processProposal adds a checked proposal into block tree, votes it if it's safe and moves to next view.
+func (hs *hotstuffImpl[T, Constraint]) processProposal(ctx context.Context, proposal *consensus.HotStuffProposal) {
+	b := proposal.Block
+	hs.blocks[b.Hash] = b
+	hs.updateQC(b.Justify)
+
+	if b.View > hs.lastVotedView && hs.safeNode(b) && hs.chainConfig.isValidator() {
+		hs.lastVotedView = b.View
+		hs.persistVotedView()
+		hs.sendVote(b)
+	}
+
+	if b.View >= hs.chainConfig.View {
+		hs.advanceView(b.View + 1)
+	}
+	// votes may arrive before the proposal.
+	hs.maybeFormQC(b.Hash)
+	hs.processOrphans(ctx, b.Hash)
+}
+
+// processOrphans processes the proposals arrived before the block with the given hash.
+func (hs *hotstuffImpl[T, Constraint]) processOrphans(ctx context.Context, hash string) {
+	if orphans, ok := hs.orphanProposals[hash]; ok {
+		delete(hs.orphanProposals, hash)
+		for _, orphan := range orphans {
+			hs.recvProposal(ctx, orphan)
+		}
+	}
+}
+
+// sendVote signs the block and sends the vote to the leader of next view.
+func (hs *hotstuffImpl[T, Constraint]) sendVote(b *consensus.HotStuffBlock) {
+	sig, err := hs.signVote(b.View, b.Height, b.Hash)
+	if err != nil {
+		return
+	}
+	vote := &consensus.HotStuffVote{
+		ReplicaId: hs.chainConfig.SelfID,
+		View:      b.View,
+		Height:    b.Height,
+		BlockHash: b.Hash,
+		Signature: sig,
+	}
+	next := hs.leaderOf(b.View + 1)
+	if next == hs.chainConfig.SelfID {
+		hs.recvVote(vote)
+		return
+	}
+	payload, err := vote.MarshalVTStrict()
+	if err != nil {
+		hs.logger.Errorf("Marshal HotStuffVote failed: %s", err)
+		return
+	}
+	hs.peerMgr.unicast(context.TODO(), &consensus.ConsensusMessage{
+		Type:    consensus.Type_HOTSTUFF_VOTE,
+		Payload: payload,
+	}, next)
+}
+
+// recvVote collects votes as the leader of next view.
+func (hs *hotstuffImpl[T, Constraint]) recvVote(vote *consensus.HotStuffVote) {
+	if !hs.isLeader(vote.View+1) || vote.View <= hs.highQC.View || vote.View < hs.root.View {
+		return
+	}
+	if !hs.chainConfig.CheckValidator(vote.ReplicaId) {
+		hs.logger.Warningf("Replica %d received vote from non-validator %d", hs.chainConfig.SelfID, vote.ReplicaId)
+		return
+	}
+	if err := hs.verifyVote(vote.ReplicaId, vote.Signature, vote.View, vote.Height, vote.BlockHash); err != nil {
+		hs.logger.Warningf("Replica %d received vote with invalid signature from %d: %s", hs.chainConfig.SelfID, vote.ReplicaId, err)
+		return
+	}
+	if _, ok := hs.votes[vote.BlockHash]; !ok {
+		hs.votes[vote.BlockHash] = make(map[uint64][]byte)
+	}
+	hs.votes[vote.BlockHash][vote.ReplicaId] = vote.Signature
+	hs.maybeFormQC(vote.BlockHash)
+}
+
+// maybeFormQC builds a QC once a quorum of votes on a known block is collected, and proposes in next view.
+func (hs *hotstuffImpl[T, Constraint]) maybeFormQC(hash string) {
+	b := hs.blocks[hash]
+	votes := hs.votes[hash]
+	if b == nil || len(votes) < hs.commonCaseQuorum() || b.View <= hs.highQC.View {
+		return
+	}
+	qc := &consensus.HotStuffQC{
+		View:       b.View,
+		Height:     b.Height,
+		BlockHash:  b.Hash,
+		Signatures: lo.MapEntries(votes, func(k uint64, v []byte) (uint64, []byte) { return k, v }),
+	}
+	hs.logger.Debugf("Replica %d formed QC for block %s", hs.chainConfig.SelfID, b.ID())
+	delete(hs.votes, hash)
+	hs.updateQC(qc)
+	if qc.View+1 > hs.chainConfig.View {
+		hs.advanceView(qc.View + 1)
+	}
+	hs.tryPropose()
+}
+
+// =============================================================================
+// pacemaker
+// =============================================================================
+
+// advanceView moves to the given view and restarts view timer.
+func (hs *hotstuffImpl[T, Constraint]) advanceView(view uint64) {
+	if view > hs.chainConfig.View {
+		hs.setView(view)
+		hs.viewTimeoutMultiple = 1
+	}
+	hs.startViewTimer()
+	hs.tryPropose()
+}
+
+func (hs *hotstuffImpl[T, Constraint]) startViewTimer() {
+	timeout := hs.timerMgr.getTimeoutValue(hotstuffViewTimer) * hs.viewTimeoutMultiple
+	hs.timerMgr.startTimerWithNewTT(hotstuffViewTimer, timeout, &LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreHotStuffViewTimerEvent,
+		Event:     hs.chainConfig.View,
+	})
+}
+
+// handleViewTimeout moves to next view and sends the highQC to its leader if current view doesn't
+// make progress while there are txs or blocks waiting for commit.
+func (hs *hotstuffImpl[T, Constraint]) handleViewTimeout(view uint64) {
+	if view != hs.chainConfig.View {
+		return
+	}
+	// the replica asked first may not have the missing blocks, fetch them from all replicas.
+	for hash, v := range hs.fetchingBlocks {
+		hs.fetchBlock(context.TODO(), hash, v, 0)
+	}
+	if !hs.requestPool.HasPendingRequestInPool() && !hs.hasPendingBlock(hs.highQC.BlockHash) {
+		// idle, check again later
+		hs.startViewTimer()
+		return
+	}
+
+	next := view + 1
+	hs.logger.Infof("Replica %d view %d timeout, move to view %d", hs.chainConfig.SelfID, view, next)
+	hs.setView(next)
+	if hs.viewTimeoutMultiple < maxHotStuffViewTimeoutMultiple {
+		hs.viewTimeoutMultiple *= 2
+	}
+	hs.startViewTimer()
+
+	if !hs.chainConfig.isValidator() {
+		return
+	}
+	nv := &consensus.HotStuffNewView{
+		ReplicaId: hs.chainConfig.SelfID,
+		View:      next,
+		HighQc:    hs.highQC,
+	}
+	leader := hs.leaderOf(next)
+	if leader == hs.chainConfig.SelfID {
+		hs.recvNewView(nv)
+		return
+	}
+	payload, err := nv.MarshalVTStrict()
+	if err != nil {
+		hs.logger.Errorf("Marshal HotStuffNewView failed: %s", err)
+		return
+	}
+	hs.peerMgr.unicast(context.TODO(), &consensus.ConsensusMessage{
+		Type:    consensus.Type_HOTSTUFF_NEW_VIEW,
+		Payload: payload,
+	}, leader)
+}
+
+// recvNewView collects new views as the leader of the given view.
+func (hs *hotstuffImpl[T, Constraint]) recvNewView(nv *consensus.HotStuffNewView) {
+	if nv.HighQc == nil || !hs.isLeader(nv.View) || nv.View < hs.chainConfig.View {
+		return
+	}
+	if !hs.chainConfig.CheckValidator(nv.ReplicaId) {
+		return
+	}
+	if err := hs.verifyQC(nv.HighQc); err != nil {
+		hs.logger.Warningf("Replica %d received new view with invalid QC from %d: %s", hs.chainConfig.SelfID, nv.ReplicaId, err)
+		return
+	}
+	hs.updateQC(nv.HighQc)
+	if _, ok := hs.newViews[nv.View]; !ok {
+		hs.newViews[nv.View] = make(map[uint64]*consensus.HotStuffQC)
+	}
+	hs.newViews[nv.View][nv.ReplicaId] = nv.HighQc
+	if len(hs.newViews[nv.View]) >= hs.commonCaseQuorum() {
+		hs.logger.Infof("Replica %d received new view quorum for view %d", hs.chainConfig.SelfID, nv.View)
+		hs.advanceView(nv.View)
+	}
+}
+
+// =============================================================================
+// signature
+// =============================================================================
+
+func (hs *hotstuffImpl[T, Constraint]) calculateVoteHash(v, height uint64, hash string) ([]byte, error) {
+	unsigned := &consensus.HotStuffVote{
+		View:      v,
+		Height:    height,
+		BlockHash: hash,
+	}
+	raw, err := unsigned.MarshalVTStrict()
+	if err != nil {
+		return nil, err
+	}
+	hasher := sha3.NewLegacyKeccak256()
+	_, err = hasher.Write(binary.BigEndian.AppendUint32(nil, uint32(consensus.Type_HOTSTUFF_VOTE)))
+	if err != nil {
+		return nil, err
+	}
+	_, err = hasher.Write(raw)
+	if err != nil {
+		return nil, err
+	}
+	return hasher.Sum(nil), nil
+}
+
+func (hs *hotstuffImpl[T, Constraint]) signVote(v, height uint64, hash string) ([]byte, error) {
+	msg, err := hs.calculateVoteHash(v, height, hash)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := hs.external.Sign(msg)
+	if err != nil {
+		hs.logger.Warningf("Replica %d sign vote failed: %s", hs.chainConfig.SelfID, err)
+		hs.stopNamespace()
+		return nil, err
+	}
+	return sig, nil
+}
+
+func (hs *hotstuffImpl[T, Constraint]) verifyVote(author uint64, sig []byte, v, height uint64, hash string) error {
+	msg, err := hs.calculateVoteHash(v, height, hash)
+	if err != nil {
+		return err
+	}
+	return hs.verifySignature(author, sig, msg)
+}
+
+// verifySignature verifies the signature of given validator with any key accepted currently.
+func (hs *hotstuffImpl[T, Constraint]) verifySignature(author uint64, sig []byte, msg []byte) error {
+	return hs.keys.verify(hs.external, hs.keyRotator, author, sig, msg)
+}
+
+// activateKeys activates the validator keys carried by stable checkpoint h.
+func (hs *hotstuffImpl[T, Constraint]) activateKeys(h uint64, keys []*consensus.ValidatorInfo) {
+	if hs.keyRotator == nil {
+		return
+	}
+	period := hs.chainConfig.EpochInfo.ConsensusParams.CheckpointPeriod
+	activated, _ := hs.keys.activate(h, period, keys)
+	for _, key := range activated {
+		hs.logger.Noticef("Replica %d activate key version %d of replica %d at checkpoint %d",
+			hs.chainConfig.SelfID, key.KeyVersion, key.Id, h)
+		if key.Id == hs.chainConfig.SelfID {
+			if err := hs.keyRotator.ActivateKey(key.KeyVersion, key.PubKey); err != nil {
+				hs.logger.Errorf("Replica %d activate key version %d failed: %s", hs.chainConfig.SelfID, key.KeyVersion, err)
+			}
+		}
+	}
+}
+
+// verifyQC verifies the signatures of a QC, genesis QC of current epoch needs no signature.
+func (hs *hotstuffImpl[T, Constraint]) verifyQC(qc *consensus.HotStuffQC) error {
+	if qc.View == 0 {
+		if qc.BlockHash != hs.genesis.Hash {
+			return errors.New("mismatch genesis QC")
+		}
+		return nil
+	}
+	valid := 0
+	for id, sig := range qc.Signatures {
+		if !hs.chainConfig.CheckValidator(id) {
+			continue
+		}
+		if err := hs.verifyVote(id, sig, qc.View, qc.Height, qc.BlockHash); err != nil {
+			return errors.WithMessagef(err, "invalid signature from %d", id)
+		}
+		valid++
+	}
+	if valid < hs.commonCaseQuorum() {
+		return fmt.Errorf("not enough signatures: %d", valid)
+	}
+	return nil
+}
+
+// =============================================================================
+// missing txs
+// =============================================================================
+
+func (hs *hotstuffImpl[T, Constraint]) fetchMissingTxs(ctx context.Context, b *consensus.HotStuffBlock, missing map[uint64]string) {
+	fetch := &consensus.FetchMissingRequest{
+		ReplicaId:            hs.chainConfig.SelfID,
+		View:                 b.View,
+		SequenceNumber:       b.Height,
+		BatchDigest:          b.BatchDigest,
+		MissingRequestHashes: missing,
+	}
+	payload, err := fetch.MarshalVTStrict()
+	if err != nil {
+		hs.logger.Errorf("Marshal FetchMissingRequest failed: %s", err)
+		return
+	}
+	hs.logger.Debugf("Replica %d fetch %d missing txs of block %s from %d", hs.chainConfig.SelfID, len(missing), b.ID(), b.Proposer)
+	hs.metrics.fetchMissingTxsCounter.Add(float64(1))
+	hs.peerMgr.unicast(ctx, &consensus.ConsensusMessage{
+		Type:    consensus.Type_FETCH_MISSING_REQUEST,
+		Payload: payload,
+	}, b.Proposer)
+}
+
+func (hs *hotstuffImpl[T, Constraint]) recvFetchMissingRequest(ctx context.Context, fetch *consensus.FetchMissingRequest) {
+	re := &consensus.FetchMissingResponse{
+		Status:               consensus.FetchMissingResponse_Failure,
+		ReplicaId:            hs.chainConfig.SelfID,
+		View:                 fetch.View,
+		SequenceNumber:       fetch.SequenceNumber,
+		BatchDigest:          fetch.BatchDigest,
+		MissingRequestHashes: fetch.MissingRequestHashes,
+	}
+	txs, err := hs.requestPool.SendMissingRequests(fetch.BatchDigest, fetch.MissingRequestHashes)
+	if err != nil {
+		hs.logger.Warningf("Replica %d cannot find missing txs of %s: %s", hs.chainConfig.SelfID, fetch.BatchDigest, err)
+	} else {
+		re.MissingRequests = make(map[uint64][]byte, len(txs))
+		for i, tx := range txs {
+			if re.MissingRequests[i], err = Constraint(tx).RbftMarshal(); err != nil {
+				hs.logger.Errorf("Tx marshal Error: %s", err)
+				return
+			}
+		}
+		re.Status = consensus.FetchMissingResponse_Success
+	}
+	payload, err := re.MarshalVTStrict()
+	if err != nil {
+		hs.logger.Errorf("Marshal FetchMissingResponse failed: %s", err)
+		return
+	}
+	hs.peerMgr.unicast(ctx, &consensus.ConsensusMessage{
+		Type:    consensus.Type_FETCH_MISSING_RESPONSE,
+		Payload: payload,
+	}, fetch.ReplicaId)
+}
+
+func (hs *hotstuffImpl[T, Constraint]) recvFetchMissingResponse(ctx context.Context, re *consensus.FetchMissingResponse) {
+	proposal, ok := hs.pendingProposals[re.BatchDigest]
+	if !ok || re.Status != consensus.FetchMissingResponse_Success || re.ReplicaId != proposal.Block.Proposer {
+		return
+	}
+	if len(re.MissingRequests) != len(re.MissingRequestHashes) {
+		hs.logger.Warningf("Replica %d received mismatch length fetchMissingResponse", hs.chainConfig.SelfID)
+		return
+	}
+	txs := make(map[uint64]*T, len(re.MissingRequests))
+	for i, raw := range re.MissingRequests {
+		var tx T
+		if err := Constraint(&tx).RbftUnmarshal(raw); err != nil {
+			hs.logger.Errorf("Tx unmarshal Error: %s", err)
+			return
+		}
+		txs[i] = &tx
+	}
+	if err := hs.requestPool.ReceiveMissingRequests(re.BatchDigest, txs); err != nil {
+		hs.logger.Warningf("Replica %d find something wrong with fetchMissingResponse: %v", hs.chainConfig.SelfID, err)
+		return
+	}
+	delete(hs.pendingProposals, re.BatchDigest)
+	hs.recvProposal(ctx, proposal)
+}
+
+// =============================================================================
+// block sync
+// =============================================================================
+
+// fetchBlock fetches the missing block with the given hash certified in the given view from the given
+// replica, or from all replicas if to is 0.
+func (hs *hotstuffImpl[T, Constraint]) fetchBlock(ctx context.Context, hash string, view uint64, to uint64) {
+	hs.fetchingBlocks[hash] = view
+	fetch := &consensus.HotStuffFetchBlock{
+		ReplicaId: hs.chainConfig.SelfID,
+		BlockHash: hash,
+	}
+	payload, err := fetch.MarshalVTStrict()
+	if err != nil {
+		hs.logger.Errorf("Marshal HotStuffFetchBlock failed: %s", err)
+		return
+	}
+	msg := &consensus.ConsensusMessage{
+		Type:    consensus.Type_HOTSTUFF_FETCH_BLOCK,
+		Payload: payload,
+	}
+	hs.logger.Debugf("Replica %d fetch block %s from %d", hs.chainConfig.SelfID, hash, to)
+	if to == 0 {
+		hs.peerMgr.broadcast(ctx, msg)
+		return
+	}
+	hs.peerMgr.unicast(ctx, msg, to)
+}
+
+func (hs *hotstuffImpl[T, Constraint]) recvFetchBlock(ctx context.Context, fetch *consensus.HotStuffFetchBlock) {
+	b, ok := hs.blocks[fetch.BlockHash]
+	// blocks of view 0 are built on local state.
+	if !ok || b.View == 0 {
+		return
+	}
+	re := &consensus.HotStuffFetchBlockResponse{
+		ReplicaId: hs.chainConfig.SelfID,
+		Block:     b,
+	}
+	payload, err := re.MarshalVTStrict()
+	if err != nil {
+		hs.logger.Errorf("Marshal HotStuffFetchBlockResponse failed: %s", err)
+		return
+	}
+	hs.peerMgr.unicast(ctx, &consensus.ConsensusMessage{
+		Type:    consensus.Type_HOTSTUFF_FETCH_BLOCK_RESPONSE,
+		Payload: payload,
+	}, fetch.ReplicaId)
+}
+
+// recvFetchBlockResponse processes a fetched block, which is only accepted if it's being fetched as its
+// hash is certified by a verified QC. A fetched block within the executed height whose parent is missing
+// replaces the detached root, as its txs have been executed before restart or by state update.
+func (hs *hotstuffImpl[T, Constraint]) recvFetchBlockResponse(ctx context.Context, re *consensus.HotStuffFetchBlockResponse) {
+	b := re.GetBlock()
+	if b == nil || b.Justify == nil {
+		return
+	}
+	if _, ok := hs.fetchingBlocks[b.Hash]; !ok || b.Hash != b.CalculateHash() {
+		return
+	}
+	delete(hs.fetchingBlocks, b.Hash)
+	hs.logger.Debugf("Replica %d received block %s from %d", hs.chainConfig.SelfID, b.ID(), re.ReplicaId)
+
+	if _, ok := hs.blocks[b.ParentHash]; !ok && b.Height <= hs.lastExec {
+		if !hs.detachedRoot {
+			hs.logger.Warningf("Replica %d ignore block %s which doesn't extend the committed blocks",
+				hs.chainConfig.SelfID, b.ID())
+			return
+		}
+		hs.logger.Noticef("Replica %d replace detached root %s with block %s on executed height %d",
+			hs.chainConfig.SelfID, hs.root.ID(), b.ID(), hs.lastExec)
+		hs.blocks[b.Hash] = b
+		hs.root = b
+		hs.pruneBlocks()
+		hs.processOrphans(ctx, b.Hash)
+	} else {
+		hs.recvProposal(ctx, &consensus.HotStuffProposal{
+			ReplicaId: b.Proposer,
+			Block:     b,
+		})
+	}
+	hs.tryPropose()
+}
+
+// =============================================================================
+// checkpoint, state update and epoch change
+// =============================================================================
+
+// checkpoint signs and broadcasts a checkpoint of the executed state.
+func (hs *hotstuffImpl[T, Constraint]) checkpoint(state *types.ServiceState) {
+	height := state.MetaState.Height
+	if _, ok := hs.localCheckpoints[height]; ok || height <= hs.chainConfig.H {
+		return
+	}
+	checkpoint := &consensus.Checkpoint{
+		Epoch: hs.chainConfig.EpochInfo.Epoch,
+		ExecuteState: &consensus.Checkpoint_ExecuteState{
+			Height:      height,
+			Digest:      state.MetaState.Digest,
+			BatchDigest: hs.seqMap[height],
+		},
+		NeedUpdateEpoch: isConfigBatch(height, hs.chainConfig.EpochInfo),
+	}
+	if hs.keyRotator != nil {
+		checkpoint.ValidatorKeys = hs.keys.checkpointKeys()
+	}
+	sig, err := hs.external.Sign(checkpoint.Hash())
+	if err != nil {
+		hs.logger.Errorf("Replica %d sign checkpoint error: %s", hs.chainConfig.SelfID, err)
+		hs.stopNamespace()
+		return
+	}
+	signedCheckpoint := &consensus.SignedCheckpoint{
+		Checkpoint: checkpoint,
+		Author:     hs.chainConfig.SelfID,
+		Signature:  sig,
+	}
+	hs.localCheckpoints[height] = signedCheckpoint
+	hs.logger.Infof("Replica %d generated signed checkpoint %s", hs.chainConfig.SelfID, checkpoint.Pretty())
+
+	payload, err := signedCheckpoint.MarshalVTStrict()
+	if err != nil {
+		hs.logger.Errorf("Marshal SignedCheckpoint failed: %s", err)
+		return
+	}
+	hs.peerMgr.broadcast(context.TODO(), &consensus.ConsensusMessage{
+		Type:    consensus.Type_SIGNED_CHECKPOINT,
+		Payload: payload,
+	})
+	hs.recvCheckpoint(signedCheckpoint)
+}
+
+// recvCheckpoint collects checkpoints, the local checkpoint becomes stable once a quorum of same checkpoints
+// is collected, and state update is triggered once self falls behind a weak quorum by a whole checkpoint period.
+func (hs *hotstuffImpl[T, Constraint]) recvCheckpoint(signedCheckpoint *consensus.SignedCheckpoint) {
+	height := signedCheckpoint.Height()
+	if height <= hs.chainConfig.H || signedCheckpoint.Epoch() != hs.chainConfig.EpochInfo.Epoch {
+		return
+	}
+	if !hs.chainConfig.CheckValidator(signedCheckpoint.Author) {
+		return
+	}
+	if err := hs.verifySignature(signedCheckpoint.Author, signedCheckpoint.Signature, signedCheckpoint.Checkpoint.Hash()); err != nil {
+		hs.logger.Warningf("Replica %d received checkpoint with invalid signature from %d: %s", hs.chainConfig.SelfID, signedCheckpoint.Author, err)
+		return
+	}
+	if _, ok := hs.checkpointStore[height]; !ok {
+		hs.checkpointStore[height] = make(map[uint64]*consensus.SignedCheckpoint)
+	}
+	hs.checkpointStore[height][signedCheckpoint.Author] = signedCheckpoint
+
+	matching := lo.Filter(lo.Values(hs.checkpointStore[height]), func(item *consensus.SignedCheckpoint, _ int) bool {
+		return item.Checkpoint.Equals(signedCheckpoint.Checkpoint)
+	})
+	local, ok := hs.localCheckpoints[height]
+	if !ok {
+		checkpointPeriod := hs.chainConfig.EpochInfo.ConsensusParams.CheckpointPeriod
+		if len(matching) >= hs.oneCorrectQuorum() && height >= hs.lastExec+checkpointPeriod &&
+			!hs.status.atomicHasBit(StateTransferring) {
+			hs.logger.Noticef("Replica %d falls behind checkpoint %s, lastExec %d", hs.chainConfig.SelfID,
+				signedCheckpoint.Checkpoint.Pretty(), hs.lastExec)
+			hs.stateUpdate(height, signedCheckpoint.Checkpoint.Digest(), matching)
+		}
+		return
+	}
+	if !local.Checkpoint.Equals(signedCheckpoint.Checkpoint) || len(matching) < hs.commonCaseQuorum() {
+		return
+	}
+
+	hs.logger.Infof("Replica %d found stable checkpoint %s", hs.chainConfig.SelfID, local.Checkpoint.Pretty())
+	hs.hLock.Lock()
+	hs.chainConfig.H = height
+	hs.hLock.Unlock()
+	hs.activateKeys(height, local.Checkpoint.ValidatorKeys)
+	for h := range hs.checkpointStore {
+		if h <= height {
+			delete(hs.checkpointStore, h)
+		}
+	}
+	for h := range hs.localCheckpoints {
+		if h < height {
+			delete(hs.localCheckpoints, h)
+		}
+	}
+	for h := range hs.seqMap {
+		if h < height {
+			delete(hs.seqMap, h)
+		}
+	}
+
+	if local.Checkpoint.NeedUpdateEpoch {
+		hs.external.SendFilterEvent(types.InformTypeFilterStableCheckpoint, matching)
+		hs.epochMgr.persistEpochQuorumCheckpoint(&consensus.QuorumCheckpoint{
+			Checkpoint: local.Checkpoint,
+			Signatures: lo.SliceToMap(matching, func(item *consensus.SignedCheckpoint) (uint64, []byte) {
+				return item.Author, item.Signature
+			}),
+			ValidatorSet: hs.quorumValidatorSet(),
+		})
+		hs.turnIntoEpoch(height, local.Checkpoint.Digest())
+	}
+}
+
+func (hs *hotstuffImpl[T, Constraint]) quorumValidatorSet() map[uint64]*consensus.ValidatorInfo {
+	validatorSet := make(map[uint64]*consensus.ValidatorInfo, len(hs.chainConfig.ValidatorSet))
+	for id := range hs.chainConfig.ValidatorSet {
+		nodeInfo, err := hs.chainConfig.getNodeInfo(id)
+		if err != nil {
+			hs.logger.Errorf("Replica %d not found node info with id %d", hs.chainConfig.SelfID, id)
+			continue
+		}
+		key := hs.keys.get(id)
+		validatorSet[id] = &consensus.ValidatorInfo{
+			Id:         id,
+			P2PId:      nodeInfo.P2PNodeID,
+			KeyVersion: key.KeyVersion,
+			PubKey:     key.PubKey,
+		}
+	}
+	return validatorSet
+}
+
+// stateUpdate asks application to catch up to the given height.
+func (hs *hotstuffImpl[T, Constraint]) stateUpdate(height uint64, digest string, checkpoints []*consensus.SignedCheckpoint, epochChanges ...*consensus.EpochChange) {
+	hs.status.atomicSetBit(StateTransferring)
+	hs.metrics.statusGaugeStateTransferring.Set(StateTransferring)
+	hs.metrics.stateUpdateCounter.Add(float64(1))
+	hs.external.StateUpdate(hs.lastExec, height, digest, checkpoints, epochChanges...)
+}
+
+// recvStateUpdatedEvent resets the block tree on the updated state.
+func (hs *hotstuffImpl[T, Constraint]) recvStateUpdatedEvent(ss *types.ServiceSyncState) {
+	hs.status.atomicClearBit(StateTransferring)
+	hs.metrics.statusGaugeStateTransferring.Set(0)
+	if ss.MetaState.Height <= hs.lastExec && !ss.EpochChanged {
+		return
+	}
+
+	finishMsg := fmt.Sprintf("======== Replica %d finished stateUpdate, height: %d", hs.chainConfig.SelfID, ss.MetaState.Height)
+	hs.logger.Noticef(finishMsg)
+	hs.external.SendFilterEvent(types.InformTypeFilterFinishStateUpdate, finishMsg)
+	hs.lastExec = ss.MetaState.Height
+	hs.hLock.Lock()
+	hs.chainConfig.H = ss.MetaState.Height
+	hs.hLock.Unlock()
+
+	epoch := hs.chainConfig.EpochInfo.Epoch
+	if ss.EpochChanged || ss.Epoch != epoch {
+		if ec, ok := hs.epochMgr.epochProofCache[ss.Epoch-1]; ok {
+			hs.epochMgr.persistEpochQuorumCheckpoint(ec.GetCheckpoint())
+		}
+		hs.turnIntoEpoch(ss.MetaState.Height, ss.MetaState.Digest)
+		hs.status.atomicClearBit(InEpochSyncing)
+	}
+	// blocks in tree may have been executed by state update, restart the tree from the updated state.
+	if hs.chainConfig.EpochInfo.Epoch == epoch {
+		hs.rebuildBlockTree(ss.MetaState.Height, ss.MetaState.Digest)
+	}
+}
+
+// epochSync syncs to the last epoch change of the given proof. Keys rotated in each epoch change are
+// learned after its signatures are verified, which are used to verify the next one.
+func (hs *hotstuffImpl[T, Constraint]) epochSync(proof *consensus.EpochChangeProof) {
+	quorumCheckpoint := proof.Last().Checkpoint
+	for _, ec := range proof.GetEpochChanges() {
+		hs.epochMgr.cacheEpochProof(ec)
+	}
+	var checkpointSet []*consensus.SignedCheckpoint
+	for _, ec := range proof.GetEpochChanges() {
+		qc := ec.GetCheckpoint()
+		for id, sig := range qc.Signatures {
+			if err := hs.verifySignature(id, sig, qc.Hash()); err != nil {
+				hs.logger.Errorf("Replica %d verify checkpoint error: %s", hs.chainConfig.SelfID, err)
+				return
+			}
+			if qc == quorumCheckpoint {
+				checkpointSet = append(checkpointSet, &consensus.SignedCheckpoint{
+					Checkpoint: quorumCheckpoint.Checkpoint,
+					Signature:  sig,
+					Author:     id,
+				})
+			}
+		}
+		hs.activateKeys(qc.Checkpoint.Height(), qc.Checkpoint.GetValidatorKeys())
+	}
+	hs.logger.Noticef("Replica %d try epoch sync to height %d, epoch %d", hs.chainConfig.SelfID,
+		quorumCheckpoint.Height(), quorumCheckpoint.NextEpoch())
+	hs.status.atomicSetBit(InEpochSyncing)
+	hs.stateUpdate(quorumCheckpoint.Height(), quorumCheckpoint.Digest(), checkpointSet, proof.GetEpochChanges()...)
+}
+
+// turnIntoEpoch loads the latest epoch from ledger and restarts the block tree from the config block.
+func (hs *hotstuffImpl[T, Constraint]) turnIntoEpoch(height uint64, digest string) {
+	newEpoch, err := hs.external.GetCurrentEpochInfo()
+	if err != nil {
+		hs.logger.Errorf("Replica %d failed to get current epoch from ledger: %v", hs.chainConfig.SelfID, err)
+		hs.stopNamespace()
+		return
+	}
+	newValidatorSet, err := hs.external.GetValidatorSet()
+	if err != nil {
+		hs.logger.Errorf("Replica %d failed to get new validator set from ledger: %v", hs.chainConfig.SelfID, err)
+		hs.stopNamespace()
+		return
+	}
+	if newEpoch.Epoch == hs.chainConfig.EpochInfo.Epoch {
+		hs.logger.Debugf("Replica %d don't change epoch as epoch %d has not been changed",
+			hs.chainConfig.SelfID, newEpoch.Epoch)
+		return
+	}
+
+	_ = hs.storage.DelState(hs.votedViewKey())
+	_ = hs.storage.DelState(hs.lockedQCKey())
+	_ = hs.storage.DelState(hs.genesisKey())
+	_ = hs.storage.DelState(hs.rootKey())
+	if err = hs.updateEpochInfo(newEpoch, newValidatorSet); err != nil {
+		hs.stopNamespace()
+		return
+	}
+	for epoch := range hs.epochMgr.epochProofCache {
+		if epoch < newEpoch.Epoch {
+			delete(hs.epochMgr.epochProofCache, epoch)
+		}
+	}
+	hs.resetBlockTree(height, digest)
+	hs.localCheckpoints = make(map[uint64]*consensus.SignedCheckpoint)
+	hs.checkpointStore = make(map[uint64]map[uint64]*consensus.SignedCheckpoint)
+	hs.metrics.clusterSizeGauge.Set(float64(hs.chainConfig.N))
+	hs.metrics.quorumSizeGauge.Set(float64(hs.commonCaseQuorum()))
+	hs.logger.Noticef("======== Replica %d turn into a new epoch, epoch=%d/N=%d/height=%d",
+		hs.chainConfig.SelfID, hs.chainConfig.EpochInfo.Epoch, hs.chainConfig.N, height)
+
+	hs.startViewTimer()
+	hs.tryPropose()
+}
+
+func (hs *hotstuffImpl[T, Constraint]) updateEpochInfo(epochInfo *kittypes.EpochInfo, validatorSet map[uint64]int64) error {
+	hs.epochLock.Lock()
+	defer hs.epochLock.Unlock()
+	hs.chainConfig.EpochInfo = epochInfo
+	hs.epochMgr.epoch = epochInfo.Epoch
+	if err := hs.chainConfig.updateDerivedData(validatorSet); err != nil {
+		hs.logger.Criticalf("Replica %d failed to check epoch info for epoch %d from ledger: %v", hs.chainConfig.SelfID, epochInfo.Epoch, err)
+		return err
+	}
+	hs.validators = lo.Keys(validatorSet)
+	sort.Slice(hs.validators, func(i, j int) bool {
+		return hs.validators[i] < hs.validators[j]
+	})
+	if hs.seqMap == nil {
+		hs.seqMap = make(map[uint64]string)
+		hs.localCheckpoints = make(map[uint64]*consensus.SignedCheckpoint)
+		hs.checkpointStore = make(map[uint64]map[uint64]*consensus.SignedCheckpoint)
+	}
+	hs.metrics.epochGauge.Set(float64(epochInfo.Epoch))
+	return nil
+}
+
+// =============================================================================
+// persistence
+// =============================================================================
+
+func (hs *hotstuffImpl[T, Constraint]) votedViewKey() string {
+	return fmt.Sprintf("hotstuff.voted.%d", hs.chainConfig.EpochInfo.Epoch)
+}
+
+func (hs *hotstuffImpl[T, Constraint]) lockedQCKey() string {
+	return fmt.Sprintf("hotstuff.locked.%d", hs.chainConfig.EpochInfo.Epoch)
+}
+
+func (hs *hotstuffImpl[T, Constraint]) genesisKey() string {
+	return fmt.Sprintf("hotstuff.genesis.%d", hs.chainConfig.EpochInfo.Epoch)
+}
+
+func (hs *hotstuffImpl[T, Constraint]) rootKey() string {
+	return fmt.Sprintf("hotstuff.root.%d", hs.chainConfig.EpochInfo.Epoch)
+}
+
+// persistVotedView persists last voted view to avoid voting twice in one view after restart.
+func (hs *hotstuffImpl[T, Constraint]) persistVotedView() {
+	if err := hs.storage.StoreState(hs.votedViewKey(), binary.BigEndian.AppendUint64(nil, hs.lastVotedView)); err != nil {
+		hs.logger.Errorf("Persist voted view failed with err: %s ", err)
+	}
+}
+
+// persistLockedQC persists locked QC to keep the lock after restart.
+func (hs *hotstuffImpl[T, Constraint]) persistLockedQC() {
+	raw, err := hs.lockedQC.MarshalVTStrict()
+	if err != nil {
+		hs.logger.Warningf("Replica %d could not persist locked QC: %s", hs.chainConfig.SelfID, err)
+		return
+	}
+	if err = hs.storage.StoreState(hs.lockedQCKey(), raw); err != nil {
+		hs.logger.Errorf("Persist locked QC failed with err: %s ", err)
+	}
+}
+
+// loadGenesis returns the genesis block of current epoch which is the same on all replicas no matter
+// when they join the epoch: it's built on the config block of last epoch, or on the given executed state
+// in the first epoch, and persisted to verify the genesis QC after restart.
+func (hs *hotstuffImpl[T, Constraint]) loadGenesis(height uint64, digest string) *consensus.HotStuffBlock {
+	if raw, err := hs.storage.ReadState(hs.genesisKey()); err == nil && len(raw) != 0 {
+		genesis := &consensus.HotStuffBlock{}
+		if err = genesis.UnmarshalVT(raw); err == nil {
+			return genesis
+		}
+	}
+	if epoch := hs.chainConfig.EpochInfo.Epoch; epoch > hs.config.GenesisEpochInfo.Epoch {
+		if c, err := hs.epochMgr.getEpochQuorumCheckpoint(epoch - 1); err == nil {
+			height, digest = c.Checkpoint.Height(), c.Checkpoint.Digest()
+		}
+	}
+	genesis := newHotStuffGenesis(height, digest)
+	raw, err := genesis.MarshalVTStrict()
+	if err != nil {
+		hs.logger.Warningf("Replica %d could not persist genesis block: %s", hs.chainConfig.SelfID, err)
+		return genesis
+	}
+	if err = hs.storage.StoreState(hs.genesisKey(), raw); err != nil {
+		hs.logger.Errorf("Persist genesis block failed with err: %s ", err)
+	}
+	return genesis
+}
+
+// persistRoot persists the latest committed block to restart the block tree from it.
+func (hs *hotstuffImpl[T, Constraint]) persistRoot() {
+	raw, err := hs.root.MarshalVTStrict()
+	if err != nil {
+		hs.logger.Warningf("Replica %d could not persist root: %s", hs.chainConfig.SelfID, err)
+		return
+	}
+	if err = hs.storage.StoreState(hs.rootKey(), raw); err != nil {
+		hs.logger.Errorf("Persist root failed with err: %s ", err)
+	}
+}
+
+// restoreRoot restores the persisted root if it's on the given executed height.
+func (hs *hotstuffImpl[T, Constraint]) restoreRoot(height uint64) *consensus.HotStuffBlock {
+	raw, err := hs.storage.ReadState(hs.rootKey())
+	if err != nil || len(raw) == 0 {
+		return nil
+	}
+	root := &consensus.HotStuffBlock{}
+	if err = root.UnmarshalVT(raw); err != nil || root.Height != height {
+		return nil
+	}
+	return root
+}
+
+// restoreSafetyState restores last voted view and locked QC of current epoch.
+func (hs *hotstuffImpl[T, Constraint]) restoreSafetyState() {
+	if raw, err := hs.storage.ReadState(hs.votedViewKey()); err == nil && len(raw) == 8 {
+		hs.lastVotedView = binary.BigEndian.Uint64(raw)
+		hs.proposedView = hs.lastVotedView
+		hs.setView(hs.lastVotedView + 1)
+	}
+	if raw, err := hs.storage.ReadState(hs.lockedQCKey()); err == nil && len(raw) != 0 {
+		qc := &consensus.HotStuffQC{}
+		if err = qc.UnmarshalVT(raw); err == nil {
+			hs.lockedQC = qc
+		}
+	}
+	hs.logger.Infof("Replica %d restore HotStuff voted view %d, locked QC view %d", hs.chainConfig.SelfID,
+		hs.lastVotedView, hs.lockedQC.View)
+}
+
+// stopNamespace stops the namespace when there is a non-recoverable error.
+func (hs *hotstuffImpl[T, Constraint]) stopNamespace() {
+	defer func() {
+		// delFlag channel might be closed by other modules at the same time
+		// consensus requests to stop namespace
+		if err := recover(); err != nil {
+			hs.logger.Warningf("Replica %d stops namespace error: %s", hs.chainConfig.SelfID, err)
+		}
+	}()
+
+	hs.logger.Criticalf("Replica %d requests to stop namespace", hs.chainConfig.SelfID)
+	hs.config.DelFlag <- true
+}
@@ -0,0 +1,342 @@
+package rbft
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/axiomesh/axiom-bft/common"
+	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-bft/common/metrics/disabled"
+	"github.com/axiomesh/axiom-bft/types"
+	"github.com/axiomesh/axiom-kit/txpool/mock_txpool"
+	kittypes "github.com/axiomesh/axiom-kit/types"
+)
+
+// hotstuffTestCluster routes messages between chained HotStuff nodes in memory.
+type hotstuffTestCluster struct {
+	nodes   map[uint64]*hotstuffNode[consensus.FltTransaction, *consensus.FltTransaction]
+	pools   map[uint64]*mock_txpool.MockMinimalTxPool[consensus.FltTransaction, *consensus.FltTransaction]
+	offline map[uint64]bool
+
+	lock     sync.Mutex
+	executed map[uint64]map[uint64][]string
+}
+
+func (c *hotstuffTestCluster) deliver(ctx context.Context, to uint64, msg *consensus.ConsensusMessage) {
+	if c.offline[to] || c.offline[msg.From] {
+		return
+	}
+	c.nodes[to].Step(ctx, msg.CloneVT())
+}
+
+func (c *hotstuffTestCluster) executedHashes(id, height uint64) []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.executed[id][height]
+}
+
+func newHotStuffTestCluster(t *testing.T, offline ...uint64) *hotstuffTestCluster {
+	ctrl := gomock.NewController(t)
+	c := &hotstuffTestCluster{
+		nodes:    make(map[uint64]*hotstuffNode[consensus.FltTransaction, *consensus.FltTransaction]),
+		pools:    make(map[uint64]*mock_txpool.MockMinimalTxPool[consensus.FltTransaction, *consensus.FltTransaction]),
+		offline:  make(map[uint64]bool),
+		executed: make(map[uint64]map[uint64][]string),
+	}
+	for _, id := range offline {
+		c.offline[id] = true
+	}
+	epochInfo := &kittypes.EpochInfo{
+		Epoch:       1,
+		EpochPeriod: 1000,
+		StartBlock:  1,
+		ConsensusParams: kittypes.ConsensusParams{
+			ProposerElectionType:          ProposerElectionTypeAbnormalRotation,
+			CheckpointPeriod:              2,
+			HighWatermarkCheckpointPeriod: 4,
+			MaxValidatorNum:               10,
+			BlockMaxTxNum:                 500,
+			NotActiveWeight:               1,
+			AbnormalNodeExcludeView:       10,
+			AgainProposeIntervalBlockInValidatorsNumPercentage: 30,
+		},
+	}
+
+	for id := uint64(1); id <= 4; id++ {
+		id := id
+		c.executed[id] = make(map[uint64][]string)
+		external := NewMockExternalStack[consensus.FltTransaction, *consensus.FltTransaction](ctrl)
+		external.EXPECT().StoreState(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		external.EXPECT().DelState(gomock.Any()).Return(nil).AnyTimes()
+		external.EXPECT().ReadState(gomock.Any()).Return(nil, errors.New("ReadState Error")).AnyTimes()
+		external.EXPECT().StoreEpochState(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		external.EXPECT().ReadEpochState(gomock.Any()).Return(nil, errors.New("ReadEpochState Error")).AnyTimes()
+		external.EXPECT().Sign(gomock.Any()).Return([]byte("sig"), nil).AnyTimes()
+		external.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		external.EXPECT().SendFilterEvent(gomock.Any(), gomock.Any()).Return().AnyTimes()
+		external.EXPECT().StateUpdate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return().AnyTimes()
+		external.EXPECT().GetBlockMeta(gomock.Any()).Return(&types.BlockMeta{}, nil).AnyTimes()
+		external.EXPECT().GetEpochInfo(gomock.Any()).Return(epochInfo, nil).AnyTimes()
+		external.EXPECT().GetCurrentEpochInfo().Return(epochInfo, nil).AnyTimes()
+		external.EXPECT().GetValidatorSet().Return(map[uint64]int64{1: 1000, 2: 1000, 3: 1000, 4: 1000}, nil).AnyTimes()
+		external.EXPECT().GetNodeInfo(gomock.Any()).DoAndReturn(func(nodeID uint64) (*NodeInfo, error) {
+			return &NodeInfo{ID: nodeID, P2PNodeID: "node" + strconv.Itoa(int(nodeID))}, nil
+		}).AnyTimes()
+		external.EXPECT().GetNodeIDByP2PID(gomock.Any()).DoAndReturn(func(p2pID string) (uint64, error) {
+			nodeID, err := strconv.Atoi(strings.TrimPrefix(p2pID, "node"))
+			return uint64(nodeID), err
+		}).AnyTimes()
+		external.EXPECT().Broadcast(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, msg *consensus.ConsensusMessage) error {
+			for to := range c.nodes {
+				if to != id {
+					c.deliver(ctx, to, msg)
+				}
+			}
+			return nil
+		}).AnyTimes()
+		external.EXPECT().Unicast(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, msg *consensus.ConsensusMessage, to string) error {
+			toID, err := strconv.Atoi(strings.TrimPrefix(to, "node"))
+			if err != nil {
+				return err
+			}
+			c.deliver(ctx, uint64(toID), msg)
+			return nil
+		}).AnyTimes()
+		external.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Do(
+			func(txs []*consensus.FltTransaction, _ []bool, seqNo uint64, _ int64, _ uint64) {
+				var hashes []string
+				for _, tx := range txs {
+					hashes = append(hashes, tx.RbftGetTxHash())
+				}
+				c.lock.Lock()
+				c.executed[id][seqNo] = hashes
+				c.lock.Unlock()
+				c.nodes[id].ReportExecuted(&types.ServiceState{
+					MetaState: &types.MetaState{Height: seqNo, Digest: fmt.Sprintf("digest-%d", seqNo)},
+					Epoch:     1,
+				})
+			}).AnyTimes()
+
+		conf := Config{
+			SelfP2PNodeID:    "node" + strconv.Itoa(int(id)),
+			GenesisEpochInfo: epochInfo,
+			LastServiceState: &types.ServiceState{
+				MetaState: &types.MetaState{Digest: "XXX GENESIS"},
+				Epoch:     1,
+			},
+			GetConsensusEngineTypeFn: func(*kittypes.EpochInfo) string {
+				return ConsensusEngineTypeChainedHotStuff
+			},
+			HotStuffViewTimeout: 300 * time.Millisecond,
+			BatchTimeout:        50 * time.Millisecond,
+			Logger:              common.NewSimpleLogger(),
+			MetricsProv:         &disabled.Provider{},
+			DelFlag:             make(chan bool, 10),
+		}
+		pool := mock_txpool.NewMockMinimalTxPool[consensus.FltTransaction, *consensus.FltTransaction](4, ctrl)
+		n, err := NewNode[consensus.FltTransaction, *consensus.FltTransaction](conf, external, pool)
+		require.Nil(t, err)
+		hn, ok := n.(*hotstuffNode[consensus.FltTransaction, *consensus.FltTransaction])
+		require.True(t, ok)
+		c.nodes[id] = hn
+		c.pools[id] = pool
+	}
+
+	for id, n := range c.nodes {
+		require.Nil(t, n.Init())
+		if c.offline[id] {
+			continue
+		}
+		require.Nil(t, n.Start())
+		n.ReportExecuted(&types.ServiceState{MetaState: &types.MetaState{Digest: "XXX GENESIS"}, Epoch: 1})
+	}
+	t.Cleanup(func() {
+		for id, n := range c.nodes {
+			if !c.offline[id] {
+				n.Stop()
+			}
+		}
+	})
+	return c
+}
+
+func (c *hotstuffTestCluster) addTxs(count int) {
+	for i := 0; i < count; i++ {
+		tx := newTx()
+		for id, pool := range c.pools {
+			if !c.offline[id] {
+				_ = pool.AddLocalTx(tx)
+			}
+		}
+	}
+}
+
+func (c *hotstuffTestCluster) assertExecuted(t *testing.T, heights ...uint64) {
+	assert.Eventually(t, func() bool {
+		for id := range c.nodes {
+			if c.offline[id] {
+				continue
+			}
+			for _, h := range heights {
+				if len(c.executedHashes(id, h)) == 0 {
+					return false
+				}
+			}
+		}
+		return true
+	}, 10*time.Second, 20*time.Millisecond)
+
+	for _, h := range heights {
+		var expect []string
+		for id := range c.nodes {
+			if c.offline[id] {
+				continue
+			}
+			if expect == nil {
+				expect = c.executedHashes(id, h)
+			}
+			assert.Equal(t, expect, c.executedHashes(id, h), "node %d executed different txs at height %d", id, h)
+		}
+	}
+}
+
+func TestHotStuff_NewNode(t *testing.T) {
+	c := newHotStuffTestCluster(t)
+	n := c.nodes[1]
+	status := n.Status()
+	assert.Equal(t, uint64(1), status.ID)
+	assert.Equal(t, Normal, int(status.Status))
+	assert.NotNil(t, n.TransferLeadership())
+	assert.False(t, n.ArchiveMode())
+	schedule, err := n.LeaderSchedule(2, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{3, 4, 1, 2}, schedule.Primaries)
+	_, err = n.LeaderSchedule(math.MaxUint64-1, 4)
+	assert.NotNil(t, err)
+	assert.Equal(t, []uint64{1, 2, 3, 4}, n.hotstuff.validators)
+}
+
+func TestHotStuff_CommitTxs(t *testing.T) {
+	c := newHotStuffTestCluster(t)
+	c.addTxs(8)
+	c.assertExecuted(t, 1, 2)
+
+	// checkpoint of height 2 becomes stable on all nodes.
+	assert.Eventually(t, func() bool {
+		for _, n := range c.nodes {
+			if n.GetLowWatermark() != 2 {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestHotStuff_ViewTimeout(t *testing.T) {
+	// node 2 is the leader of view 1.
+	c := newHotStuffTestCluster(t, 2)
+	c.addTxs(4)
+	c.assertExecuted(t, 1)
+	assert.Greater(t, c.nodes[1].Status().View, uint64(2))
+}
+
+func TestHotStuff_FetchBlocksAfterStateUpdate(t *testing.T) {
+	c := newHotStuffTestCluster(t, 4)
+	c.addTxs(4)
+	c.assertExecuted(t, 1)
+	for id := uint64(1); id <= 3; id++ {
+		c.nodes[id].Stop()
+		c.offline[id] = true
+	}
+	leader, hs := c.nodes[1].hotstuff, c.nodes[4].hotstuff
+	defer c.nodes[4].Stop()
+	ctx := context.Background()
+
+	// block tree is restarted from a detached root after state update.
+	hs.recvStateUpdatedEvent(&types.ServiceSyncState{
+		ServiceState: types.ServiceState{
+			MetaState: &types.MetaState{Height: 1, Digest: "digest-1"},
+			Epoch:     1,
+		},
+	})
+	assert.True(t, hs.detachedRoot)
+	assert.Equal(t, uint64(1), hs.root.Height)
+
+	// the latest block arrives before its ancestors, which are fetched until the detached root is replaced.
+	head := leader.blocks[leader.highQC.BlockHash]
+	require.NotEqual(t, leader.root.Hash, head.Hash)
+	hs.recvProposal(ctx, &consensus.HotStuffProposal{ReplicaId: head.Proposer, Block: head})
+	assert.Contains(t, hs.orphanProposals, head.ParentHash)
+	assert.Contains(t, hs.fetchingBlocks, head.ParentHash)
+
+	// only blocks being fetched are accepted.
+	hs.recvFetchBlockResponse(ctx, &consensus.HotStuffFetchBlockResponse{ReplicaId: 1, Block: head})
+	assert.NotContains(t, hs.blocks, head.Hash)
+
+	for len(hs.fetchingBlocks) != 0 {
+		for hash := range hs.fetchingBlocks {
+			require.Contains(t, leader.blocks, hash)
+			hs.recvFetchBlockResponse(ctx, &consensus.HotStuffFetchBlockResponse{ReplicaId: 1, Block: leader.blocks[hash]})
+		}
+	}
+	assert.Contains(t, hs.blocks, head.Hash)
+	assert.True(t, hs.extends(head, hs.root.Hash))
+	assert.Equal(t, uint64(1), hs.root.Height)
+	assert.NotEqual(t, uint64(0), hs.root.View)
+}
+
+func TestHotStuff_epochSyncWithRotatedKeys(t *testing.T) {
+	c := newHotStuffTestCluster(t, 4)
+	hs := c.nodes[4].hotstuff
+	rotator := &testKeyRotator{}
+	hs.keyRotator = rotator
+
+	epochChange := func(epoch, height uint64, keys []*consensus.ValidatorInfo, sign func(id uint64, hash []byte) []byte) *consensus.EpochChange {
+		qc := &consensus.QuorumCheckpoint{
+			Checkpoint: &consensus.Checkpoint{
+				Epoch:           epoch,
+				ExecuteState:    &consensus.Checkpoint_ExecuteState{Height: height, Digest: fmt.Sprintf("digest-%d", height)},
+				NeedUpdateEpoch: true,
+				ValidatorKeys:   keys,
+			},
+			Signatures: make(map[uint64][]byte),
+		}
+		for id := uint64(1); id <= 3; id++ {
+			qc.Signatures[id] = sign(id, qc.Hash())
+		}
+		return &consensus.EpochChange{Checkpoint: qc}
+	}
+
+	// keys rotated in the first epoch change are used to verify the next one.
+	key2, key4 := []byte("key-2-v1"), []byte("key-4-v1")
+	keys := []*consensus.ValidatorInfo{{Id: 2, KeyVersion: 1, PubKey: key2}, {Id: 4, KeyVersion: 1, PubKey: key4}}
+	first := epochChange(1, 1000, keys, func(uint64, []byte) []byte { return []byte("sig") })
+	second := epochChange(2, 2000, keys, func(id uint64, hash []byte) []byte {
+		if id == 2 {
+			return testKeySign(key2, hash)
+		}
+		return []byte("sig")
+	})
+	hs.epochSync(&consensus.EpochChangeProof{EpochChanges: []*consensus.EpochChange{first, second}})
+	assert.True(t, hs.status.atomicHasBit(InEpochSyncing))
+	assert.Equal(t, uint64(1), hs.keys.get(2).KeyVersion)
+	assert.Equal(t, key2, hs.keys.get(2).PubKey)
+	assert.Equal(t, []uint64{1}, rotator.activated)
+	assert.Nil(t, hs.verifySignature(2, testKeySign(key2, []byte("msg")), []byte("msg")))
+
+	// checkpoints carry the learned keys.
+	hs.seqMap[2001] = "batch"
+	hs.checkpoint(&types.ServiceState{MetaState: &types.MetaState{Height: 2001, Digest: "digest-2001"}, Epoch: 1})
+	assert.Equal(t, keys, hs.localCheckpoints[2001].Checkpoint.ValidatorKeys)
+}
@@ -0,0 +1,172 @@
+package rbft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/axiomesh/axiom-bft/common"
+	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-bft/types"
+	"github.com/axiomesh/axiom-kit/txpool"
+	types2 "github.com/axiomesh/axiom-kit/types"
+)
+
+// hotstuffNode implements the Node interface with the chained HotStuff engine.
+type hotstuffNode[T any, Constraint types2.TXConstraint[T]] struct {
+	// hotstuff is the actually chained HotStuff service.
+	hotstuff *hotstuffImpl[T, Constraint]
+
+	// stateLock is used to ensure mutually exclusive access of currentState.
+	stateLock sync.RWMutex
+
+	// currentState maintains the current application service state.
+	currentState *types.ServiceState
+
+	config Config
+	logger common.Logger
+}
+
+// newHotStuffNode help to initialize a Node service running chained HotStuff.
+func newHotStuffNode[T any, Constraint types2.TXConstraint[T]](c Config, external ExternalStack[T, Constraint], requestPool txpool.TxPool[T, Constraint]) (*hotstuffNode[T, Constraint], error) {
+	hs, err := newHotStuff[T, Constraint](c, external, requestPool)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &hotstuffNode[T, Constraint]{
+		hotstuff: hs,
+		config:   c,
+		logger:   c.Logger,
+	}
+
+	hs.node = n
+
+	return n, nil
+}
+
+// Init init Node state.
+func (n *hotstuffNode[T, Constraint]) Init() error {
+	return n.hotstuff.init()
+}
+
+// Start starts a Node instance.
+func (n *hotstuffNode[T, Constraint]) Start() error {
+	return n.hotstuff.start()
+}
+
+// Stop stops a Node instance.
+func (n *hotstuffNode[T, Constraint]) Stop() {
+	n.hotstuff.stop()
+
+	n.stateLock.Lock()
+	n.currentState = nil
+	n.stateLock.Unlock()
+}
+
+// Step advances the state machine using the given message.
+func (n *hotstuffNode[T, Constraint]) Step(ctx context.Context, msg *consensus.ConsensusMessage) {
+	n.hotstuff.step(ctx, msg)
+}
+
+// ReportExecuted reports to HotStuff core that application service has finished height one batch with
+// current height batch seqNo and state digest.
+func (n *hotstuffNode[T, Constraint]) ReportExecuted(state *types.ServiceState) {
+	n.stateLock.Lock()
+	if n.currentState == nil {
+		n.logger.Noticef("Init service state with: %s", state)
+		n.currentState = state
+		n.stateLock.Unlock()
+		return
+	}
+	if state.MetaState.Height != 0 && state.MetaState.Height <= n.currentState.MetaState.Height {
+		n.logger.Warningf("Receive invalid service state %+v, "+
+			"current state %+v", state, n.currentState)
+		n.stateLock.Unlock()
+		return
+	}
+	n.logger.Debugf("Update service state: %s", state)
+	n.currentState = state
+	n.stateLock.Unlock()
+
+	// a config transaction executed or checkpoint, send state to checkpoint channel
+	n.hotstuff.reportCheckpoint(state)
+}
+
+// ReportStateUpdated reports to HotStuff core that application service has finished one desired StateUpdate
+// request which was triggered by HotStuff core before.
+func (n *hotstuffNode[T, Constraint]) ReportStateUpdated(state *types.ServiceSyncState) {
+	n.stateLock.Lock()
+	n.currentState = &state.ServiceState
+	n.stateLock.Unlock()
+	n.logger.Infof("Update current service state: %v", n.currentState)
+	n.hotstuff.reportStateUpdated(state)
+}
+
+// ReportStateUpdateProgress is not supported by HotStuff core, which waits for ReportStateUpdated.
+func (n *hotstuffNode[T, Constraint]) ReportStateUpdateProgress(progress *types.StateUpdateProgress) {
+	n.logger.Debugf("HotStuff ignores state update progress: %+v", progress)
+}
+
+// Status returns the current node status of the HotStuff state machine.
+func (n *hotstuffNode[T, Constraint]) Status() NodeStatus {
+	return n.hotstuff.getStatus()
+}
+
+// GetUncommittedTransactions returns all uncommitted transactions.
+// not supported temporarily.
+func (n *hotstuffNode[T, Constraint]) GetUncommittedTransactions(_ uint64) []*T {
+	return nil
+}
+
+func (n *hotstuffNode[T, Constraint]) GetLowWatermark() uint64 {
+	return n.hotstuff.getLowWatermark()
+}
+
+// TransferLeadership is not supported as the leader of chained HotStuff rotates every view.
+func (n *hotstuffNode[T, Constraint]) TransferLeadership() error {
+	return errors.New("leader transfer is not supported by chained HotStuff as leader rotates every view")
+}
+
+// RotateKey is not supported as HotStuff doesn't order key rotations, keys rotated before are still verified.
+func (n *hotstuffNode[T, Constraint]) RotateKey([]byte) error {
+	return errors.New("key rotation is not supported by chained HotStuff")
+}
+
+func (n *hotstuffNode[T, Constraint]) LeaderSchedule(fromView uint64, count uint64) (*LeaderSchedule, error) {
+	if err := checkLeaderScheduleRange(fromView, count); err != nil {
+		return nil, err
+	}
+	return n.hotstuff.getLeaderSchedule(fromView, count), nil
+}
+
+func (n *hotstuffNode[T, Constraint]) GetEpochChangeProof(startEpoch, endEpoch uint64) (*consensus.EpochChangeProof, error) {
+	return n.hotstuff.epochMgr.getEpochChangeProof(startEpoch, endEpoch)
+}
+
+func (n *hotstuffNode[T, Constraint]) GetQuorumCheckpoint(epoch uint64) (*consensus.QuorumCheckpoint, error) {
+	return n.hotstuff.epochMgr.getEpochQuorumCheckpoint(epoch)
+}
+
+func (n *hotstuffNode[T, Constraint]) ArchiveMode() bool {
+	return false
+}
+
+func (n *hotstuffNode[T, Constraint]) NotifyGenBatch(_ int) {
+	localEvent := &MiscEvent{
+		EventType: NotifyGenBatchEvent,
+	}
+	go n.hotstuff.postMsg(localEvent)
+}
+
+func (n *hotstuffNode[T, Constraint]) NotifyFindNextBatch(hashes ...string) {
+	req := &NotifyFindNextBatchMsg{
+		hashes: hashes,
+	}
+	localEvent := &MiscEvent{
+		EventType: NotifyFindNextBatchEvent,
+		Event:     req,
+	}
+	go n.hotstuff.postMsg(localEvent)
+}
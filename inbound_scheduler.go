@@ -0,0 +1,264 @@
+package rbft
+
+import (
+	"crypto/md5"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+// errInboundDataQueueFull is returned when a request set is posted while the data queue is full, so
+// that the application layer can back off rather than blocking on the event loop.
+var errInboundDataQueueFull = errors.New("inbound data queue is full")
+
+// inboundClass is the class of inbound events, classes with lower value have more weight.
+type inboundClass int
+
+const (
+	// inboundClassControl includes control-plane messages which drive view, checkpoint, recovery and
+	// epoch, which should never queue behind normal-case traffic.
+	inboundClassControl inboundClass = iota
+
+	// inboundClassLocal includes local events, timer events and misc events, which are posted to
+	// recvChan and never dropped.
+	inboundClassLocal
+
+	// inboundClassConsensus includes normal-case consensus messages.
+	inboundClassConsensus
+
+	// inboundClassData includes request sets from application layer and other nodes.
+	inboundClassData
+)
+
+// inboundSchedule is the weighted round robin order in which classes are polled, each class gets a share
+// of the event loop by its occurrences under load, so that lower classes are never starved. Control gets
+// 3/8, local and consensus get 2/8, and data gets 1/8. An idle class gives its share to others.
+var inboundSchedule = []inboundClass{
+	inboundClassControl, inboundClassLocal, inboundClassConsensus,
+	inboundClassControl, inboundClassLocal, inboundClassConsensus,
+	inboundClassControl, inboundClassData,
+}
+
+var inboundClassNames = map[inboundClass]string{
+	inboundClassControl:   "control",
+	inboundClassConsensus: "consensus",
+	inboundClassData:      "data",
+}
+
+// controlPlaneMsgs are consensus messages in inboundClassControl.
+var controlPlaneMsgs = map[consensus.Type]struct{}{
+	consensus.Type_SIGNED_CHECKPOINT:    {},
+	consensus.Type_FETCH_CHECKPOINT:     {},
+	consensus.Type_VIEW_CHANGE:          {},
+	consensus.Type_QUORUM_VIEW_CHANGE:   {},
+	consensus.Type_NEW_VIEW:             {},
+	consensus.Type_FETCH_VIEW:           {},
+	consensus.Type_RECOVERY_RESPONSE:    {},
+	consensus.Type_FETCH_PQC_REQUEST:    {},
+	consensus.Type_FETCH_PQC_RESPONSE:   {},
+	consensus.Type_SYNC_STATE:           {},
+	consensus.Type_SYNC_STATE_RESPONSE:  {},
+	consensus.Type_EPOCH_CHANGE_REQUEST: {},
+	consensus.Type_EPOCH_CHANGE_PROOF:   {},
+	consensus.Type_LEADER_TRANSFER:      {},
+	consensus.Type_KEY_ROTATION:         {},
+}
+
+// inboundMsgKey identifies a consensus message regardless of its nonce, which is used to drop
+// duplicated messages while the same message is still queued.
+type inboundMsgKey struct {
+	msgType consensus.Type
+	from    uint64
+	epoch   uint64
+	view    uint64
+	digest  [md5.Size]byte
+}
+
+// inboundEvent is a queued event, key is nil if the event is not a consensus message.
+type inboundEvent struct {
+	event consensusEvent
+	key   *inboundMsgKey
+}
+
+// inboundScheduler queues inbound consensus messages and request sets by class in bounded queues,
+// so that control-plane messages are not blocked by normal-case traffic under load. Local events
+// are still posted to recvChan, which is polled as inboundClassLocal.
+type inboundScheduler struct {
+	control   chan *inboundEvent
+	consensus chan *inboundEvent
+	data      chan *inboundEvent
+
+	queuedLock sync.Mutex
+	queued     map[inboundMsgKey]struct{}
+
+	// position of inboundSchedule to poll next, only accessed by the event loop.
+	cursor int
+
+	metrics *rbftMetrics
+}
+
+func newInboundScheduler(size int, metrics *rbftMetrics) *inboundScheduler {
+	return &inboundScheduler{
+		control:   make(chan *inboundEvent, size),
+		consensus: make(chan *inboundEvent, size),
+		data:      make(chan *inboundEvent, size),
+		queued:    make(map[inboundMsgKey]struct{}),
+		metrics:   metrics,
+	}
+}
+
+func (s *inboundScheduler) queue(class inboundClass) chan *inboundEvent {
+	switch class {
+	case inboundClassControl:
+		return s.control
+	case inboundClassConsensus:
+		return s.consensus
+	default:
+		return s.data
+	}
+}
+
+func classifyConsensusMsg(msgType consensus.Type) inboundClass {
+	if _, ok := controlPlaneMsgs[msgType]; ok {
+		return inboundClassControl
+	}
+	if msgType == consensus.Type_REBROADCAST_REQUEST_SET {
+		return inboundClassData
+	}
+	return inboundClassConsensus
+}
+
+// postMsg queues the given consensus message by its class, the message is dropped if the same message
+// is still queued. If its queue is full, a message of inboundClassData is dropped, while control-plane
+// and normal-case consensus messages block the caller until queued or closeC is closed, as dropping
+// them may stall consensus.
+func (s *inboundScheduler) postMsg(msg *consensusMessageWrapper, closeC chan bool) {
+	class := classifyConsensusMsg(msg.Type)
+	key := inboundMsgKey{
+		msgType: msg.Type,
+		from:    msg.From,
+		epoch:   msg.Epoch,
+		view:    msg.View,
+		digest:  md5.Sum(msg.Payload),
+	}
+
+	s.queuedLock.Lock()
+	if _, ok := s.queued[key]; ok {
+		s.queuedLock.Unlock()
+		s.metrics.inboundDroppedMsgCounter.With("class", inboundClassNames[class], "reason", "duplicate").Add(1)
+		return
+	}
+	ev := &inboundEvent{event: msg, key: &key}
+	if class == inboundClassData {
+		select {
+		case s.data <- ev:
+			s.queued[key] = struct{}{}
+		default:
+			s.metrics.inboundDroppedMsgCounter.With("class", inboundClassNames[class], "reason", "full").Add(1)
+		}
+		s.queuedLock.Unlock()
+		s.updateDepth(class)
+		return
+	}
+
+	// mark the message as queued before blocking, so that duplicates posted meanwhile are dropped.
+	s.queued[key] = struct{}{}
+	s.queuedLock.Unlock()
+	select {
+	case s.queue(class) <- ev:
+	case <-closeC:
+		s.queuedLock.Lock()
+		delete(s.queued, key)
+		s.queuedLock.Unlock()
+		return
+	}
+	s.updateDepth(class)
+}
+
+// postData queues the given request set from application layer, it never blocks and returns
+// errInboundDataQueueFull if the data queue is full.
+func (s *inboundScheduler) postData(requests consensusEvent) error {
+	select {
+	case s.data <- &inboundEvent{event: requests}:
+	default:
+		s.metrics.inboundDroppedMsgCounter.With("class", inboundClassNames[inboundClassData], "reason", "full").Add(1)
+		return errInboundDataQueueFull
+	}
+	s.updateDepth(inboundClassData)
+	return nil
+}
+
+// next returns the next event to process by inboundSchedule, it blocks until there is an event, and
+// returns false if closeC is closed.
+func (s *inboundScheduler) next(closeC chan bool, localC chan consensusEvent) (consensusEvent, bool) {
+	for i := 0; i < len(inboundSchedule); i++ {
+		class := inboundSchedule[s.cursor]
+		s.cursor = (s.cursor + 1) % len(inboundSchedule)
+		if ev, ok := s.poll(class, localC); ok {
+			return ev, true
+		}
+	}
+
+	select {
+	case <-closeC:
+		return nil, false
+	case ev := <-s.control:
+		return s.dequeued(inboundClassControl, ev), true
+	case ev := <-localC:
+		return ev, true
+	case ev := <-s.consensus:
+		return s.dequeued(inboundClassConsensus, ev), true
+	case ev := <-s.data:
+		return s.dequeued(inboundClassData, ev), true
+	}
+}
+
+// poll returns the next event of the given class if there is one.
+func (s *inboundScheduler) poll(class inboundClass, localC chan consensusEvent) (consensusEvent, bool) {
+	if class == inboundClassLocal {
+		select {
+		case ev := <-localC:
+			return ev, true
+		default:
+			return nil, false
+		}
+	}
+	select {
+	case ev := <-s.queue(class):
+		return s.dequeued(class, ev), true
+	default:
+		return nil, false
+	}
+}
+
+func (s *inboundScheduler) dequeued(class inboundClass, ev *inboundEvent) consensusEvent {
+	if ev.key != nil {
+		s.queuedLock.Lock()
+		delete(s.queued, *ev.key)
+		s.queuedLock.Unlock()
+	}
+	s.updateDepth(class)
+	return ev.event
+}
+
+func (s *inboundScheduler) updateDepth(class inboundClass) {
+	s.metrics.inboundQueueDepthGauge.With("class", inboundClassNames[class]).Set(float64(len(s.queue(class))))
+}
+
+// drain removes all queued events.
+func (s *inboundScheduler) drain() []consensusEvent {
+	var events []consensusEvent
+	for _, class := range []inboundClass{inboundClassControl, inboundClassConsensus, inboundClassData} {
+		q := s.queue(class)
+		for len(q) > 0 {
+			select {
+			case ev := <-q:
+				events = append(events, s.dequeued(class, ev))
+			default:
+			}
+		}
+	}
+	return events
+}
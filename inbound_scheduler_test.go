@@ -0,0 +1,175 @@
+package rbft
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+func TestInboundScheduler_next(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	rbft := rbfts[0]
+	rbft.inbound = newInboundScheduler(2, rbft.metrics)
+
+	requests := &RequestSet[consensus.FltTransaction, *consensus.FltTransaction]{
+		Requests: []*consensus.FltTransaction{newTx()},
+	}
+	prepare := &consensusMessageWrapper{
+		ctx:              context.Background(),
+		ConsensusMessage: &consensus.ConsensusMessage{Type: consensus.Type_PREPARE, From: 2, Payload: []byte("prepare")},
+	}
+	vc := &consensusMessageWrapper{
+		ctx:              context.Background(),
+		ConsensusMessage: &consensus.ConsensusMessage{Type: consensus.Type_VIEW_CHANGE, From: 2, Payload: []byte("vc")},
+	}
+	local := &LocalEvent{Service: CoreRbftService, EventType: CoreBatchTimerEvent}
+
+	rbft.postMsg(requests)
+	rbft.postMsg(prepare)
+	rbft.postMsg(local)
+	rbft.postMsg(vc)
+
+	// events are processed by class weight.
+	for _, expect := range []consensusEvent{vc, local, prepare, requests} {
+		ev, ok := rbft.inbound.next(rbft.close, rbft.recvChan)
+		assert.True(t, ok)
+		assert.Equal(t, expect, ev)
+	}
+
+	// duplicated message is dropped while the same message is queued.
+	rbft.postMsg(prepare)
+	dup := &consensusMessageWrapper{ctx: context.Background(), ConsensusMessage: prepare.CloneVT()}
+	dup.Nonce = 100
+	rbft.postMsg(dup)
+	assert.Equal(t, 1, len(rbft.inbound.consensus))
+
+	// consensus message blocks the caller if queue is full rather than being dropped.
+	msg := &consensusMessageWrapper{ctx: context.Background(), ConsensusMessage: prepare.CloneVT()}
+	msg.Payload = []byte("blocked")
+	rbft.postMsg(msg)
+	posted := make(chan struct{})
+	blocked := &consensusMessageWrapper{ctx: context.Background(), ConsensusMessage: prepare.CloneVT()}
+	blocked.Payload = []byte("blocked-2")
+	go func() {
+		rbft.postMsg(blocked)
+		close(posted)
+	}()
+	select {
+	case <-posted:
+		t.Fatal("consensus message is not blocked by full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+	ev, ok := rbft.inbound.next(rbft.close, rbft.recvChan)
+	assert.True(t, ok)
+	assert.Equal(t, prepare, ev)
+	<-posted
+	assert.Equal(t, 2, len(rbft.inbound.consensus))
+
+	// rebroadcast request set is dropped if queue is full.
+	for i := 0; i < 3; i++ {
+		rebroadcast := &consensusMessageWrapper{
+			ctx:              context.Background(),
+			ConsensusMessage: &consensus.ConsensusMessage{Type: consensus.Type_REBROADCAST_REQUEST_SET, From: 2, Payload: []byte{byte(i)}},
+		}
+		rbft.postMsg(rebroadcast)
+	}
+	assert.Equal(t, 2, len(rbft.inbound.data))
+
+	// dequeued message can be queued again.
+	ev, ok = rbft.inbound.next(rbft.close, rbft.recvChan)
+	assert.True(t, ok)
+	assert.Equal(t, msg, ev)
+	rbft.postMsg(msg)
+	assert.Equal(t, 2, len(rbft.inbound.consensus))
+
+	// blocked caller returns once closed.
+	posted = make(chan struct{})
+	go func() {
+		rbft.postMsg(prepare)
+		close(posted)
+	}()
+	close(rbft.close)
+	<-posted
+	rbft.inbound.drain()
+	_, ok = rbft.inbound.next(rbft.close, rbft.recvChan)
+	assert.False(t, ok)
+}
+
+func TestInboundScheduler_weightedFairness(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	rbft := rbfts[0]
+	rbft.inbound = newInboundScheduler(100, rbft.metrics)
+
+	for i := 0; i < 100; i++ {
+		rbft.postMsg(&consensusMessageWrapper{
+			ctx:              context.Background(),
+			ConsensusMessage: &consensus.ConsensusMessage{Type: consensus.Type_VIEW_CHANGE, From: 2, Payload: []byte{byte(i)}},
+		})
+	}
+	for i := 0; i < 2; i++ {
+		requests := &RequestSet[consensus.FltTransaction, *consensus.FltTransaction]{
+			Requests: []*consensus.FltTransaction{newTx()},
+		}
+		assert.Nil(t, rbft.postRequests(requests))
+	}
+
+	// request sets are not starved by control-plane messages.
+	var dataIndexes []int
+	for i := 0; i < 20; i++ {
+		ev, ok := rbft.inbound.next(rbft.close, rbft.recvChan)
+		assert.True(t, ok)
+		if _, isData := ev.(*RequestSet[consensus.FltTransaction, *consensus.FltTransaction]); isData {
+			dataIndexes = append(dataIndexes, i)
+		}
+	}
+	assert.Equal(t, []int{3, 7}, dataIndexes)
+}
+
+func TestInboundScheduler_postData(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	rbft := rbfts[0]
+	rbft.inbound = newInboundScheduler(1, rbft.metrics)
+
+	requests := &RequestSet[consensus.FltTransaction, *consensus.FltTransaction]{
+		Requests: []*consensus.FltTransaction{newTx()},
+	}
+	assert.Nil(t, rbft.postRequests(requests))
+	// request sets are rejected rather than blocking when the data queue is full.
+	assert.Equal(t, errInboundDataQueueFull, rbft.postRequests(requests))
+	assert.Equal(t, 1, len(rbft.inbound.data))
+}
+
+func TestInboundScheduler_checkMsgNonceWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	rbft := newMockRbft[consensus.FltTransaction, *consensus.FltTransaction](t, ctrl)
+
+	c := rbft.config
+	c.InboundQueueSize = 100
+	c.MsgNonceWindow = 200
+	_, err := newRBFT(c, rbft.external, rbft.batchMgr.requestPool, true)
+	assert.NotNil(t, err)
+
+	c.MsgNonceWindow = 300
+	_, err = newRBFT(c, rbft.external, rbft.batchMgr.requestPool, true)
+	assert.Nil(t, err)
+}
+
+func TestInboundScheduler_stop(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	rbft := rbfts[0]
+	rbft.inbound = newInboundScheduler(10, rbft.metrics)
+
+	rbft.postMsg(&RequestSet[consensus.FltTransaction, *consensus.FltTransaction]{
+		Requests: []*consensus.FltTransaction{newTx(), newTx()},
+	})
+	remainTxs := rbft.stop()
+	assert.Equal(t, 2, len(remainTxs))
+	assert.Equal(t, 0, len(rbft.inbound.data))
+}
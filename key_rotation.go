@@ -0,0 +1,482 @@
+package rbft
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/axiomesh/axiom-bft/common"
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+const (
+	// validatorKeyPrefix is the storage key prefix of the consensus key state of each validator.
+	validatorKeyPrefix = "validator.key."
+
+	// checkpointKeysPrefix is the storage key prefix of the validator keys carried by each local checkpoint.
+	checkpointKeysPrefix = "chkpt-keys."
+)
+
+// validatorKeys tracks the consensus key state of validators which have rotated or announced to rotate
+// their keys, it's accessed by the event loop and the verify workers concurrently. Key states are never
+// modified in place but replaced as a whole.
+//
+// A key rotation announced by a validator is only a proposal until it's ordered by a batch, and the
+// ordered rotation is carried by the next checkpoint and activated once the checkpoint becomes stable,
+// so that all replicas switch keys at the same height, and replicas synced to a stable checkpoint
+// learn the keys from the checkpoint.
+type validatorKeys struct {
+	lock   sync.RWMutex
+	states map[uint64]*consensus.ValidatorKeyState
+
+	// proposals are key rotations announced but not ordered yet, which are proposed in batches by
+	// primary. They're only accessed in the event loop and not persisted.
+	proposals map[uint64]*consensus.KeyRotation
+
+	storage Storage
+	logger  common.Logger
+}
+
+func newValidatorKeys(storage Storage, logger common.Logger) *validatorKeys {
+	return &validatorKeys{
+		states:    make(map[uint64]*consensus.ValidatorKeyState),
+		proposals: make(map[uint64]*consensus.KeyRotation),
+		storage:   storage,
+		logger:    logger,
+	}
+}
+
+// get returns the key state of given validator, which is the initial key state if it never rotated
+// its key. The returned key state must not be modified.
+func (k *validatorKeys) get(id uint64) *consensus.ValidatorKeyState {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	if state, ok := k.states[id]; ok {
+		return state
+	}
+	return &consensus.ValidatorKeyState{}
+}
+
+// set replaces the key state of given validator and persists it.
+func (k *validatorKeys) set(id uint64, state *consensus.ValidatorKeyState) {
+	k.lock.Lock()
+	k.states[id] = state
+	k.lock.Unlock()
+
+	raw, err := state.MarshalVTStrict()
+	if err != nil {
+		k.logger.Errorf("Marshal key state of replica %d failed: %s", id, err)
+		return
+	}
+	if err = k.storage.StoreState(fmt.Sprintf("%s%d", validatorKeyPrefix, id), raw); err != nil {
+		k.logger.Errorf("Persist key state of replica %d failed: %s", id, err)
+	}
+}
+
+// ids returns the validators with key state in ascending order.
+func (k *validatorKeys) ids() []uint64 {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	ids := make([]uint64, 0, len(k.states))
+	for id := range k.states {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// restore restores the persisted key state of validators.
+func (k *validatorKeys) restore() {
+	states, err := k.storage.ReadStateSet(validatorKeyPrefix)
+	if err != nil {
+		k.logger.Debugf("Could not restore key state of validators: %v", err)
+		return
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	for key, raw := range states {
+		var id uint64
+		if _, err = fmt.Sscanf(key, validatorKeyPrefix+"%d", &id); err != nil {
+			k.logger.Warningf("Could not restore key state %s", key)
+			continue
+		}
+		state := &consensus.ValidatorKeyState{}
+		if err = state.UnmarshalVT(raw); err != nil {
+			k.logger.Warningf("Could not restore key state of replica %d: %v", id, err)
+			continue
+		}
+		k.states[id] = state
+	}
+}
+
+// verify verifies the signature of given signer with its current key, its previous key during the
+// overlap window after a rotation, or its next key which is ordered but not activated yet, as the
+// signer may have reached the stable checkpoint activating it earlier.
+func (k *validatorKeys) verify(crypto Crypto, rotator KeyRotator, signer uint64, sig []byte, msg []byte) error {
+	state := k.get(signer)
+	err := verifyWithKey(crypto, rotator, signer, state.KeyVersion, state.PubKey, sig, msg)
+	if err != nil && state.PrevUntil != 0 {
+		err = verifyWithKey(crypto, rotator, signer, state.PrevKeyVersion, state.PrevPubKey, sig, msg)
+	}
+	if err != nil && state.Pending != nil {
+		err = verifyWithKey(crypto, rotator, signer, state.Pending.KeyVersion, state.Pending.PubKey, sig, msg)
+	}
+	return err
+}
+
+// verifyWithKey verifies the signature of given signer with the key of given version, the initial key
+// is verified by Crypto.Verify.
+func verifyWithKey(crypto Crypto, rotator KeyRotator, signer uint64, version uint64, pubKey []byte, sig []byte, msg []byte) error {
+	if version == 0 {
+		return crypto.Verify(signer, sig, msg)
+	}
+	if rotator == nil {
+		return errors.Errorf("cannot verify key version %d of replica %d as key rotation is disabled", version, signer)
+	}
+	return rotator.VerifyWithKey(pubKey, sig, msg)
+}
+
+// checkpointKeys returns the keys of validators which have rotated their keys in ascending order of
+// validators, which are carried by the next checkpoint. The ordered key takes the place of the current
+// one as it's activated by the checkpoint.
+func (k *validatorKeys) checkpointKeys() []*consensus.ValidatorInfo {
+	var keys []*consensus.ValidatorInfo
+	for _, id := range k.ids() {
+		state := k.get(id)
+		switch {
+		case state.Pending != nil:
+			keys = append(keys, &consensus.ValidatorInfo{Id: id, KeyVersion: state.Pending.KeyVersion, PubKey: state.Pending.PubKey})
+		case state.KeyVersion != 0:
+			keys = append(keys, &consensus.ValidatorInfo{Id: id, KeyVersion: state.KeyVersion, PubKey: state.PubKey})
+		}
+	}
+	return keys
+}
+
+// activate activates the keys carried by stable checkpoint h, the previous key of a validator is still
+// accepted until h+overlap. Previous keys whose overlap window has passed are dropped. It returns the
+// activated keys and whether any previous key is dropped.
+func (k *validatorKeys) activate(h uint64, overlap uint64, keys []*consensus.ValidatorInfo) ([]*consensus.ValidatorInfo, bool) {
+	overlapClosed := false
+	for _, id := range k.ids() {
+		state := k.get(id)
+		if state.PrevUntil == 0 || h < state.PrevUntil {
+			continue
+		}
+		k.logger.Debugf("Drop key version %d of replica %d at checkpoint %d", state.PrevKeyVersion, id, h)
+		next := state.CloneVT()
+		next.PrevKeyVersion, next.PrevPubKey, next.PrevUntil = 0, nil, 0
+		k.set(id, next)
+		overlapClosed = true
+	}
+
+	var activated []*consensus.ValidatorInfo
+	for _, key := range keys {
+		state := k.get(key.Id)
+		if key.KeyVersion <= state.KeyVersion {
+			continue
+		}
+		next := state.CloneVT()
+		next.PrevKeyVersion, next.PrevPubKey = next.KeyVersion, next.PubKey
+		next.KeyVersion, next.PubKey = key.KeyVersion, key.PubKey
+		next.PrevUntil = h + overlap
+		if next.Pending != nil && next.Pending.KeyVersion <= next.KeyVersion {
+			next.Pending = nil
+		}
+		k.set(key.Id, next)
+		k.dropProposal(key.Id, key.KeyVersion)
+		activated = append(activated, key)
+	}
+	return activated, overlapClosed
+}
+
+// propose records a key rotation announced but not ordered yet.
+func (k *validatorKeys) propose(kr *consensus.KeyRotation) {
+	k.proposals[kr.ReplicaId] = kr
+}
+
+// proposal returns the key rotation announced by given validator but not ordered yet.
+func (k *validatorKeys) proposal(id uint64) (*consensus.KeyRotation, bool) {
+	kr, ok := k.proposals[id]
+	return kr, ok
+}
+
+// dropProposal drops the key rotation announced by given validator if it's not newer than version.
+func (k *validatorKeys) dropProposal(id uint64, version uint64) {
+	if kr, ok := k.proposals[id]; ok && kr.KeyVersion <= version {
+		delete(k.proposals, id)
+	}
+}
+
+// proposable returns the key rotations which are still the next key version of validators in
+// ascending order of validators, they're proposed by primary in the next batch.
+func (k *validatorKeys) proposable() []*consensus.KeyRotation {
+	var rotations []*consensus.KeyRotation
+	for id, kr := range k.proposals {
+		state := k.get(id)
+		if state.Pending == nil && kr.KeyVersion == state.KeyVersion+1 {
+			rotations = append(rotations, kr)
+		}
+	}
+	sort.Slice(rotations, func(i, j int) bool { return rotations[i].ReplicaId < rotations[j].ReplicaId })
+	return rotations
+}
+
+func (rbft *rbftImpl[T, Constraint]) isKeyRotationEnabled() bool {
+	return rbft.keyRotator != nil
+}
+
+// verifyValidatorSignature verifies the signature of given signer with any key accepted currently.
+func (rbft *rbftImpl[T, Constraint]) verifyValidatorSignature(signer uint64, sig []byte, msg []byte) error {
+	return rbft.keys.verify(rbft.external, rbft.keyRotator, signer, sig, msg)
+}
+
+func (rbft *rbftImpl[T, Constraint]) handleReqRotateKeyEvent(e *ReqRotateKeyMsg) consensusEvent {
+	if !rbft.isKeyRotationEnabled() {
+		e.ch <- errors.New("key rotation is disabled")
+		return nil
+	}
+	if !rbft.chainConfig.isValidator() {
+		e.ch <- errors.New("only validator can rotate key")
+		return nil
+	}
+	if len(e.pubKey) == 0 {
+		e.ch <- errors.New("empty public key")
+		return nil
+	}
+	state := rbft.keys.get(rbft.chainConfig.SelfID)
+	if state.Pending != nil {
+		e.ch <- errors.Errorf("key rotation to version %d is still pending", state.Pending.KeyVersion)
+		return nil
+	}
+	if kr, ok := rbft.keys.proposal(rbft.chainConfig.SelfID); ok {
+		e.ch <- errors.Errorf("key rotation to version %d is not ordered yet", kr.KeyVersion)
+		return nil
+	}
+
+	kr := &consensus.KeyRotation{
+		ReplicaId:  rbft.chainConfig.SelfID,
+		KeyVersion: state.KeyVersion + 1,
+		PubKey:     e.pubKey,
+	}
+	hash, err := rbft.calculateKeyRotationHash(kr)
+	if err != nil {
+		e.ch <- err
+		return nil
+	}
+	// signed by the current key, as the new key only takes effect at a stable checkpoint.
+	kr.Signature, err = rbft.external.Sign(hash)
+	if err != nil {
+		e.ch <- errors.Wrap(err, "sign key rotation failed")
+		return nil
+	}
+	rbft.keys.propose(kr)
+
+	rbft.logger.Noticef("Replica %d announce key rotation to version %d", rbft.chainConfig.SelfID, kr.KeyVersion)
+	rbft.broadcastKeyRotation(kr)
+	e.ch <- nil
+	return nil
+}
+
+func (rbft *rbftImpl[T, Constraint]) broadcastKeyRotation(kr *consensus.KeyRotation) {
+	payload, err := kr.MarshalVTStrict()
+	if err != nil {
+		rbft.logger.Errorf("ConsensusMessage_KEY_ROTATION Marshal Error: %s", err)
+		return
+	}
+	consensusMsg := &consensus.ConsensusMessage{
+		Type:    consensus.Type_KEY_ROTATION,
+		Payload: payload,
+	}
+	rbft.peerMgr.broadcast(context.TODO(), consensusMsg)
+}
+
+// recvKeyRotation records the key rotation announced by other validators as a proposal, which is
+// ordered once the primary proposes it in a batch.
+func (rbft *rbftImpl[T, Constraint]) recvKeyRotation(kr *consensus.KeyRotation) consensusEvent {
+	if !rbft.isKeyRotationEnabled() {
+		rbft.logger.Debugf("Replica %d ignore key rotation as key rotation is disabled", rbft.chainConfig.SelfID)
+		return nil
+	}
+	if kr.ReplicaId == rbft.chainConfig.SelfID {
+		return nil
+	}
+	rbft.logger.Debugf("Replica %d received key rotation to version %d from replica %d",
+		rbft.chainConfig.SelfID, kr.KeyVersion, kr.ReplicaId)
+
+	state := rbft.keys.get(kr.ReplicaId)
+	proposal, ok := rbft.keys.proposal(kr.ReplicaId)
+	if kr.KeyVersion <= state.KeyVersion || (state.Pending != nil && state.Pending.KeyVersion == kr.KeyVersion) ||
+		(ok && proposal.KeyVersion == kr.KeyVersion) {
+		rbft.logger.Debugf("Replica %d ignore known key rotation to version %d from replica %d",
+			rbft.chainConfig.SelfID, kr.KeyVersion, kr.ReplicaId)
+		return nil
+	}
+	if err := rbft.checkKeyRotation(kr); err != nil {
+		rbft.logger.Warningf("Replica %d received invalid key rotation from replica %d: %s",
+			rbft.chainConfig.SelfID, kr.ReplicaId, err)
+		return nil
+	}
+
+	rbft.keys.propose(kr)
+	rbft.logger.Infof("Replica %d accept key rotation to version %d from replica %d, wait to be ordered",
+		rbft.chainConfig.SelfID, kr.KeyVersion, kr.ReplicaId)
+	return nil
+}
+
+// checkKeyRotation checks if the key rotation announces the next key version of a validator and is
+// signed by its current key. Only the current key is able to announce a new key, not the previous one
+// in overlap window or the ordered one.
+func (rbft *rbftImpl[T, Constraint]) checkKeyRotation(kr *consensus.KeyRotation) error {
+	if !rbft.chainConfig.CheckValidator(kr.ReplicaId) {
+		return errors.Errorf("replica %d is not a validator", kr.ReplicaId)
+	}
+	if len(kr.PubKey) == 0 {
+		return errors.New("empty public key")
+	}
+	state := rbft.keys.get(kr.ReplicaId)
+	if state.Pending != nil {
+		return errors.Errorf("key rotation to version %d is still pending", state.Pending.KeyVersion)
+	}
+	if kr.KeyVersion != state.KeyVersion+1 {
+		return errors.Errorf("cannot verify key version %d, current key version %d", kr.KeyVersion, state.KeyVersion)
+	}
+	hash, err := rbft.calculateKeyRotationHash(kr)
+	if err != nil {
+		return err
+	}
+	if err = verifyWithKey(rbft.external, rbft.keyRotator, kr.ReplicaId, state.KeyVersion, state.PubKey, kr.Signature, hash); err != nil {
+		return errors.Wrap(err, "invalid signature")
+	}
+	return nil
+}
+
+// orderKeyRotations records the key rotations proposed in the executed batch as pending, invalid ones
+// are skipped. As batches are executed in the same order, all replicas get the same pending rotations
+// at each checkpoint.
+func (rbft *rbftImpl[T, Constraint]) orderKeyRotations(n uint64, d string) {
+	batch, ok := rbft.storeMgr.batchStore[d]
+	if !ok {
+		return
+	}
+	for _, kr := range batch.KeyRotations {
+		if err := rbft.checkKeyRotation(kr); err != nil {
+			rbft.logger.Debugf("Replica %d skip key rotation to version %d of replica %d in seqNo=%d: %s",
+				rbft.chainConfig.SelfID, kr.KeyVersion, kr.ReplicaId, n, err)
+			continue
+		}
+		next := rbft.keys.get(kr.ReplicaId).CloneVT()
+		next.Pending = kr
+		rbft.keys.set(kr.ReplicaId, next)
+		rbft.keys.dropProposal(kr.ReplicaId, kr.KeyVersion)
+		rbft.logger.Infof("Replica %d order key rotation to version %d of replica %d in seqNo=%d, "+
+			"take effect at next stable checkpoint", rbft.chainConfig.SelfID, kr.KeyVersion, kr.ReplicaId, n)
+	}
+}
+
+// checkpointValidatorKeys returns the validator keys carried by the checkpoint of given height, the
+// persisted keys are used if the checkpoint is restored.
+func (rbft *rbftImpl[T, Constraint]) checkpointValidatorKeys(n uint64) []*consensus.ValidatorInfo {
+	if !rbft.isKeyRotationEnabled() {
+		return nil
+	}
+	if keys, ok := rbft.storeMgr.checkpointKeys[n]; ok {
+		return keys
+	}
+	return rbft.keys.checkpointKeys()
+}
+
+// activateKeyRotations activates the validator keys carried by stable checkpoint h, the previous key is
+// still accepted until the next checkpoint.
+func (rbft *rbftImpl[T, Constraint]) activateKeyRotations(h uint64, keys []*consensus.ValidatorInfo) {
+	if !rbft.isKeyRotationEnabled() {
+		return
+	}
+
+	period := rbft.chainConfig.EpochInfo.ConsensusParams.CheckpointPeriod
+	activated, overlapClosed := rbft.keys.activate(h, period, keys)
+	for _, key := range activated {
+		rbft.logger.Noticef("Replica %d activate key version %d of replica %d at checkpoint %d, previous key is accepted until %d",
+			rbft.chainConfig.SelfID, key.KeyVersion, key.Id, h, h+period)
+		rbft.metrics.keyRotationCounter.Add(float64(1))
+		if key.Id == rbft.chainConfig.SelfID {
+			if err := rbft.keyRotator.ActivateKey(key.KeyVersion, key.PubKey); err != nil {
+				rbft.logger.Errorf("Replica %d activate key version %d failed: %s", rbft.chainConfig.SelfID,
+					key.KeyVersion, err)
+			}
+		}
+	}
+	if overlapClosed {
+		// signatures verified with the dropped keys cannot be accepted from cache any more.
+		rbft.sigCache.reset()
+	}
+
+	// announce again if the rotation of self is not ordered yet, e.g. the primary missed it.
+	if kr, ok := rbft.keys.proposal(rbft.chainConfig.SelfID); ok {
+		rbft.broadcastKeyRotation(kr)
+	}
+}
+
+func (rbft *rbftImpl[T, Constraint]) calculateKeyRotationHash(kr *consensus.KeyRotation) ([]byte, error) {
+	hasher := sha3.NewLegacyKeccak256()
+	unsigned := &consensus.KeyRotation{
+		ReplicaId:  kr.ReplicaId,
+		KeyVersion: kr.KeyVersion,
+		PubKey:     kr.PubKey,
+	}
+	raw, err := unsigned.MarshalVTStrict()
+	if err != nil {
+		return nil, err
+	}
+	_, err = hasher.Write(raw)
+	if err != nil {
+		return nil, err
+	}
+	hash := hasher.Sum(nil)
+	return hash, nil
+}
+
+// persistCheckpointKeys persists the validator keys carried by the local checkpoint of given height.
+func (rbft *rbftImpl[T, Constraint]) persistCheckpointKeys(seqNo uint64, keys []*consensus.ValidatorInfo) {
+	if len(keys) == 0 {
+		return
+	}
+	raw, err := (&consensus.Checkpoint{ValidatorKeys: keys}).MarshalVTStrict()
+	if err != nil {
+		rbft.logger.Errorf("Replica %d marshal keys of checkpoint %d failed: %s", rbft.chainConfig.SelfID, seqNo, err)
+		return
+	}
+	if err = rbft.storage.StoreState(fmt.Sprintf("%s%d", checkpointKeysPrefix, seqNo), raw); err != nil {
+		rbft.logger.Errorf("Replica %d persist keys of checkpoint %d failed: %s", rbft.chainConfig.SelfID, seqNo, err)
+	}
+}
+
+// restoreCheckpointKeys restores the validator keys carried by the local checkpoint of given height.
+func (rbft *rbftImpl[T, Constraint]) restoreCheckpointKeys(seqNo uint64) {
+	if !rbft.isKeyRotationEnabled() {
+		return
+	}
+	raw, err := rbft.storage.ReadState(fmt.Sprintf("%s%d", checkpointKeysPrefix, seqNo))
+	if err != nil || len(raw) == 0 {
+		return
+	}
+	c := &consensus.Checkpoint{}
+	if err = c.UnmarshalVT(raw); err != nil {
+		rbft.logger.Warningf("Replica %d could not restore keys of checkpoint %d: %v", rbft.chainConfig.SelfID, seqNo, err)
+		return
+	}
+	rbft.storeMgr.checkpointKeys[seqNo] = c.ValidatorKeys
+}
+
+// cleanCheckpointKeys cleans restored validator keys of checkpoints not larger than h.
+func (rbft *rbftImpl[T, Constraint]) cleanCheckpointKeys(h uint64) {
+	for n := range rbft.storeMgr.checkpointKeys {
+		if n <= h {
+			delete(rbft.storeMgr.checkpointKeys, n)
+		}
+	}
+}
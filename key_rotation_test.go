@@ -0,0 +1,226 @@
+package rbft
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-bft/types"
+)
+
+// testKeyRotator accepts signatures derived from the public key and msg, and records activated keys.
+type testKeyRotator struct {
+	activated []uint64
+}
+
+func testKeySign(pubKey []byte, msg []byte) []byte {
+	sig := sha256.Sum256(append(append([]byte{}, pubKey...), msg...))
+	return sig[:]
+}
+
+func (r *testKeyRotator) VerifyWithKey(pubKey []byte, signature []byte, msg []byte) error {
+	if !bytes.Equal(signature, testKeySign(pubKey, msg)) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+func (r *testKeyRotator) ActivateKey(version uint64, _ []byte) error {
+	r.activated = append(r.activated, version)
+	return nil
+}
+
+func TestKeyRotation_rotateKey(t *testing.T) {
+	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	rotators := make([]*testKeyRotator, len(rbfts))
+	for i, r := range rbfts {
+		rotators[i] = &testKeyRotator{}
+		r.keyRotator = rotators[i]
+	}
+	primary, rotating, replica, synced := rbfts[0], rbfts[1], rbfts[2], rbfts[3]
+	period := replica.chainConfig.EpochInfo.ConsensusParams.CheckpointPeriod
+	rotateKey := func(pubKey []byte) error {
+		req := &ReqRotateKeyMsg{pubKey: pubKey, ch: make(chan error, 1)}
+		rotating.handleReqRotateKeyEvent(req)
+		return <-req.ch
+	}
+
+	newKey := []byte("key-2-v1")
+	assert.Nil(t, rotateKey(newKey))
+	assert.NotNil(t, rotateKey([]byte("key-2-v2")), "previous rotation is not ordered yet")
+	msg := nodes[1].broadcastMessageCache
+	assert.Equal(t, consensus.Type_KEY_ROTATION, msg.Type)
+	kr := &consensus.KeyRotation{}
+	assert.Nil(t, kr.UnmarshalVT(msg.Payload))
+	assert.Equal(t, uint64(1), kr.KeyVersion)
+
+	// rotation skipping key versions cannot be verified.
+	skipped := kr.CloneVT()
+	skipped.KeyVersion = 2
+	primary.recvKeyRotation(skipped)
+	_, ok := primary.keys.proposal(2)
+	assert.False(t, ok)
+
+	// announced rotation is only a proposal until it's ordered.
+	for _, r := range []*rbftImpl[consensus.FltTransaction, *consensus.FltTransaction]{primary, replica} {
+		r.recvKeyRotation(kr)
+		proposal, ok := r.keys.proposal(2)
+		assert.True(t, ok)
+		assert.True(t, proposal.EqualVT(kr))
+		assert.Nil(t, r.keys.get(2).Pending)
+	}
+
+	// primary proposes the rotation in the next batch, which is ordered by executing the batch.
+	tx := newTx()
+	for _, r := range rbfts {
+		_ = r.batchMgr.requestPool.AddLocalTx(tx)
+	}
+	primary.processEvent(&LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreBatchTimerEvent,
+	})
+	prePrepMsg := nodes[0].broadcastMessageCache
+	assert.Equal(t, consensus.Type_PRE_PREPARE, prePrepMsg.Type)
+	prePrep := &consensus.PrePrepare{}
+	assert.Nil(t, prePrep.UnmarshalVT(prePrepMsg.Payload))
+	assert.Equal(t, 1, len(prePrep.HashBatch.KeyRotations))
+	assert.True(t, prePrep.HashBatch.KeyRotations[0].EqualVT(kr))
+	// the ordered rotations are covered by the batch digest.
+	assert.Equal(t, calculateBatchDigest(prePrep.HashBatch), prePrep.BatchDigest)
+	assert.NotEqual(t, calculateMD5Hash(prePrep.HashBatch.RequestHashList, prePrep.HashBatch.Timestamp), prePrep.BatchDigest)
+
+	prepares := make([]*consensusMessageWrapper, 3)
+	for i := 1; i < 3; i++ {
+		rbfts[i].processEvent(prePrepMsg)
+		prepares[i] = nodes[i].broadcastMessageCache
+		assert.Equal(t, consensus.Type_PREPARE, prepares[i].Type)
+	}
+	commits := make([]*consensusMessageWrapper, 3)
+	for i := 0; i < 3; i++ {
+		for j := 1; j < 3; j++ {
+			if j != i {
+				rbfts[i].processEvent(prepares[j])
+			}
+		}
+		commits[i] = nodes[i].broadcastMessageCache
+		assert.Equal(t, consensus.Type_COMMIT, commits[i].Type)
+	}
+	signedMsg := []byte("checkpoint")
+	newSig := testKeySign(newKey, signedMsg)
+	oldSig := []byte("old-sig")
+	var keys []*consensus.ValidatorInfo
+	for i := 0; i < 3; i++ {
+		rbfts[i].processEvent(commits[(i+1)%3])
+		rbfts[i].processEvent(commits[(i+2)%3])
+		assert.Equal(t, uint64(1), rbfts[i].exec.lastExec)
+		assert.True(t, rbfts[i].keys.get(2).Pending.EqualVT(kr))
+		assert.Equal(t, uint64(0), rbfts[i].keys.get(2).KeyVersion)
+		_, ok = rbfts[i].keys.proposal(2)
+		assert.False(t, ok)
+
+		// both keys are accepted until the rotation is activated.
+		assert.Nil(t, rbfts[i].verifySignature(2, newSig, signedMsg))
+		assert.Nil(t, rbfts[i].verifySignature(2, oldSig, signedMsg))
+
+		// the ordered rotation is carried by the checkpoint, which is the same on all replicas.
+		state := &types.ServiceState{
+			MetaState: &types.MetaState{Height: period, Digest: "block"},
+			Epoch:     rbfts[i].chainConfig.EpochInfo.Epoch,
+		}
+		signedCheckpoint, err := rbfts[i].generateSignedCheckpoint(state, "batch", false, false)
+		assert.Nil(t, err)
+		assert.Equal(t, []*consensus.ValidatorInfo{{Id: 2, KeyVersion: 1, PubKey: newKey}}, signedCheckpoint.Checkpoint.ValidatorKeys)
+		if keys != nil {
+			assert.Equal(t, keys, signedCheckpoint.Checkpoint.ValidatorKeys)
+		}
+		keys = signedCheckpoint.Checkpoint.ValidatorKeys
+	}
+	raw, err := replica.storage.ReadState(fmt.Sprintf("%s%d", validatorKeyPrefix, 2))
+	assert.Nil(t, err)
+	persisted := &consensus.ValidatorKeyState{}
+	assert.Nil(t, persisted.UnmarshalVT(raw))
+	assert.True(t, persisted.EqualVT(replica.keys.get(2)))
+
+	// new key takes effect once the checkpoint becomes stable, both keys are accepted in overlap window.
+	replica.activateKeyRotations(period, keys)
+	state := replica.keys.get(2)
+	assert.Nil(t, state.Pending)
+	assert.Equal(t, uint64(1), state.KeyVersion)
+	assert.Equal(t, 2*period, state.PrevUntil)
+	assert.Nil(t, replica.verifySignature(2, newSig, signedMsg))
+	assert.Nil(t, replica.verifySignature(2, oldSig, signedMsg))
+
+	rotating.activateKeyRotations(period, keys)
+	assert.Equal(t, []uint64{1}, rotators[1].activated)
+	assert.Empty(t, rotators[0].activated)
+
+	// replica synced to the stable checkpoint learns the key from the checkpoint.
+	synced.activateKeyRotations(period, keys)
+	assert.Equal(t, uint64(1), synced.keys.get(2).KeyVersion)
+	assert.Equal(t, newKey, synced.keys.get(2).PubKey)
+
+	// previous key is dropped once the overlap window has passed.
+	replica.activateKeyRotations(2*period, keys)
+	assert.Equal(t, uint64(0), replica.keys.get(2).PrevUntil)
+	assert.Nil(t, replica.verifySignature(2, newSig, signedMsg))
+	assert.NotNil(t, replica.verifySignature(2, oldSig, signedMsg))
+
+	// invalid rotation proposed by primary is skipped when ordered.
+	replica.storeMgr.batchStore["skipped"] = &RequestBatch[consensus.FltTransaction, *consensus.FltTransaction]{
+		KeyRotations: []*consensus.KeyRotation{skipped},
+	}
+	replica.orderKeyRotations(2*period+1, "skipped")
+	assert.Nil(t, replica.keys.get(2).Pending)
+
+	// next rotation must be signed by the new key.
+	next := &consensus.KeyRotation{ReplicaId: 2, KeyVersion: 2, PubKey: []byte("key-2-v2")}
+	hash, err := replica.calculateKeyRotationHash(next)
+	assert.Nil(t, err)
+	next.Signature = oldSig
+	replica.recvKeyRotation(next)
+	_, ok = replica.keys.proposal(2)
+	assert.False(t, ok)
+	next.Signature = testKeySign(newKey, hash)
+	replica.recvKeyRotation(next)
+	proposal, ok := replica.keys.proposal(2)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), proposal.KeyVersion)
+}
+
+func TestKeyRotation_batchDigest(t *testing.T) {
+	tx := newTx()
+	kr2 := &consensus.KeyRotation{ReplicaId: 2, KeyVersion: 1, PubKey: []byte("key-2-v1"), Signature: []byte("sig-2")}
+	kr3 := &consensus.KeyRotation{ReplicaId: 3, KeyVersion: 1, PubKey: []byte("key-3-v1"), Signature: []byte("sig-3")}
+	batch := &RequestBatch[consensus.FltTransaction, *consensus.FltTransaction]{
+		RequestHashList: []string{tx.RbftGetTxHash()},
+		RequestList:     []*consensus.FltTransaction{tx},
+		Timestamp:       1,
+		SeqNo:           2,
+		LocalList:       []bool{false},
+		KeyRotations:    []*consensus.KeyRotation{kr2, kr3},
+	}
+	digest := calculateBatchDigest(batch.hashBatch())
+	assert.NotEqual(t, calculateMD5Hash(batch.RequestHashList, batch.Timestamp), digest)
+	assert.Nil(t, verifyFetchedBatch(digest, batch))
+
+	// a batch with rotations dropped or replaced doesn't match the digest.
+	forged := *batch
+	forged.KeyRotations = []*consensus.KeyRotation{kr2}
+	assert.NotNil(t, verifyFetchedBatch(digest, &forged))
+	replaced := kr3.CloneVT()
+	replaced.PubKey = []byte("key-3-forged")
+	forged.KeyRotations = []*consensus.KeyRotation{kr2, replaced}
+	assert.NotNil(t, verifyFetchedBatch(digest, &forged))
+
+	// rotations must be sorted by replica without duplicates.
+	forged.KeyRotations = []*consensus.KeyRotation{kr3, kr2}
+	assert.NotNil(t, verifyFetchedBatch(calculateBatchDigest(forged.hashBatch()), &forged))
+	forged.KeyRotations = []*consensus.KeyRotation{kr2, kr2}
+	assert.NotNil(t, verifyFetchedBatch(calculateBatchDigest(forged.hashBatch()), &forged))
+}
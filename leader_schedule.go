@@ -0,0 +1,77 @@
+package rbft
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+// maxLeaderScheduleCount is the max number of views projected in one LeaderSchedule query, as the
+// projection runs in the consensus event loop.
+const maxLeaderScheduleCount = 1000
+
+// LeaderSchedule is the projected primaries of upcoming views under current validator dynamic info
+// and proposer election type. As WRF also reselects primary after each checkpoint and punishes the
+// primary of each failed view, the schedule is only valid until next checkpoint or view change.
+type LeaderSchedule struct {
+	Epoch uint64
+
+	// View is the current view.
+	View uint64
+
+	// FromView is the view of the first projected primary.
+	FromView uint64
+
+	// Primaries are the projected primary IDs of views from FromView in order.
+	Primaries []uint64
+
+	// ExcludedNodes are the nodes which produced blocks recently and are excluded from WRF proposer
+	// election, sorted by id.
+	ExcludedNodes []uint64
+
+	// PunishedNodes are the validators whose voting power is reduced for timeouts as primary, or with
+	// a reputation penalty, sorted by id.
+	PunishedNodes []ValidatorInfo
+}
+
+// checkLeaderScheduleRange checks the range of views projected in one LeaderSchedule query.
+func checkLeaderScheduleRange(fromView uint64, count uint64) error {
+	if count == 0 {
+		return errors.New("count of leader schedule must be larger than 0")
+	}
+	if count > maxLeaderScheduleCount {
+		return errors.Errorf("count of leader schedule exceeds %d", maxLeaderScheduleCount)
+	}
+	if fromView > math.MaxUint64-count {
+		return errors.Errorf("views of leader schedule from %d overflow", fromView)
+	}
+	return nil
+}
+
+// getLeaderSchedule projects the primaries of count views from fromView.
+func (rbft *rbftImpl[T, Constraint]) getLeaderSchedule(fromView uint64, count uint64) *LeaderSchedule {
+	schedule := &LeaderSchedule{
+		Epoch:     rbft.chainConfig.EpochInfo.Epoch,
+		View:      rbft.chainConfig.View,
+		FromView:  fromView,
+		Primaries: make([]uint64, 0, count),
+	}
+	for i := uint64(0); i < count; i++ {
+		schedule.Primaries = append(schedule.Primaries, rbft.chainConfig.calPrimaryIDByView(fromView+i, rbft.chainConfig.ValidatorDynamicInfoMap))
+	}
+
+	if rbft.chainConfig.isProposerElectionTypeWRF() {
+		schedule.ExcludedNodes = lo.Keys(rbft.chainConfig.RecentBlockProcessorTracker.GetRecentProcessorSet())
+		sort.Slice(schedule.ExcludedNodes, func(i, j int) bool {
+			return schedule.ExcludedNodes[i] < schedule.ExcludedNodes[j]
+		})
+	}
+	for _, info := range formatValidatorDynamicInfo(rbft.chainConfig.ValidatorDynamicInfoMap) {
+		if info.ConsensusVotingPowerReduced || info.ReputationPenalty != 0 {
+			schedule.PunishedNodes = append(schedule.PunishedNodes, info)
+		}
+	}
+	return schedule
+}
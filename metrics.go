@@ -96,10 +96,31 @@ type rbftMetrics struct {
 	// [primary only]
 	cacheBatchNumber metrics.Gauge
 
+	// ========================== metrics related to pipelining ==========================
+	// monitor the number of consensus instances which have been pre-prepared but not committed.
+	pipelineDepthGauge metrics.Gauge
+
+	// monitor the number of in-flight instances which are waiting for missing txs.
+	pipelineFetchingGauge metrics.Gauge
+
+	// monitor the times primary stops proposing because of too many in-flight batches.
+	// [primary only]
+	pipelineFullCounter metrics.Counter
+
+	// ========================== metrics related to fast path ==========================
+	// monitor the times batch is committed by fast path without commit phase.
+	fastPathCommitCounter metrics.Counter
+
+	// monitor the times fast path timer expired and fall back to commit phase.
+	fastPathFallbackCounter metrics.Counter
+
 	// ========================== metrics related to fell behind info ==========================
 	// monitor the state update times.
 	stateUpdateCounter metrics.Counter
 
+	// monitor the times of state update re-issued because of timeout, error or newer target.
+	stateUpdateRetryCounter metrics.Counter
+
 	// monitor the times of fetch missing txs which is caused by missing txs before commit.
 	fetchMissingTxsCounter metrics.Counter
 
@@ -110,6 +131,57 @@ type rbftMetrics struct {
 	// monitor the times of fetch request batch which is caused by missing batches after vc.
 	fetchRequestBatchCounter metrics.Counter
 
+	// monitor the times of fetch retried against other peers because of timeout or invalid response.
+	fetchRetryCounter metrics.Counter
+
+	// monitor the times of invalid fetch response which mismatches the known digests.
+	invalidFetchResponseCounter metrics.Counter
+
+	// monitor the number of in-flight fetches of missing batches and txs.
+	fetchInFlightGauge metrics.Gauge
+
+	// monitor the number of total and applied chunks of ongoing snapshot sync.
+	snapshotSyncChunksGauge metrics.Gauge
+
+	// monitor the times of validator key rotations activated at stable checkpoints.
+	keyRotationCounter metrics.Counter
+
+	// monitor the times of batches rejected by application before prepare.
+	rejectedBatchCounter metrics.Counter
+
+	// monitor the times of batches cut by batch policy in primary.
+	batchPolicyCutCounter metrics.Counter
+
+	// monitor the number of txs exceeding batch policy on their own in primary.
+	oversizedTxCounter metrics.Counter
+
+	// monitor the times of batches reordered by ordering policy in primary.
+	reorderedBatchCounter metrics.Counter
+
+	// ========================== metrics related to message rate limit ==========================
+	// monitor the number of consensus messages dropped by rate limit or peer ban.
+	rateLimitedMsgCounter metrics.Counter
+
+	// monitor the times of peers banned for exceeding the rate limit.
+	bannedPeerCounter metrics.Counter
+
+	// monitor the number of currently banned peers.
+	bannedPeersGauge metrics.Gauge
+
+	// monitor the number of consensus messages rejected by nonce as stale or duplicate.
+	replayedMsgCounter metrics.Counter
+
+	// ========================== metrics related to payload compression ==========================
+	// monitor the payload size of compressed messages before and after compression.
+	compressedPayloadBytes metrics.Counter
+
+	// ========================== metrics related to inbound scheduler ==========================
+	// monitor the number of queued inbound events of each class.
+	inboundQueueDepthGauge metrics.Gauge
+
+	// monitor the number of inbound consensus messages dropped because of full queue or duplicate.
+	inboundDroppedMsgCounter metrics.Counter
+
 	// ========================== metrics related to txs/txSets info ==========================
 	// monitor part of incoming tx sets, including tx sets from API and relayed from NVP.
 	incomingLocalTxSets metrics.Counter
@@ -413,6 +485,56 @@ func newRBFTMetrics(metricsProv metrics.Provider) (*rbftMetrics, error) {
 		return m, err
 	}
 
+	m.pipelineDepthGauge, err = metricsProv.NewGauge(
+		metrics.GaugeOpts{
+			Name: "pipeline_depth",
+			Help: "rbft number of in-flight consensus instances",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.pipelineFetchingGauge, err = metricsProv.NewGauge(
+		metrics.GaugeOpts{
+			Name: "pipeline_fetching_batch_number",
+			Help: "rbft number of in-flight batches waiting for missing txs",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.pipelineFullCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name: "pipeline_full_times",
+			Help: "rbft times primary stops proposing because of too many in-flight batches",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.fastPathCommitCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name: "fast_path_commit_times",
+			Help: "rbft times batch is committed by fast path",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.fastPathFallbackCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name: "fast_path_fallback_times",
+			Help: "rbft times fast path timer expired and fall back to commit phase",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
 	m.stateUpdateCounter, err = metricsProv.NewCounter(
 		metrics.CounterOpts{
 			Name: "state_update_times",
@@ -423,6 +545,17 @@ func newRBFTMetrics(metricsProv metrics.Provider) (*rbftMetrics, error) {
 		return m, err
 	}
 
+	m.stateUpdateRetryCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name:       "state_update_retry_times",
+			Help:       "rbft state update retry times",
+			LabelNames: []string{"reason"},
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
 	m.fetchMissingTxsCounter, err = metricsProv.NewCounter(
 		metrics.CounterOpts{
 			Name: "fetch_missing_txs_times",
@@ -454,6 +587,174 @@ func newRBFTMetrics(metricsProv metrics.Provider) (*rbftMetrics, error) {
 		return m, err
 	}
 
+	m.fetchRetryCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name:       "fetch_retry_times",
+			Help:       "rbft fetch retry times against other peers",
+			LabelNames: []string{"type"},
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.invalidFetchResponseCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name:       "invalid_fetch_response_times",
+			Help:       "rbft invalid fetch response times",
+			LabelNames: []string{"type"},
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.fetchInFlightGauge, err = metricsProv.NewGauge(
+		metrics.GaugeOpts{
+			Name: "fetch_in_flight",
+			Help: "rbft number of in-flight fetches",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.snapshotSyncChunksGauge, err = metricsProv.NewGauge(
+		metrics.GaugeOpts{
+			Name:       "snapshot_sync_chunks",
+			Help:       "rbft number of total and applied chunks of snapshot sync",
+			LabelNames: []string{"type"},
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.keyRotationCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name: "key_rotation_times",
+			Help: "rbft validator key rotations activated at stable checkpoints",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.rejectedBatchCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name: "rejected_batch_times",
+			Help: "rbft batches rejected by application before prepare",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.batchPolicyCutCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name: "batch_policy_cut_times",
+			Help: "rbft batches cut by batch policy in primary",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.oversizedTxCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name: "oversized_tx_number",
+			Help: "rbft txs exceeding batch policy on their own in primary",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.reorderedBatchCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name: "reordered_batch_times",
+			Help: "rbft batches reordered by ordering policy in primary",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.rateLimitedMsgCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name:       "rate_limited_msgs",
+			Help:       "rbft consensus messages dropped by rate limit or peer ban",
+			LabelNames: []string{"type"},
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.bannedPeerCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name: "banned_peer_times",
+			Help: "rbft peers banned for exceeding the rate limit",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.bannedPeersGauge, err = metricsProv.NewGauge(
+		metrics.GaugeOpts{
+			Name: "banned_peers",
+			Help: "rbft currently banned peers",
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.replayedMsgCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name:       "replayed_msgs",
+			Help:       "rbft consensus messages rejected by nonce as stale or duplicate",
+			LabelNames: []string{"reason"},
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.compressedPayloadBytes, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name:       "compressed_payload_bytes",
+			Help:       "rbft payload size of compressed messages before and after compression",
+			LabelNames: []string{"type", "stage"},
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.inboundQueueDepthGauge, err = metricsProv.NewGauge(
+		metrics.GaugeOpts{
+			Name:       "inbound_queue_depth",
+			Help:       "rbft queued inbound events of each class",
+			LabelNames: []string{"class"},
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
+	m.inboundDroppedMsgCounter, err = metricsProv.NewCounter(
+		metrics.CounterOpts{
+			Name:       "inbound_dropped_msgs",
+			Help:       "rbft inbound consensus messages dropped because of full queue or duplicate",
+			LabelNames: []string{"class", "reason"},
+		},
+	)
+	if err != nil {
+		return m, err
+	}
+
 	m.incomingLocalTxSets, err = metricsProv.NewCounter(
 		metrics.CounterOpts{
 			Name: "incoming_local_tx_sets",
@@ -632,9 +933,27 @@ func (rm *rbftMetrics) unregisterMetrics() {
 	if rm.cacheBatchNumber != nil {
 		rm.cacheBatchNumber.Unregister()
 	}
+	if rm.pipelineDepthGauge != nil {
+		rm.pipelineDepthGauge.Unregister()
+	}
+	if rm.pipelineFetchingGauge != nil {
+		rm.pipelineFetchingGauge.Unregister()
+	}
+	if rm.pipelineFullCounter != nil {
+		rm.pipelineFullCounter.Unregister()
+	}
+	if rm.fastPathCommitCounter != nil {
+		rm.fastPathCommitCounter.Unregister()
+	}
+	if rm.fastPathFallbackCounter != nil {
+		rm.fastPathFallbackCounter.Unregister()
+	}
 	if rm.stateUpdateCounter != nil {
 		rm.stateUpdateCounter.Unregister()
 	}
+	if rm.stateUpdateRetryCounter != nil {
+		rm.stateUpdateRetryCounter.Unregister()
+	}
 	if rm.fetchMissingTxsCounter != nil {
 		rm.fetchMissingTxsCounter.Unregister()
 	}
@@ -644,6 +963,54 @@ func (rm *rbftMetrics) unregisterMetrics() {
 	if rm.fetchRequestBatchCounter != nil {
 		rm.fetchRequestBatchCounter.Unregister()
 	}
+	if rm.fetchRetryCounter != nil {
+		rm.fetchRetryCounter.Unregister()
+	}
+	if rm.invalidFetchResponseCounter != nil {
+		rm.invalidFetchResponseCounter.Unregister()
+	}
+	if rm.fetchInFlightGauge != nil {
+		rm.fetchInFlightGauge.Unregister()
+	}
+	if rm.snapshotSyncChunksGauge != nil {
+		rm.snapshotSyncChunksGauge.Unregister()
+	}
+	if rm.keyRotationCounter != nil {
+		rm.keyRotationCounter.Unregister()
+	}
+	if rm.rejectedBatchCounter != nil {
+		rm.rejectedBatchCounter.Unregister()
+	}
+	if rm.batchPolicyCutCounter != nil {
+		rm.batchPolicyCutCounter.Unregister()
+	}
+	if rm.oversizedTxCounter != nil {
+		rm.oversizedTxCounter.Unregister()
+	}
+	if rm.reorderedBatchCounter != nil {
+		rm.reorderedBatchCounter.Unregister()
+	}
+	if rm.rateLimitedMsgCounter != nil {
+		rm.rateLimitedMsgCounter.Unregister()
+	}
+	if rm.bannedPeerCounter != nil {
+		rm.bannedPeerCounter.Unregister()
+	}
+	if rm.bannedPeersGauge != nil {
+		rm.bannedPeersGauge.Unregister()
+	}
+	if rm.replayedMsgCounter != nil {
+		rm.replayedMsgCounter.Unregister()
+	}
+	if rm.compressedPayloadBytes != nil {
+		rm.compressedPayloadBytes.Unregister()
+	}
+	if rm.inboundQueueDepthGauge != nil {
+		rm.inboundQueueDepthGauge.Unregister()
+	}
+	if rm.inboundDroppedMsgCounter != nil {
+		rm.inboundDroppedMsgCounter.Unregister()
+	}
 	if rm.incomingLocalTxSets != nil {
 		rm.incomingLocalTxSets.Unregister()
 	}
@@ -410,6 +410,108 @@ func (c *MockCryptoVerifyCall) DoAndReturn(f func(uint64, []byte, []byte) error)
 	return c
 }
 
+// MockVRF is a mock of VRF interface.
+type MockVRF struct {
+	ctrl     *gomock.Controller
+	recorder *MockVRFMockRecorder
+}
+
+// MockVRFMockRecorder is the mock recorder for MockVRF.
+type MockVRFMockRecorder struct {
+	mock *MockVRF
+}
+
+// NewMockVRF creates a new mock instance.
+func NewMockVRF(ctrl *gomock.Controller) *MockVRF {
+	mock := &MockVRF{ctrl: ctrl}
+	mock.recorder = &MockVRFMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVRF) EXPECT() *MockVRFMockRecorder {
+	return m.recorder
+}
+
+// VRFProve mocks base method.
+func (m *MockVRF) VRFProve(msg []byte) ([]byte, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VRFProve", msg)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// VRFProve indicates an expected call of VRFProve.
+func (mr *MockVRFMockRecorder) VRFProve(msg any) *MockVRFVRFProveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VRFProve", reflect.TypeOf((*MockVRF)(nil).VRFProve), msg)
+	return &MockVRFVRFProveCall{Call: call}
+}
+
+// MockVRFVRFProveCall wrap *gomock.Call
+type MockVRFVRFProveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVRFVRFProveCall) Return(output, proof []byte, err error) *MockVRFVRFProveCall {
+	c.Call = c.Call.Return(output, proof, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVRFVRFProveCall) Do(f func([]byte) ([]byte, []byte, error)) *MockVRFVRFProveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVRFVRFProveCall) DoAndReturn(f func([]byte) ([]byte, []byte, error)) *MockVRFVRFProveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// VRFVerify mocks base method.
+func (m *MockVRF) VRFVerify(nodeID uint64, msg, proof []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VRFVerify", nodeID, msg, proof)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VRFVerify indicates an expected call of VRFVerify.
+func (mr *MockVRFMockRecorder) VRFVerify(nodeID, msg, proof any) *MockVRFVRFVerifyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VRFVerify", reflect.TypeOf((*MockVRF)(nil).VRFVerify), nodeID, msg, proof)
+	return &MockVRFVRFVerifyCall{Call: call}
+}
+
+// MockVRFVRFVerifyCall wrap *gomock.Call
+type MockVRFVRFVerifyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVRFVRFVerifyCall) Return(arg0 []byte, arg1 error) *MockVRFVRFVerifyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVRFVRFVerifyCall) Do(f func(uint64, []byte, []byte) ([]byte, error)) *MockVRFVRFVerifyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVRFVRFVerifyCall) DoAndReturn(f func(uint64, []byte, []byte) ([]byte, error)) *MockVRFVRFVerifyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockServiceOutbound is a mock of ServiceOutbound interface.
 type MockServiceOutbound[T any, Constraint types0.TXConstraint[T]] struct {
 	ctrl     *gomock.Controller
@@ -556,6 +658,136 @@ func (c *MockServiceOutboundStateUpdateCall[T, Constraint]) DoAndReturn(f func(u
 	return c
 }
 
+// MockBatchValidator is a mock of BatchValidator interface.
+type MockBatchValidator[T any, Constraint types0.TXConstraint[T]] struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchValidatorMockRecorder[T, Constraint]
+}
+
+// MockBatchValidatorMockRecorder is the mock recorder for MockBatchValidator.
+type MockBatchValidatorMockRecorder[T any, Constraint types0.TXConstraint[T]] struct {
+	mock *MockBatchValidator[T, Constraint]
+}
+
+// NewMockBatchValidator creates a new mock instance.
+func NewMockBatchValidator[T any, Constraint types0.TXConstraint[T]](ctrl *gomock.Controller) *MockBatchValidator[T, Constraint] {
+	mock := &MockBatchValidator[T, Constraint]{ctrl: ctrl}
+	mock.recorder = &MockBatchValidatorMockRecorder[T, Constraint]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchValidator[T, Constraint]) EXPECT() *MockBatchValidatorMockRecorder[T, Constraint] {
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockBatchValidator[T, Constraint]) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// ValidateBatch mocks base method.
+func (m *MockBatchValidator[T, Constraint]) ValidateBatch(txs []*T, seqNo uint64, timestamp int64, proposerNodeID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateBatch", txs, seqNo, timestamp, proposerNodeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateBatch indicates an expected call of ValidateBatch.
+func (mr *MockBatchValidatorMockRecorder[T, Constraint]) ValidateBatch(txs, seqNo, timestamp, proposerNodeID any) *MockBatchValidatorValidateBatchCall[T, Constraint] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBatch", reflect.TypeOf((*MockBatchValidator[T, Constraint])(nil).ValidateBatch), txs, seqNo, timestamp, proposerNodeID)
+	return &MockBatchValidatorValidateBatchCall[T, Constraint]{Call: call}
+}
+
+// MockBatchValidatorValidateBatchCall wrap *gomock.Call
+type MockBatchValidatorValidateBatchCall[T any, Constraint types0.TXConstraint[T]] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBatchValidatorValidateBatchCall[T, Constraint]) Return(arg0 error) *MockBatchValidatorValidateBatchCall[T, Constraint] {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBatchValidatorValidateBatchCall[T, Constraint]) Do(f func([]*T, uint64, int64, uint64) error) *MockBatchValidatorValidateBatchCall[T, Constraint] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBatchValidatorValidateBatchCall[T, Constraint]) DoAndReturn(f func([]*T, uint64, int64, uint64) error) *MockBatchValidatorValidateBatchCall[T, Constraint] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStateUpdateTracker is a mock of StateUpdateTracker interface.
+type MockStateUpdateTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateUpdateTrackerMockRecorder
+}
+
+// MockStateUpdateTrackerMockRecorder is the mock recorder for MockStateUpdateTracker.
+type MockStateUpdateTrackerMockRecorder struct {
+	mock *MockStateUpdateTracker
+}
+
+// NewMockStateUpdateTracker creates a new mock instance.
+func NewMockStateUpdateTracker(ctrl *gomock.Controller) *MockStateUpdateTracker {
+	mock := &MockStateUpdateTracker{ctrl: ctrl}
+	mock.recorder = &MockStateUpdateTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStateUpdateTracker) EXPECT() *MockStateUpdateTrackerMockRecorder {
+	return m.recorder
+}
+
+// TrackedStateUpdate mocks base method.
+func (m *MockStateUpdateTracker) TrackedStateUpdate(requestID, localLowWatermark, seqNo uint64, digest string, checkpoints []*consensus.SignedCheckpoint, epochChanges ...*consensus.EpochChange) {
+	m.ctrl.T.Helper()
+	varargs := []any{requestID, localLowWatermark, seqNo, digest, checkpoints}
+	for _, a := range epochChanges {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "TrackedStateUpdate", varargs...)
+}
+
+// TrackedStateUpdate indicates an expected call of TrackedStateUpdate.
+func (mr *MockStateUpdateTrackerMockRecorder) TrackedStateUpdate(requestID, localLowWatermark, seqNo, digest, checkpoints any, epochChanges ...any) *MockStateUpdateTrackerTrackedStateUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{requestID, localLowWatermark, seqNo, digest, checkpoints}, epochChanges...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackedStateUpdate", reflect.TypeOf((*MockStateUpdateTracker)(nil).TrackedStateUpdate), varargs...)
+	return &MockStateUpdateTrackerTrackedStateUpdateCall{Call: call}
+}
+
+// MockStateUpdateTrackerTrackedStateUpdateCall wrap *gomock.Call
+type MockStateUpdateTrackerTrackedStateUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateUpdateTrackerTrackedStateUpdateCall) Return() *MockStateUpdateTrackerTrackedStateUpdateCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateUpdateTrackerTrackedStateUpdateCall) Do(f func(uint64, uint64, uint64, string, []*consensus.SignedCheckpoint, ...*consensus.EpochChange)) *MockStateUpdateTrackerTrackedStateUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateUpdateTrackerTrackedStateUpdateCall) DoAndReturn(f func(uint64, uint64, uint64, string, []*consensus.SignedCheckpoint, ...*consensus.EpochChange)) *MockStateUpdateTrackerTrackedStateUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockEpochService is a mock of EpochService interface.
 type MockEpochService struct {
 	ctrl     *gomock.Controller
@@ -739,6 +971,72 @@ func (c *MockEpochServiceStoreEpochStateCall) DoAndReturn(f func(string, []byte)
 	return c
 }
 
+// MockEpochStatePruner is a mock of EpochStatePruner interface.
+type MockEpochStatePruner struct {
+	ctrl     *gomock.Controller
+	recorder *MockEpochStatePrunerMockRecorder
+}
+
+// MockEpochStatePrunerMockRecorder is the mock recorder for MockEpochStatePruner.
+type MockEpochStatePrunerMockRecorder struct {
+	mock *MockEpochStatePruner
+}
+
+// NewMockEpochStatePruner creates a new mock instance.
+func NewMockEpochStatePruner(ctrl *gomock.Controller) *MockEpochStatePruner {
+	mock := &MockEpochStatePruner{ctrl: ctrl}
+	mock.recorder = &MockEpochStatePrunerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEpochStatePruner) EXPECT() *MockEpochStatePrunerMockRecorder {
+	return m.recorder
+}
+
+// ISGOMOCK indicates that this struct is a gomock mock.
+func (m *MockEpochStatePruner) ISGOMOCK() struct{} {
+	return struct{}{}
+}
+
+// DelEpochState mocks base method.
+func (m *MockEpochStatePruner) DelEpochState(key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelEpochState", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelEpochState indicates an expected call of DelEpochState.
+func (mr *MockEpochStatePrunerMockRecorder) DelEpochState(key any) *MockEpochStatePrunerDelEpochStateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelEpochState", reflect.TypeOf((*MockEpochStatePruner)(nil).DelEpochState), key)
+	return &MockEpochStatePrunerDelEpochStateCall{Call: call}
+}
+
+// MockEpochStatePrunerDelEpochStateCall wrap *gomock.Call
+type MockEpochStatePrunerDelEpochStateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEpochStatePrunerDelEpochStateCall) Return(arg0 error) *MockEpochStatePrunerDelEpochStateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEpochStatePrunerDelEpochStateCall) Do(f func(string) error) *MockEpochStatePrunerDelEpochStateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEpochStatePrunerDelEpochStateCall) DoAndReturn(f func(string) error) *MockEpochStatePrunerDelEpochStateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockNodeService is a mock of NodeService interface.
 type MockNodeService struct {
 	ctrl     *gomock.Controller
@@ -85,6 +85,45 @@ func (c *MockNodeArchiveModeCall[T, Constraint]) DoAndReturn(f func() bool) *Moc
 	return c
 }
 
+// GetEpochChangeProof mocks base method.
+func (m *MockNode[T, Constraint]) GetEpochChangeProof(startEpoch, endEpoch uint64) (*consensus.EpochChangeProof, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEpochChangeProof", startEpoch, endEpoch)
+	ret0, _ := ret[0].(*consensus.EpochChangeProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEpochChangeProof indicates an expected call of GetEpochChangeProof.
+func (mr *MockNodeMockRecorder[T, Constraint]) GetEpochChangeProof(startEpoch, endEpoch any) *MockNodeGetEpochChangeProofCall[T, Constraint] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpochChangeProof", reflect.TypeOf((*MockNode[T, Constraint])(nil).GetEpochChangeProof), startEpoch, endEpoch)
+	return &MockNodeGetEpochChangeProofCall[T, Constraint]{Call: call}
+}
+
+// MockNodeGetEpochChangeProofCall wrap *gomock.Call
+type MockNodeGetEpochChangeProofCall[T any, Constraint types0.TXConstraint[T]] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNodeGetEpochChangeProofCall[T, Constraint]) Return(arg0 *consensus.EpochChangeProof, arg1 error) *MockNodeGetEpochChangeProofCall[T, Constraint] {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNodeGetEpochChangeProofCall[T, Constraint]) Do(f func(uint64, uint64) (*consensus.EpochChangeProof, error)) *MockNodeGetEpochChangeProofCall[T, Constraint] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNodeGetEpochChangeProofCall[T, Constraint]) DoAndReturn(f func(uint64, uint64) (*consensus.EpochChangeProof, error)) *MockNodeGetEpochChangeProofCall[T, Constraint] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetLowWatermark mocks base method.
 func (m *MockNode[T, Constraint]) GetLowWatermark() uint64 {
 	m.ctrl.T.Helper()
@@ -123,6 +162,45 @@ func (c *MockNodeGetLowWatermarkCall[T, Constraint]) DoAndReturn(f func() uint64
 	return c
 }
 
+// GetQuorumCheckpoint mocks base method.
+func (m *MockNode[T, Constraint]) GetQuorumCheckpoint(epoch uint64) (*consensus.QuorumCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuorumCheckpoint", epoch)
+	ret0, _ := ret[0].(*consensus.QuorumCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuorumCheckpoint indicates an expected call of GetQuorumCheckpoint.
+func (mr *MockNodeMockRecorder[T, Constraint]) GetQuorumCheckpoint(epoch any) *MockNodeGetQuorumCheckpointCall[T, Constraint] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuorumCheckpoint", reflect.TypeOf((*MockNode[T, Constraint])(nil).GetQuorumCheckpoint), epoch)
+	return &MockNodeGetQuorumCheckpointCall[T, Constraint]{Call: call}
+}
+
+// MockNodeGetQuorumCheckpointCall wrap *gomock.Call
+type MockNodeGetQuorumCheckpointCall[T any, Constraint types0.TXConstraint[T]] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNodeGetQuorumCheckpointCall[T, Constraint]) Return(arg0 *consensus.QuorumCheckpoint, arg1 error) *MockNodeGetQuorumCheckpointCall[T, Constraint] {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNodeGetQuorumCheckpointCall[T, Constraint]) Do(f func(uint64) (*consensus.QuorumCheckpoint, error)) *MockNodeGetQuorumCheckpointCall[T, Constraint] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNodeGetQuorumCheckpointCall[T, Constraint]) DoAndReturn(f func(uint64) (*consensus.QuorumCheckpoint, error)) *MockNodeGetQuorumCheckpointCall[T, Constraint] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUncommittedTransactions mocks base method.
 func (m *MockNode[T, Constraint]) GetUncommittedTransactions(maxsize uint64) []*T {
 	m.ctrl.T.Helper()
@@ -199,6 +277,45 @@ func (c *MockNodeInitCall[T, Constraint]) DoAndReturn(f func() error) *MockNodeI
 	return c
 }
 
+// LeaderSchedule mocks base method.
+func (m *MockNode[T, Constraint]) LeaderSchedule(fromView, count uint64) (*LeaderSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaderSchedule", fromView, count)
+	ret0, _ := ret[0].(*LeaderSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaderSchedule indicates an expected call of LeaderSchedule.
+func (mr *MockNodeMockRecorder[T, Constraint]) LeaderSchedule(fromView, count any) *MockNodeLeaderScheduleCall[T, Constraint] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaderSchedule", reflect.TypeOf((*MockNode[T, Constraint])(nil).LeaderSchedule), fromView, count)
+	return &MockNodeLeaderScheduleCall[T, Constraint]{Call: call}
+}
+
+// MockNodeLeaderScheduleCall wrap *gomock.Call
+type MockNodeLeaderScheduleCall[T any, Constraint types0.TXConstraint[T]] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNodeLeaderScheduleCall[T, Constraint]) Return(arg0 *LeaderSchedule, arg1 error) *MockNodeLeaderScheduleCall[T, Constraint] {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNodeLeaderScheduleCall[T, Constraint]) Do(f func(uint64, uint64) (*LeaderSchedule, error)) *MockNodeLeaderScheduleCall[T, Constraint] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNodeLeaderScheduleCall[T, Constraint]) DoAndReturn(f func(uint64, uint64) (*LeaderSchedule, error)) *MockNodeLeaderScheduleCall[T, Constraint] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReportExecuted mocks base method.
 func (m *MockNode[T, Constraint]) ReportExecuted(state *types.ServiceState) {
 	m.ctrl.T.Helper()
@@ -235,6 +352,42 @@ func (c *MockNodeReportExecutedCall[T, Constraint]) DoAndReturn(f func(*types.Se
 	return c
 }
 
+// ReportStateUpdateProgress mocks base method.
+func (m *MockNode[T, Constraint]) ReportStateUpdateProgress(progress *types.StateUpdateProgress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReportStateUpdateProgress", progress)
+}
+
+// ReportStateUpdateProgress indicates an expected call of ReportStateUpdateProgress.
+func (mr *MockNodeMockRecorder[T, Constraint]) ReportStateUpdateProgress(progress any) *MockNodeReportStateUpdateProgressCall[T, Constraint] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportStateUpdateProgress", reflect.TypeOf((*MockNode[T, Constraint])(nil).ReportStateUpdateProgress), progress)
+	return &MockNodeReportStateUpdateProgressCall[T, Constraint]{Call: call}
+}
+
+// MockNodeReportStateUpdateProgressCall wrap *gomock.Call
+type MockNodeReportStateUpdateProgressCall[T any, Constraint types0.TXConstraint[T]] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNodeReportStateUpdateProgressCall[T, Constraint]) Return() *MockNodeReportStateUpdateProgressCall[T, Constraint] {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNodeReportStateUpdateProgressCall[T, Constraint]) Do(f func(*types.StateUpdateProgress)) *MockNodeReportStateUpdateProgressCall[T, Constraint] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNodeReportStateUpdateProgressCall[T, Constraint]) DoAndReturn(f func(*types.StateUpdateProgress)) *MockNodeReportStateUpdateProgressCall[T, Constraint] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReportStateUpdated mocks base method.
 func (m *MockNode[T, Constraint]) ReportStateUpdated(state *types.ServiceSyncState) {
 	m.ctrl.T.Helper()
@@ -271,6 +424,44 @@ func (c *MockNodeReportStateUpdatedCall[T, Constraint]) DoAndReturn(f func(*type
 	return c
 }
 
+// RotateKey mocks base method.
+func (m *MockNode[T, Constraint]) RotateKey(pubKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateKey", pubKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateKey indicates an expected call of RotateKey.
+func (mr *MockNodeMockRecorder[T, Constraint]) RotateKey(pubKey any) *MockNodeRotateKeyCall[T, Constraint] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockNode[T, Constraint])(nil).RotateKey), pubKey)
+	return &MockNodeRotateKeyCall[T, Constraint]{Call: call}
+}
+
+// MockNodeRotateKeyCall wrap *gomock.Call
+type MockNodeRotateKeyCall[T any, Constraint types0.TXConstraint[T]] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNodeRotateKeyCall[T, Constraint]) Return(arg0 error) *MockNodeRotateKeyCall[T, Constraint] {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNodeRotateKeyCall[T, Constraint]) Do(f func([]byte) error) *MockNodeRotateKeyCall[T, Constraint] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNodeRotateKeyCall[T, Constraint]) DoAndReturn(f func([]byte) error) *MockNodeRotateKeyCall[T, Constraint] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Start mocks base method.
 func (m *MockNode[T, Constraint]) Start() error {
 	m.ctrl.T.Helper()
@@ -419,6 +610,44 @@ func (c *MockNodeStopCall[T, Constraint]) DoAndReturn(f func()) *MockNodeStopCal
 	return c
 }
 
+// TransferLeadership mocks base method.
+func (m *MockNode[T, Constraint]) TransferLeadership() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeadership")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferLeadership indicates an expected call of TransferLeadership.
+func (mr *MockNodeMockRecorder[T, Constraint]) TransferLeadership() *MockNodeTransferLeadershipCall[T, Constraint] {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeadership", reflect.TypeOf((*MockNode[T, Constraint])(nil).TransferLeadership))
+	return &MockNodeTransferLeadershipCall[T, Constraint]{Call: call}
+}
+
+// MockNodeTransferLeadershipCall wrap *gomock.Call
+type MockNodeTransferLeadershipCall[T any, Constraint types0.TXConstraint[T]] struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNodeTransferLeadershipCall[T, Constraint]) Return(arg0 error) *MockNodeTransferLeadershipCall[T, Constraint] {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNodeTransferLeadershipCall[T, Constraint]) Do(f func() error) *MockNodeTransferLeadershipCall[T, Constraint] {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNodeTransferLeadershipCall[T, Constraint]) DoAndReturn(f func() error) *MockNodeTransferLeadershipCall[T, Constraint] {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockServiceInbound is a mock of ServiceInbound interface.
 type MockServiceInbound struct {
 	ctrl     *gomock.Controller
@@ -483,6 +712,42 @@ func (c *MockServiceInboundReportExecutedCall) DoAndReturn(f func(*types.Service
 	return c
 }
 
+// ReportStateUpdateProgress mocks base method.
+func (m *MockServiceInbound) ReportStateUpdateProgress(progress *types.StateUpdateProgress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReportStateUpdateProgress", progress)
+}
+
+// ReportStateUpdateProgress indicates an expected call of ReportStateUpdateProgress.
+func (mr *MockServiceInboundMockRecorder) ReportStateUpdateProgress(progress any) *MockServiceInboundReportStateUpdateProgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportStateUpdateProgress", reflect.TypeOf((*MockServiceInbound)(nil).ReportStateUpdateProgress), progress)
+	return &MockServiceInboundReportStateUpdateProgressCall{Call: call}
+}
+
+// MockServiceInboundReportStateUpdateProgressCall wrap *gomock.Call
+type MockServiceInboundReportStateUpdateProgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceInboundReportStateUpdateProgressCall) Return() *MockServiceInboundReportStateUpdateProgressCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceInboundReportStateUpdateProgressCall) Do(f func(*types.StateUpdateProgress)) *MockServiceInboundReportStateUpdateProgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceInboundReportStateUpdateProgressCall) DoAndReturn(f func(*types.StateUpdateProgress)) *MockServiceInboundReportStateUpdateProgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReportStateUpdated mocks base method.
 func (m *MockServiceInbound) ReportStateUpdated(state *types.ServiceSyncState) {
 	m.ctrl.T.Helper()
@@ -585,6 +850,45 @@ func (c *MockInboundNodeArchiveModeCall) DoAndReturn(f func() bool) *MockInbound
 	return c
 }
 
+// GetEpochChangeProof mocks base method.
+func (m *MockInboundNode) GetEpochChangeProof(startEpoch, endEpoch uint64) (*consensus.EpochChangeProof, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEpochChangeProof", startEpoch, endEpoch)
+	ret0, _ := ret[0].(*consensus.EpochChangeProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEpochChangeProof indicates an expected call of GetEpochChangeProof.
+func (mr *MockInboundNodeMockRecorder) GetEpochChangeProof(startEpoch, endEpoch any) *MockInboundNodeGetEpochChangeProofCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpochChangeProof", reflect.TypeOf((*MockInboundNode)(nil).GetEpochChangeProof), startEpoch, endEpoch)
+	return &MockInboundNodeGetEpochChangeProofCall{Call: call}
+}
+
+// MockInboundNodeGetEpochChangeProofCall wrap *gomock.Call
+type MockInboundNodeGetEpochChangeProofCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInboundNodeGetEpochChangeProofCall) Return(arg0 *consensus.EpochChangeProof, arg1 error) *MockInboundNodeGetEpochChangeProofCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInboundNodeGetEpochChangeProofCall) Do(f func(uint64, uint64) (*consensus.EpochChangeProof, error)) *MockInboundNodeGetEpochChangeProofCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInboundNodeGetEpochChangeProofCall) DoAndReturn(f func(uint64, uint64) (*consensus.EpochChangeProof, error)) *MockInboundNodeGetEpochChangeProofCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetLowWatermark mocks base method.
 func (m *MockInboundNode) GetLowWatermark() uint64 {
 	m.ctrl.T.Helper()
@@ -623,6 +927,45 @@ func (c *MockInboundNodeGetLowWatermarkCall) DoAndReturn(f func() uint64) *MockI
 	return c
 }
 
+// GetQuorumCheckpoint mocks base method.
+func (m *MockInboundNode) GetQuorumCheckpoint(epoch uint64) (*consensus.QuorumCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuorumCheckpoint", epoch)
+	ret0, _ := ret[0].(*consensus.QuorumCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuorumCheckpoint indicates an expected call of GetQuorumCheckpoint.
+func (mr *MockInboundNodeMockRecorder) GetQuorumCheckpoint(epoch any) *MockInboundNodeGetQuorumCheckpointCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuorumCheckpoint", reflect.TypeOf((*MockInboundNode)(nil).GetQuorumCheckpoint), epoch)
+	return &MockInboundNodeGetQuorumCheckpointCall{Call: call}
+}
+
+// MockInboundNodeGetQuorumCheckpointCall wrap *gomock.Call
+type MockInboundNodeGetQuorumCheckpointCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInboundNodeGetQuorumCheckpointCall) Return(arg0 *consensus.QuorumCheckpoint, arg1 error) *MockInboundNodeGetQuorumCheckpointCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInboundNodeGetQuorumCheckpointCall) Do(f func(uint64) (*consensus.QuorumCheckpoint, error)) *MockInboundNodeGetQuorumCheckpointCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInboundNodeGetQuorumCheckpointCall) DoAndReturn(f func(uint64) (*consensus.QuorumCheckpoint, error)) *MockInboundNodeGetQuorumCheckpointCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Init mocks base method.
 func (m *MockInboundNode) Init() error {
 	m.ctrl.T.Helper()
@@ -661,6 +1004,45 @@ func (c *MockInboundNodeInitCall) DoAndReturn(f func() error) *MockInboundNodeIn
 	return c
 }
 
+// LeaderSchedule mocks base method.
+func (m *MockInboundNode) LeaderSchedule(fromView, count uint64) (*LeaderSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaderSchedule", fromView, count)
+	ret0, _ := ret[0].(*LeaderSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaderSchedule indicates an expected call of LeaderSchedule.
+func (mr *MockInboundNodeMockRecorder) LeaderSchedule(fromView, count any) *MockInboundNodeLeaderScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaderSchedule", reflect.TypeOf((*MockInboundNode)(nil).LeaderSchedule), fromView, count)
+	return &MockInboundNodeLeaderScheduleCall{Call: call}
+}
+
+// MockInboundNodeLeaderScheduleCall wrap *gomock.Call
+type MockInboundNodeLeaderScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInboundNodeLeaderScheduleCall) Return(arg0 *LeaderSchedule, arg1 error) *MockInboundNodeLeaderScheduleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInboundNodeLeaderScheduleCall) Do(f func(uint64, uint64) (*LeaderSchedule, error)) *MockInboundNodeLeaderScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInboundNodeLeaderScheduleCall) DoAndReturn(f func(uint64, uint64) (*LeaderSchedule, error)) *MockInboundNodeLeaderScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReportExecuted mocks base method.
 func (m *MockInboundNode) ReportExecuted(state *types.ServiceState) {
 	m.ctrl.T.Helper()
@@ -697,6 +1079,42 @@ func (c *MockInboundNodeReportExecutedCall) DoAndReturn(f func(*types.ServiceSta
 	return c
 }
 
+// ReportStateUpdateProgress mocks base method.
+func (m *MockInboundNode) ReportStateUpdateProgress(progress *types.StateUpdateProgress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReportStateUpdateProgress", progress)
+}
+
+// ReportStateUpdateProgress indicates an expected call of ReportStateUpdateProgress.
+func (mr *MockInboundNodeMockRecorder) ReportStateUpdateProgress(progress any) *MockInboundNodeReportStateUpdateProgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportStateUpdateProgress", reflect.TypeOf((*MockInboundNode)(nil).ReportStateUpdateProgress), progress)
+	return &MockInboundNodeReportStateUpdateProgressCall{Call: call}
+}
+
+// MockInboundNodeReportStateUpdateProgressCall wrap *gomock.Call
+type MockInboundNodeReportStateUpdateProgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInboundNodeReportStateUpdateProgressCall) Return() *MockInboundNodeReportStateUpdateProgressCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInboundNodeReportStateUpdateProgressCall) Do(f func(*types.StateUpdateProgress)) *MockInboundNodeReportStateUpdateProgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInboundNodeReportStateUpdateProgressCall) DoAndReturn(f func(*types.StateUpdateProgress)) *MockInboundNodeReportStateUpdateProgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReportStateUpdated mocks base method.
 func (m *MockInboundNode) ReportStateUpdated(state *types.ServiceSyncState) {
 	m.ctrl.T.Helper()
@@ -733,6 +1151,44 @@ func (c *MockInboundNodeReportStateUpdatedCall) DoAndReturn(f func(*types.Servic
 	return c
 }
 
+// RotateKey mocks base method.
+func (m *MockInboundNode) RotateKey(pubKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateKey", pubKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateKey indicates an expected call of RotateKey.
+func (mr *MockInboundNodeMockRecorder) RotateKey(pubKey any) *MockInboundNodeRotateKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockInboundNode)(nil).RotateKey), pubKey)
+	return &MockInboundNodeRotateKeyCall{Call: call}
+}
+
+// MockInboundNodeRotateKeyCall wrap *gomock.Call
+type MockInboundNodeRotateKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInboundNodeRotateKeyCall) Return(arg0 error) *MockInboundNodeRotateKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInboundNodeRotateKeyCall) Do(f func([]byte) error) *MockInboundNodeRotateKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInboundNodeRotateKeyCall) DoAndReturn(f func([]byte) error) *MockInboundNodeRotateKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Start mocks base method.
 func (m *MockInboundNode) Start() error {
 	m.ctrl.T.Helper()
@@ -880,3 +1336,41 @@ func (c *MockInboundNodeStopCall) DoAndReturn(f func()) *MockInboundNodeStopCall
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// TransferLeadership mocks base method.
+func (m *MockInboundNode) TransferLeadership() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeadership")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferLeadership indicates an expected call of TransferLeadership.
+func (mr *MockInboundNodeMockRecorder) TransferLeadership() *MockInboundNodeTransferLeadershipCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeadership", reflect.TypeOf((*MockInboundNode)(nil).TransferLeadership))
+	return &MockInboundNodeTransferLeadershipCall{Call: call}
+}
+
+// MockInboundNodeTransferLeadershipCall wrap *gomock.Call
+type MockInboundNodeTransferLeadershipCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInboundNodeTransferLeadershipCall) Return(arg0 error) *MockInboundNodeTransferLeadershipCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInboundNodeTransferLeadershipCall) Do(f func() error) *MockInboundNodeTransferLeadershipCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInboundNodeTransferLeadershipCall) DoAndReturn(f func() error) *MockInboundNodeTransferLeadershipCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
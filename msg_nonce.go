@@ -0,0 +1,169 @@
+package rbft
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/axiomesh/axiom-bft/common"
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+// peerNonceKeyPrefix is the storage key prefix of the persisted highest nonce of each peer.
+const peerNonceKeyPrefix = "peer.nonce."
+
+// maxMsgNonceDrift bounds how far the nonce of a message can be ahead of local time, as nonces of
+// peers start from their local time in nanoseconds. Messages with nonce beyond it are accepted but not
+// tracked, so that a forged nonce can't raise the highest nonce of a peer and reject its messages.
+const maxMsgNonceDrift = time.Minute
+
+// envelopeDigest returns the digest of the envelope of given message signed by its sender, which binds
+// the nonce to the sender, type, epoch and uncompressed payload of the message.
+func envelopeDigest(msg *consensus.ConsensusMessage) []byte {
+	payload := sha256.Sum256(msg.Payload)
+	h := sha256.New()
+	var buf [8]byte
+	for _, v := range []uint64{msg.From, uint64(msg.Nonce), uint64(msg.Type), msg.Epoch} {
+		binary.LittleEndian.PutUint64(buf[:], v)
+		h.Write(buf[:])
+	}
+	h.Write(payload[:])
+	return h.Sum(nil)
+}
+
+// peerNonceWindow tracks the nonces received from a peer in a sliding window below the highest one.
+type peerNonceWindow struct {
+	// nonces not larger than floor are rejected, which is the highest nonce restored from storage.
+	floor   int64
+	highest int64
+	seen    []bool
+}
+
+// msgNonceFilter rejects replayed or duplicated consensus messages by nonce. A message is accepted
+// only if its nonce is larger than the highest nonce of the sender, or within the window below the
+// highest nonce and not seen before, so that messages reordered by network are still accepted.
+// It's only accessed in the event loop, after the sender of message is checked to be a validator and
+// the envelope signature of tracked messages is verified, so that nonces can't be forged.
+type msgNonceFilter struct {
+	window int64
+	peers  map[uint64]*peerNonceWindow
+
+	now     func() time.Time
+	storage Storage
+	metrics *rbftMetrics
+	logger  common.Logger
+}
+
+func newMsgNonceFilter(c Config, storage Storage, metrics *rbftMetrics) *msgNonceFilter {
+	return &msgNonceFilter{
+		window:  int64(c.MsgNonceWindow),
+		peers:   make(map[uint64]*peerNonceWindow),
+		now:     time.Now,
+		storage: storage,
+		metrics: metrics,
+		logger:  c.Logger,
+	}
+}
+
+func (f *msgNonceFilter) peer(from uint64) *peerNonceWindow {
+	p, ok := f.peers[from]
+	if !ok {
+		p = &peerNonceWindow{seen: make([]bool, f.window)}
+		f.peers[from] = p
+	}
+	return p
+}
+
+// tracks returns whether the message with given nonce is tracked, whose envelope signature must be
+// verified before accepted.
+func (f *msgNonceFilter) tracks(nonce int64) bool {
+	return f.window > 0 && nonce > 0
+}
+
+// accept checks if the message with given nonce from given peer is neither replayed nor duplicated,
+// and marks the nonce as seen. Messages without nonce are always accepted.
+func (f *msgNonceFilter) accept(from uint64, nonce int64) bool {
+	if !f.tracks(nonce) {
+		return true
+	}
+	if nonce > f.now().Add(maxMsgNonceDrift).UnixNano() {
+		f.logger.Debugf("Not track msg from peer %d with nonce %d which is too far ahead", from, nonce)
+		return true
+	}
+
+	p := f.peer(from)
+	if nonce <= p.floor || nonce <= p.highest-f.window {
+		f.reject(from, nonce, "stale")
+		return false
+	}
+	if nonce > p.highest {
+		if nonce-p.highest >= f.window {
+			for i := range p.seen {
+				p.seen[i] = false
+			}
+		} else {
+			for n := p.highest + 1; n < nonce; n++ {
+				p.seen[n%f.window] = false
+			}
+		}
+		p.highest = nonce
+		p.seen[nonce%f.window] = true
+		return true
+	}
+	if p.seen[nonce%f.window] {
+		f.reject(from, nonce, "duplicate")
+		return false
+	}
+	p.seen[nonce%f.window] = true
+	return true
+}
+
+func (f *msgNonceFilter) reject(from uint64, nonce int64, reason string) {
+	f.metrics.replayedMsgCounter.With("reason", reason).Add(1)
+	f.logger.Debugf("Reject %s msg from peer %d with nonce %d", reason, from, nonce)
+}
+
+// persist stores the highest nonce of each peer, which is restored as the floor after restart so that
+// messages received before are not accepted again.
+func (f *msgNonceFilter) persist() {
+	if f.window <= 0 {
+		return
+	}
+	for id, p := range f.peers {
+		key := fmt.Sprintf("%s%d", peerNonceKeyPrefix, id)
+		if err := f.storage.StoreState(key, []byte(strconv.FormatInt(p.highest, 10))); err != nil {
+			f.logger.Errorf("Persist nonce of peer %d failed: %v", id, err)
+		}
+	}
+}
+
+// restore restores the persisted highest nonce of each peer as its floor.
+func (f *msgNonceFilter) restore() {
+	if f.window <= 0 {
+		return
+	}
+	nonces, err := f.storage.ReadStateSet(peerNonceKeyPrefix)
+	if err != nil {
+		f.logger.Debugf("Could not restore nonce of peers: %v", err)
+		return
+	}
+	for key, raw := range nonces {
+		var id uint64
+		if _, err = fmt.Sscanf(key, peerNonceKeyPrefix+"%d", &id); err != nil {
+			f.logger.Warningf("Could not restore nonce key %s", key)
+			continue
+		}
+		nonce, pErr := strconv.ParseInt(string(raw), 10, 64)
+		if pErr != nil {
+			f.logger.Warningf("Could not restore nonce of peer %d: %v", id, pErr)
+			continue
+		}
+		p := f.peer(id)
+		p.floor = nonce
+		if nonce > p.highest {
+			p.highest = nonce
+		}
+	}
+}
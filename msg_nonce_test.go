@@ -0,0 +1,169 @@
+package rbft
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/axiomesh/axiom-bft/common"
+	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-bft/common/metrics/disabled"
+)
+
+// envelopeExternal accepts signatures equal to the signed message only.
+type envelopeExternal struct {
+	ExternalStack[consensus.FltTransaction, *consensus.FltTransaction]
+}
+
+func (e *envelopeExternal) Verify(_ uint64, sig []byte, msg []byte) error {
+	if !bytes.Equal(sig, msg) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+func newTestMsgNonceFilter(t *testing.T, storage Storage, window int) *msgNonceFilter {
+	metrics, err := newRBFTMetrics(&disabled.Provider{})
+	assert.Nil(t, err)
+	return newMsgNonceFilter(Config{MsgNonceWindow: window, Logger: common.NewSimpleLogger()}, storage, metrics)
+}
+
+func TestMsgNonceFilter_accept(t *testing.T) {
+	f := newTestMsgNonceFilter(t, nil, 4)
+
+	// messages without nonce are always accepted.
+	assert.True(t, f.accept(1, 0))
+	assert.True(t, f.accept(1, 0))
+
+	assert.True(t, f.accept(1, 100))
+	assert.False(t, f.accept(1, 100))
+	// reordered messages within window are accepted once.
+	assert.True(t, f.accept(1, 103))
+	assert.True(t, f.accept(1, 101))
+	assert.False(t, f.accept(1, 101))
+	assert.True(t, f.accept(1, 102))
+	// messages out of window are rejected.
+	assert.False(t, f.accept(1, 99))
+	assert.True(t, f.accept(1, 110))
+	assert.False(t, f.accept(1, 106))
+	assert.True(t, f.accept(1, 107))
+	assert.False(t, f.accept(1, 103))
+
+	// nonces are tracked by peer.
+	assert.True(t, f.accept(2, 100))
+
+	// nonces too far ahead of local time are not tracked.
+	assert.True(t, f.accept(2, math.MaxInt64))
+	assert.True(t, f.accept(2, math.MaxInt64))
+	assert.True(t, f.accept(2, 101))
+
+	// filter is disabled without window.
+	f = newTestMsgNonceFilter(t, nil, 0)
+	assert.True(t, f.accept(1, 100))
+	assert.True(t, f.accept(1, 100))
+}
+
+func TestMsgNonceFilter_persist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	storage := NewMockStorage(ctrl)
+	store := make(map[string][]byte)
+	storage.EXPECT().StoreState(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, value []byte) error {
+		store[key] = value
+		return nil
+	}).AnyTimes()
+	storage.EXPECT().ReadStateSet(peerNonceKeyPrefix).DoAndReturn(func(string) (map[string][]byte, error) {
+		return store, nil
+	}).AnyTimes()
+
+	f := newTestMsgNonceFilter(t, storage, 4)
+	assert.True(t, f.accept(1, 100))
+	assert.True(t, f.accept(2, 200))
+	f.persist()
+	assert.Equal(t, []byte("100"), store[peerNonceKeyPrefix+"1"])
+	assert.Equal(t, []byte("200"), store[peerNonceKeyPrefix+"2"])
+	assert.NotContains(t, store, peerNonceKeyPrefix+"3")
+
+	// messages received before restart are rejected.
+	f = newTestMsgNonceFilter(t, storage, 4)
+	f.restore()
+	assert.False(t, f.accept(1, 100))
+	assert.False(t, f.accept(1, 99))
+	assert.False(t, f.accept(2, 200))
+	assert.True(t, f.accept(1, 101))
+	assert.True(t, f.accept(3, 1))
+}
+
+func TestMsgNonceFilter_consensusMessageFilter(t *testing.T) {
+	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	rbfts[0].config.MsgNonceWindow = 4
+	rbfts[0].nonceFilter = newTestMsgNonceFilter(t, nil, 4)
+	rbfts[0].external = &envelopeExternal{ExternalStack: rbfts[0].external}
+
+	rbfts[1].initSyncState()
+	syncState := nodes[1].broadcastMessageCache
+	assert.Equal(t, consensus.Type_SYNC_STATE, syncState.Type)
+	syncState.Nonce = time.Now().UnixNano()
+	syncState.Signature = envelopeDigest(syncState.ConsensusMessage)
+
+	// messages from non-validators are not tracked.
+	forged := syncState.CloneVT()
+	forged.From = 100
+	rbfts[0].processEvent(&consensusMessageWrapper{ctx: context.Background(), ConsensusMessage: forged})
+	assert.NotContains(t, rbfts[0].nonceFilter.peers, uint64(100))
+
+	// messages with forged nonce or without envelope signature are rejected and not tracked.
+	nodes[0].unicastMessageCache = nil
+	forged = syncState.CloneVT()
+	forged.Nonce++
+	rbfts[0].processEvent(&consensusMessageWrapper{ctx: context.Background(), ConsensusMessage: forged})
+	forged = syncState.CloneVT()
+	forged.Signature = nil
+	rbfts[0].processEvent(&consensusMessageWrapper{ctx: context.Background(), ConsensusMessage: forged})
+	assert.NotContains(t, rbfts[0].nonceFilter.peers, uint64(2))
+	assert.Nil(t, nodes[0].unicastMessageCache)
+
+	// messages from validators with envelope signature are tracked.
+	rbfts[0].processEvent(syncState)
+	assert.Equal(t, syncState.Nonce, rbfts[0].nonceFilter.peers[2].highest)
+	assert.NotNil(t, nodes[0].unicastMessageCache)
+	nodes[0].unicastMessageCache = nil
+	rbfts[0].processEvent(&consensusMessageWrapper{ctx: context.Background(), ConsensusMessage: syncState.CloneVT()})
+	assert.Nil(t, nodes[0].unicastMessageCache)
+}
+
+func TestPeerManager_nextNonce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	storage := NewMockStorage(ctrl)
+	store := make(map[string][]byte)
+	storage.EXPECT().StoreState(msgNonceKey, gomock.Any()).DoAndReturn(func(key string, value []byte) error {
+		store[key] = value
+		return nil
+	}).AnyTimes()
+	storage.EXPECT().ReadState(msgNonceKey).DoAndReturn(func(key string) ([]byte, error) {
+		if v, ok := store[key]; ok {
+			return v, nil
+		}
+		return nil, errors.New("not found")
+	}).AnyTimes()
+
+	conf := Config{Logger: common.NewSimpleLogger()}
+	m := newPeerManager(nil, nil, nil, storage, nil, conf, false)
+	first := m.nextNonce()
+	assert.Equal(t, first+1, m.nextNonce())
+	ceiling, err := strconv.ParseInt(string(store[msgNonceKey]), 10, 64)
+	assert.Nil(t, err)
+	assert.Equal(t, first+msgNonceReserveSize, ceiling)
+
+	// nonce never goes backwards after restart even if clock goes backwards.
+	m = newPeerManager(nil, nil, nil, storage, nil, conf, false)
+	m.msgNonce = first - 1000
+	assert.Equal(t, ceiling+1, m.nextNonce())
+}
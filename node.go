@@ -53,6 +53,13 @@ type ServiceInbound interface {
 	// Users must ReportStateUpdated after RBFT core invoked StateUpdate request no matter this request was
 	// finished successfully or not, otherwise, RBFT core will enter abnormal status infinitely.
 	ReportStateUpdated(state *types.ServiceSyncState)
+
+	// ReportStateUpdateProgress reports to RBFT core the progress of the StateUpdate request triggered by
+	// RBFT core before. RBFT core re-issues StateUpdate right away if the progress carries an error, and if
+	// there is no progress in StateUpdateTimeout when it's set. A re-issued StateUpdate supersedes the
+	// previous one which should be cancelled by users. Progress should carry the request id passed to
+	// StateUpdateTracker if it's implemented, progress of superseded requests is ignored.
+	ReportStateUpdateProgress(progress *types.StateUpdateProgress)
 }
 
 type InboundNode interface {
@@ -76,6 +83,32 @@ type InboundNode interface {
 	// GetLowWatermark return the low watermark of txpool
 	GetLowWatermark() uint64
 
+	// TransferLeadership asks current primary to hand over its leadership gracefully: it stops
+	// proposing new batches, waits for all in-flight batches to be committed and then broadcasts
+	// a signed LeaderTransfer to let replicas move to the next view without punishing it.
+	// It returns an error if current node cannot start a leader transfer.
+	TransferLeadership() error
+
+	// RotateKey announces a new consensus key of local validator signed by its current key. The new key
+	// is ordered by a batch and takes effect at the next stable checkpoint, when KeyRotator.ActivateKey is
+	// invoked to sign with it, and the current key is still accepted by others for one more checkpoint
+	// period. An announcement not ordered before restart must be made again. It returns an error if key
+	// rotation is disabled or a previous rotation is still pending.
+	RotateKey(pubKey []byte) error
+
+	// LeaderSchedule returns the projected primaries of count views from fromView under current
+	// validator dynamic info and proposer election type, with the nodes currently excluded from
+	// proposer election and the punished nodes.
+	LeaderSchedule(fromView uint64, count uint64) (*LeaderSchedule, error)
+
+	// GetEpochChangeProof returns the proof of epoch changes from startEpoch to endEpoch (exclusive) with
+	// persisted quorum checkpoints, which contains at most MaxNumEpochEndingCheckpoint epoch changes and
+	// sets More to endEpoch if there are more. endEpoch is capped at the epoch after the latest epoch change.
+	GetEpochChangeProof(startEpoch, endEpoch uint64) (*consensus.EpochChangeProof, error)
+
+	// GetQuorumCheckpoint returns the persisted quorum checkpoint which ends the given epoch.
+	GetQuorumCheckpoint(epoch uint64) (*consensus.QuorumCheckpoint, error)
+
 	ArchiveMode() bool
 }
 
@@ -95,8 +128,11 @@ type node[T any, Constraint types2.TXConstraint[T]] struct {
 	logger common.Logger
 }
 
-// NewNode initializes a Node service.
+// NewNode initializes a Node service, the consensus engine is chosen by Config.GetConsensusEngineTypeFn.
 func NewNode[T any, Constraint types2.TXConstraint[T]](c Config, external ExternalStack[T, Constraint], requestPool txpool.TxPool[T, Constraint]) (Node[T, Constraint], error) {
+	if c.GetConsensusEngineTypeFn != nil && c.GetConsensusEngineTypeFn(c.GenesisEpochInfo) == ConsensusEngineTypeChainedHotStuff {
+		return newHotStuffNode[T, Constraint](c, external, requestPool)
+	}
 	return newNode[T, Constraint](c, external, requestPool, false)
 }
 
@@ -192,6 +228,12 @@ func (n *node[T, Constraint]) ReportStateUpdated(state *types.ServiceSyncState)
 	n.rbft.reportStateUpdated(state)
 }
 
+// ReportStateUpdateProgress reports to RBFT core the progress of the StateUpdate request triggered by
+// RBFT core before.
+func (n *node[T, Constraint]) ReportStateUpdateProgress(progress *types.StateUpdateProgress) {
+	n.rbft.reportStateUpdateProgress(progress)
+}
+
 // Status returns the current node status of the RBFT state machine.
 func (n *node[T, Constraint]) Status() NodeStatus {
 	return n.rbft.getStatus()
@@ -223,6 +265,59 @@ func (n *node[T, Constraint]) GetLowWatermark() uint64 {
 	return <-getWatermarkReq.ch
 }
 
+func (n *node[T, Constraint]) LeaderSchedule(fromView uint64, count uint64) (*LeaderSchedule, error) {
+	if err := checkLeaderScheduleRange(fromView, count); err != nil {
+		return nil, err
+	}
+	leaderScheduleReq := &ReqLeaderScheduleMsg{
+		fromView: fromView,
+		count:    count,
+		ch:       make(chan *LeaderSchedule),
+	}
+	localEvent := &MiscEvent{
+		EventType: ReqLeaderScheduleEvent,
+		Event:     leaderScheduleReq,
+	}
+	n.rbft.postMsg(localEvent)
+
+	return <-leaderScheduleReq.ch, nil
+}
+
+func (n *node[T, Constraint]) TransferLeadership() error {
+	transferReq := &ReqTransferLeadershipMsg{
+		ch: make(chan error),
+	}
+	localEvent := &MiscEvent{
+		EventType: ReqTransferLeadershipEvent,
+		Event:     transferReq,
+	}
+	n.rbft.postMsg(localEvent)
+
+	return <-transferReq.ch
+}
+
+func (n *node[T, Constraint]) RotateKey(pubKey []byte) error {
+	rotateReq := &ReqRotateKeyMsg{
+		pubKey: pubKey,
+		ch:     make(chan error),
+	}
+	localEvent := &MiscEvent{
+		EventType: ReqRotateKeyEvent,
+		Event:     rotateReq,
+	}
+	n.rbft.postMsg(localEvent)
+
+	return <-rotateReq.ch
+}
+
+func (n *node[T, Constraint]) GetEpochChangeProof(startEpoch, endEpoch uint64) (*consensus.EpochChangeProof, error) {
+	return n.rbft.epochMgr.getEpochChangeProof(startEpoch, endEpoch)
+}
+
+func (n *node[T, Constraint]) GetQuorumCheckpoint(epoch uint64) (*consensus.QuorumCheckpoint, error) {
+	return n.rbft.epochMgr.getEpochQuorumCheckpoint(epoch)
+}
+
 func (n *node[T, Constraint]) ArchiveMode() bool {
 	return false
 }
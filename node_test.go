@@ -2,6 +2,7 @@ package rbft
 
 import (
 	"context"
+	"math"
 	"sync"
 	"testing"
 
@@ -195,3 +196,35 @@ func TestNode_getCurrentState(t *testing.T) {
 	}
 	assert.Equal(t, expState, n.getCurrentState())
 }
+
+func TestNode_LeaderSchedule(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	n := rbfts[0].node
+	n.currentState = &types.ServiceState{
+		MetaState: &types.MetaState{
+			Height: uint64(0),
+			Digest: "XXX GENESIS",
+		},
+	}
+	rbfts[0].chainConfig.ValidatorDynamicInfoMap[2].ConsensusVotingPowerReduced = true
+	rbfts[0].chainConfig.ValidatorDynamicInfoMap[2].ConsensusVotingPowerReduceView = 1
+	_ = n.Start()
+	defer n.Stop()
+
+	_, err := n.LeaderSchedule(0, 0)
+	assert.NotNil(t, err)
+	_, err = n.LeaderSchedule(0, maxLeaderScheduleCount+1)
+	assert.NotNil(t, err)
+	_, err = n.LeaderSchedule(math.MaxUint64, 2)
+	assert.NotNil(t, err)
+
+	schedule, err := n.LeaderSchedule(2, 6)
+	assert.Nil(t, err)
+	assert.Equal(t, n.Status().View, schedule.View)
+	assert.Equal(t, uint64(2), schedule.FromView)
+	assert.Equal(t, []uint64{3, 4, 1, 2, 3, 4}, schedule.Primaries)
+	assert.Equal(t, 0, len(schedule.ExcludedNodes))
+	assert.Equal(t, 1, len(schedule.PunishedNodes))
+	assert.Equal(t, uint64(2), schedule.PunishedNodes[0].ID)
+	assert.Equal(t, uint64(1), schedule.PunishedNodes[0].ConsensusVotingPowerReduceView)
+}
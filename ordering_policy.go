@@ -0,0 +1,215 @@
+package rbft
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// OrderingTx describes a tx with the fields used by ordering policies.
+type OrderingTx struct {
+	Hash      string
+	From      string
+	Nonce     uint64
+	GasPrice  *big.Int
+	Timestamp int64
+
+	// ReceiveTime is the local time primary received the tx, 0 if unknown. When a replica verifies a
+	// batch with a ReceiveTimeVerifier, it's the receive time reported by primary in the batch.
+	ReceiveTime int64
+
+	// LocalReceiveTime is the local time this replica received the tx when it verifies a batch with a
+	// ReceiveTimeVerifier, 0 if unknown.
+	LocalReceiveTime int64
+}
+
+// TxReceiveTimeProvider is an optional extension of request pool which reports the local time txs were
+// received by this replica, which is used by FairOrderingPolicy instead of the timestamp of txs.
+type TxReceiveTimeProvider interface {
+	// GetReceiveTime returns the local receive time of the tx in nanoseconds, 0 if unknown.
+	GetReceiveTime(txHash string) int64
+}
+
+// OrderingPolicy decides the order of txs inside a batch, primary applies it when building a batch
+// and replicas verify the batch in prePrepare follows it, so all nodes in cluster must be configured
+// with the same policy.
+type OrderingPolicy interface {
+	// Order returns the given txs in the order of the policy, the result must be deterministic and
+	// any prefix of the result must also be in order as primary may cut the ordered batch.
+	Order(txs []*OrderingTx) []*OrderingTx
+
+	// Verify returns an error if the given txs are not in the order of the policy.
+	Verify(txs []*OrderingTx) error
+}
+
+// ReceiveTimeVerifier is an optional extension of OrderingPolicy whose order depends on receive times of
+// txs, which differ between replicas. Primary reports its receive times of txs in the batch, replicas
+// verify the order against the reported receive times with Verify, and check the reported receive times
+// against their own ones with VerifyReceiveTimes.
+type ReceiveTimeVerifier interface {
+	// VerifyReceiveTimes returns an error if the receive time reported by primary of any tx is not
+	// consistent with the local receive time of this replica.
+	VerifyReceiveTimes(txs []*OrderingTx) error
+}
+
+// NonceOrderingPolicy only requires txs from the same sender to be in nonce order, the relative
+// order of different senders is kept.
+type NonceOrderingPolicy struct{}
+
+// Order implements OrderingPolicy.
+func (p *NonceOrderingPolicy) Order(txs []*OrderingTx) []*OrderingTx {
+	// reassign txs of each sender to the positions occupied by this sender in nonce order.
+	queues := senderQueues(txs)
+	ordered := make([]*OrderingTx, len(txs))
+	for i, tx := range txs {
+		ordered[i] = queues[tx.From][0]
+		queues[tx.From] = queues[tx.From][1:]
+	}
+	return ordered
+}
+
+// Verify implements OrderingPolicy.
+func (p *NonceOrderingPolicy) Verify(txs []*OrderingTx) error {
+	lastNonce := make(map[string]uint64)
+	for i, tx := range txs {
+		if nonce, ok := lastNonce[tx.From]; ok && tx.Nonce <= nonce {
+			return fmt.Errorf("tx %s at position %d from %s has nonce %d after nonce %d", tx.Hash, i, tx.From, tx.Nonce, nonce)
+		}
+		lastNonce[tx.From] = tx.Nonce
+	}
+	return nil
+}
+
+// FeePriorityOrderingPolicy orders txs by gas price in descending order, while txs from the same
+// sender are still in nonce order.
+type FeePriorityOrderingPolicy struct{}
+
+// Order implements OrderingPolicy.
+func (p *FeePriorityOrderingPolicy) Order(txs []*OrderingTx) []*OrderingTx {
+	return mergeSenderQueues(txs, func(a, b *OrderingTx) bool {
+		if c := gasPriceOrZero(a.GasPrice).Cmp(gasPriceOrZero(b.GasPrice)); c != 0 {
+			return c > 0
+		}
+		if a.Timestamp != b.Timestamp {
+			return a.Timestamp < b.Timestamp
+		}
+		return a.Hash < b.Hash
+	})
+}
+
+// Verify implements OrderingPolicy.
+func (p *FeePriorityOrderingPolicy) Verify(txs []*OrderingTx) error {
+	return verifyOrder(txs, p.Order(txs))
+}
+
+// FairOrderingPolicy orders txs by receive time in ascending order, while txs from the same sender
+// are still in nonce order. The local receive time is used if request pool implements
+// TxReceiveTimeProvider, otherwise the timestamp of tx is used.
+//
+// Primary reports the receive times it ordered the batch by, so replicas check the exact order against
+// them. A replica also rejects a batch if primary reports a tx received more than Tolerance later than
+// this replica received it, i.e. primary delays a tx by pretending to receive it late. Txs unknown to
+// the replica are not checked.
+type FairOrderingPolicy struct {
+	// Tolerance is the max receive time difference between replicas, which must cover the propagation
+	// delay of txs and the clock skew of replicas.
+	Tolerance time.Duration
+}
+
+// Order implements OrderingPolicy.
+func (p *FairOrderingPolicy) Order(txs []*OrderingTx) []*OrderingTx {
+	return mergeSenderQueues(txs, func(a, b *OrderingTx) bool {
+		if ta, tb := receiveTime(a), receiveTime(b); ta != tb {
+			return ta < tb
+		}
+		return a.Hash < b.Hash
+	})
+}
+
+// Verify implements OrderingPolicy.
+func (p *FairOrderingPolicy) Verify(txs []*OrderingTx) error {
+	return verifyOrder(txs, p.Order(txs))
+}
+
+// VerifyReceiveTimes implements ReceiveTimeVerifier.
+func (p *FairOrderingPolicy) VerifyReceiveTimes(txs []*OrderingTx) error {
+	for i, tx := range txs {
+		if tx.LocalReceiveTime == 0 {
+			continue
+		}
+		if delay := time.Duration(tx.ReceiveTime - tx.LocalReceiveTime); delay > p.Tolerance {
+			return fmt.Errorf("tx %s at position %d is reported received %v later than local receive time",
+				tx.Hash, i, delay)
+		}
+	}
+	return nil
+}
+
+// receiveTime returns the receive time of tx, or its timestamp if unknown.
+func receiveTime(tx *OrderingTx) int64 {
+	if tx.ReceiveTime != 0 {
+		return tx.ReceiveTime
+	}
+	return tx.Timestamp
+}
+
+// gasPriceOrZero treats nil gas price as zero.
+func gasPriceOrZero(price *big.Int) *big.Int {
+	if price == nil {
+		return big.NewInt(0)
+	}
+	return price
+}
+
+// senderQueues groups txs by sender, txs of each sender are sorted in nonce order.
+func senderQueues(txs []*OrderingTx) map[string][]*OrderingTx {
+	queues := make(map[string][]*OrderingTx)
+	for _, tx := range txs {
+		queues[tx.From] = append(queues[tx.From], tx)
+	}
+	for _, queue := range queues {
+		sort.SliceStable(queue, func(i, j int) bool {
+			return queue[i].Nonce < queue[j].Nonce
+		})
+	}
+	return queues
+}
+
+// mergeSenderQueues picks the best tx among the lowest-nonce txs of all senders repeatedly, so that
+// txs are ordered by the given priority without breaking the nonce order of each sender.
+func mergeSenderQueues(txs []*OrderingTx, better func(a, b *OrderingTx) bool) []*OrderingTx {
+	queues := senderQueues(txs)
+	senders := make([]string, 0, len(queues))
+	for from := range queues {
+		senders = append(senders, from)
+	}
+	sort.Strings(senders)
+
+	ordered := make([]*OrderingTx, 0, len(txs))
+	for len(ordered) < len(txs) {
+		best := ""
+		found := false
+		for _, from := range senders {
+			if len(queues[from]) == 0 {
+				continue
+			}
+			if !found || better(queues[from][0], queues[best][0]) {
+				best, found = from, true
+			}
+		}
+		ordered = append(ordered, queues[best][0])
+		queues[best] = queues[best][1:]
+	}
+	return ordered
+}
+
+// verifyOrder compares the given txs with the expected order.
+func verifyOrder(txs []*OrderingTx, expected []*OrderingTx) error {
+	for i := range txs {
+		if txs[i].Hash != expected[i].Hash {
+			return fmt.Errorf("tx %s at position %d is out of order, expect tx %s", txs[i].Hash, i, expected[i].Hash)
+		}
+	}
+	return nil
+}
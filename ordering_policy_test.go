@@ -0,0 +1,112 @@
+package rbft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newOrderingTx(hash, from string, nonce uint64, gasPrice int64, timestamp int64) *OrderingTx {
+	return &OrderingTx{
+		Hash:      hash,
+		From:      from,
+		Nonce:     nonce,
+		GasPrice:  big.NewInt(gasPrice),
+		Timestamp: timestamp,
+	}
+}
+
+func orderingHashes(txs []*OrderingTx) []string {
+	hashes := make([]string, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.Hash
+	}
+	return hashes
+}
+
+func TestOrderingPolicy_Nonce(t *testing.T) {
+	p := &NonceOrderingPolicy{}
+	txs := []*OrderingTx{
+		newOrderingTx("a1", "a", 1, 0, 0),
+		newOrderingTx("b0", "b", 0, 0, 0),
+		newOrderingTx("a0", "a", 0, 0, 0),
+	}
+	assert.NotNil(t, p.Verify(txs))
+
+	ordered := p.Order(txs)
+	assert.Equal(t, []string{"a0", "b0", "a1"}, orderingHashes(ordered))
+	assert.Nil(t, p.Verify(ordered))
+	assert.Nil(t, p.Verify(ordered[:2]))
+}
+
+func TestOrderingPolicy_FeePriority(t *testing.T) {
+	p := &FeePriorityOrderingPolicy{}
+	txs := []*OrderingTx{
+		newOrderingTx("a0", "a", 0, 1, 0),
+		newOrderingTx("a1", "a", 1, 100, 0),
+		newOrderingTx("b0", "b", 0, 10, 0),
+		newOrderingTx("c0", "c", 0, 10, -1),
+	}
+	assert.NotNil(t, p.Verify(txs))
+
+	// a1 has the highest price but must wait for a0.
+	ordered := p.Order(txs)
+	assert.Equal(t, []string{"c0", "b0", "a0", "a1"}, orderingHashes(ordered))
+	assert.Nil(t, p.Verify(ordered))
+	for i := range ordered {
+		assert.Nil(t, p.Verify(ordered[:i]))
+	}
+}
+
+func TestOrderingPolicy_Fair(t *testing.T) {
+	p := &FairOrderingPolicy{}
+	txs := []*OrderingTx{
+		newOrderingTx("a0", "a", 0, 0, 5),
+		newOrderingTx("a1", "a", 1, 0, 1),
+		newOrderingTx("b0", "b", 0, 0, 3),
+		newOrderingTx("c0", "c", 0, 0, 3),
+	}
+	assert.NotNil(t, p.Verify(txs))
+
+	ordered := p.Order(txs)
+	assert.Equal(t, []string{"b0", "c0", "a0", "a1"}, orderingHashes(ordered))
+	assert.Nil(t, p.Verify(ordered))
+	for i := range ordered {
+		assert.Nil(t, p.Verify(ordered[:i]))
+	}
+}
+
+func TestOrderingPolicy_FeePriorityNilGasPrice(t *testing.T) {
+	p := &FeePriorityOrderingPolicy{}
+	txs := []*OrderingTx{
+		{Hash: "a0", From: "a"},
+		newOrderingTx("b0", "b", 0, 1, 0),
+	}
+	ordered := p.Order(txs)
+	assert.Equal(t, []string{"b0", "a0"}, orderingHashes(ordered))
+	assert.Nil(t, p.Verify(ordered))
+}
+
+func TestOrderingPolicy_FairReceiveTime(t *testing.T) {
+	p := &FairOrderingPolicy{Tolerance: 2}
+	txs := []*OrderingTx{
+		newOrderingTx("a0", "a", 0, 0, 1),
+		newOrderingTx("b0", "b", 0, 0, 2),
+	}
+	// local receive time takes precedence over the timestamp of tx.
+	txs[0].ReceiveTime, txs[1].ReceiveTime = 10, 8
+	assert.Equal(t, []string{"b0", "a0"}, orderingHashes(p.Order(txs)))
+
+	// replicas verify the exact order against the receive times reported by primary.
+	assert.NotNil(t, p.Verify(txs))
+	assert.Nil(t, p.Verify(p.Order(txs)))
+
+	// primary can't report a tx received later than local receive time beyond tolerance.
+	txs[0].LocalReceiveTime, txs[1].LocalReceiveTime = 9, 0
+	assert.Nil(t, p.VerifyReceiveTimes(txs))
+	txs[0].LocalReceiveTime = 7
+	assert.NotNil(t, p.VerifyReceiveTimes(txs))
+	txs[0].LocalReceiveTime = 12
+	assert.Nil(t, p.VerifyReceiveTimes(txs))
+}
@@ -16,6 +16,8 @@ package rbft
 
 import (
 	"context"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/axiomesh/axiom-bft/common"
@@ -32,35 +34,77 @@ type peerManager struct {
 	// network helper to broadcast/unicast messages.
 	network Network
 
+	// compress payload of large messages, nil if compression is disabled.
+	compressor PayloadCompressor
+
+	// compression types advertised by validators, indexed by replica id.
+	peerCompressions map[uint64]peerCompression
+	compressionLock  sync.RWMutex
+
+	// storage to persist the nonce ceiling.
+	storage Storage
+
+	// crypto to sign the envelope of messages carrying nonce.
+	crypto Crypto
+
 	// logger
 	logger common.Logger
 
-	msgNonce int64
-	isTest   bool
+	nonceLock     sync.Mutex
+	msgNonce      int64
+	nonceCeiling  int64 // persisted upper bound of msgNonce
+	nonceRestored bool  // whether the persisted nonce ceiling has been restored
+	isTest        bool
 }
 
+const (
+	// msgNonceKey is the storage key of the persisted nonce ceiling.
+	msgNonceKey = "rbft.nonce"
+
+	// msgNonceReserveSize is the number of nonces reserved by each persisted nonce ceiling.
+	msgNonceReserveSize int64 = 1 << 20
+)
+
 // init peer pool in rbft core
-func newPeerManager(chainConfig *ChainConfig, metrics *rbftMetrics, network Network, config Config, isTest bool) *peerManager {
+func newPeerManager(chainConfig *ChainConfig, metrics *rbftMetrics, network Network, storage Storage, crypto Crypto, config Config, isTest bool) *peerManager {
 	return &peerManager{
-		chainConfig: chainConfig,
-		metrics:     metrics,
-		network:     network,
-		logger:      config.Logger,
-		msgNonce:    time.Now().UnixNano(),
-		isTest:      isTest,
+		chainConfig:      chainConfig,
+		metrics:          metrics,
+		network:          network,
+		storage:          storage,
+		crypto:           crypto,
+		compressor:       config.PayloadCompressor,
+		logger:           config.Logger,
+		msgNonce:         time.Now().UnixNano(),
+		isTest:           isTest,
+		peerCompressions: make(map[uint64]peerCompression),
 	}
 }
 
-func (m *peerManager) broadcast(ctx context.Context, msg *consensus.ConsensusMessage) {
+// seal fills the envelope of an outgoing message, and returns the message to be sent to the given
+// peer, whose payload may be compressed. p2pID is empty for broadcast.
+func (m *peerManager) seal(msg *consensus.ConsensusMessage, p2pID string) *consensus.ConsensusMessage {
 	msg.From = m.chainConfig.SelfID
 	msg.Epoch = m.chainConfig.EpochInfo.Epoch
 	msg.View = m.chainConfig.View
 	if !m.isTest {
-		m.msgNonce++
-		msg.Nonce = m.msgNonce
+		msg.Nonce = m.nextNonce()
+		sig, err := m.crypto.Sign(envelopeDigest(msg))
+		if err != nil {
+			m.logger.Errorf("Sign envelope of msg[%s] failed: %v", msg.Type.String(), err)
+		}
+		msg.Signature = sig
 	}
 
-	err := m.network.Broadcast(ctx, msg)
+	if m.compressor != nil {
+		msg.AcceptCompression = m.compressor.Type()
+	}
+
+	return m.compress(msg, p2pID)
+}
+
+func (m *peerManager) broadcast(ctx context.Context, msg *consensus.ConsensusMessage) {
+	err := m.network.Broadcast(ctx, m.seal(msg, ""))
 	if err != nil {
 		m.logger.Errorf("Broadcast failed: %v", err)
 		return
@@ -68,16 +112,9 @@ func (m *peerManager) broadcast(ctx context.Context, msg *consensus.ConsensusMes
 }
 
 func (m *peerManager) unicastByP2PID(ctx context.Context, msg *consensus.ConsensusMessage, p2pID string) {
-	msg.From = m.chainConfig.SelfID
-	msg.Epoch = m.chainConfig.EpochInfo.Epoch
-	msg.View = m.chainConfig.View
-	if !m.isTest {
-		m.msgNonce++
-		msg.Nonce = m.msgNonce
-	}
-
+	sendMsg := m.seal(msg, p2pID)
 	start := time.Now()
-	err := m.network.Unicast(ctx, msg, p2pID)
+	err := m.network.Unicast(ctx, sendMsg, p2pID)
 	m.metrics.processEventDuration.With("event", "p2p_unicast").Observe(time.Since(start).Seconds())
 	if err != nil {
 		m.logger.Errorf("Unicast to %s failed: %v", p2pID, err)
@@ -85,6 +122,23 @@ func (m *peerManager) unicastByP2PID(ctx context.Context, msg *consensus.Consens
 	}
 }
 
+// unicastAsync works like unicast but sends the message in background, which is used to
+// avoid blocking the caller on network.
+func (m *peerManager) unicastAsync(ctx context.Context, msg *consensus.ConsensusMessage, to uint64) {
+	n, err := m.chainConfig.getNodeInfo(to)
+	if err != nil {
+		m.logger.Errorf("Unicast to %d failed: %v", to, err)
+		return
+	}
+
+	sendMsg := m.seal(msg, n.P2PNodeID)
+	go func() {
+		if err := m.network.Unicast(ctx, sendMsg, n.P2PNodeID); err != nil {
+			m.logger.Errorf("Unicast to %s failed: %v", n.P2PNodeID, err)
+		}
+	}()
+}
+
 func (m *peerManager) unicast(ctx context.Context, msg *consensus.ConsensusMessage, to uint64) {
 	n, err := m.chainConfig.getNodeInfo(to)
 	if err != nil {
@@ -94,3 +148,32 @@ func (m *peerManager) unicast(ctx context.Context, msg *consensus.ConsensusMessa
 
 	m.unicastByP2PID(ctx, msg, n.P2PNodeID)
 }
+
+// nextNonce returns the nonce of next outgoing message. To keep nonces increasing across restarts, a
+// ceiling ahead of the nonce in use is persisted, and nonces start from the larger one of current time
+// and the persisted ceiling after restart.
+func (m *peerManager) nextNonce() int64 {
+	m.nonceLock.Lock()
+	defer m.nonceLock.Unlock()
+
+	if !m.nonceRestored {
+		m.nonceRestored = true
+		if raw, err := m.storage.ReadState(msgNonceKey); err == nil {
+			ceiling, pErr := strconv.ParseInt(string(raw), 10, 64)
+			if pErr != nil {
+				m.logger.Warningf("Parse persisted msg nonce ceiling failed: %v", pErr)
+			} else if ceiling > m.msgNonce {
+				m.msgNonce = ceiling
+			}
+		}
+	}
+
+	m.msgNonce++
+	if m.msgNonce >= m.nonceCeiling {
+		m.nonceCeiling = m.msgNonce + msgNonceReserveSize
+		if err := m.storage.StoreState(msgNonceKey, []byte(strconv.FormatInt(m.nonceCeiling, 10))); err != nil {
+			m.logger.Errorf("Persist msg nonce ceiling failed: %v", err)
+		}
+	}
+	return m.msgNonce
+}
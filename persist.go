@@ -16,6 +16,7 @@ package rbft
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -422,10 +423,13 @@ func (rbft *rbftImpl[T, Constraint]) persistDelBatch(digest string) {
 }
 
 // persistCheckpoint persists checkpoint to database, which, key contains the seqNo of checkpoint, value is the
-// checkpoint ID
-func (rbft *rbftImpl[T, Constraint]) persistCheckpoint(seqNo uint64, digest, batchDigest string) {
+// checkpoint ID, with the hex encoded VRF output appended if any
+func (rbft *rbftImpl[T, Constraint]) persistCheckpoint(seqNo uint64, digest, batchDigest string, vrfOutput []byte) {
 	key := fmt.Sprintf("chkpt.%d", seqNo)
 	val := fmt.Sprintf("%s,%s", digest, batchDigest)
+	if len(vrfOutput) != 0 {
+		val = fmt.Sprintf("%s,%s", val, hex.EncodeToString(vrfOutput))
+	}
 	err := rbft.storage.StoreState(key, []byte(val))
 	if err != nil {
 		rbft.logger.Errorf("Persist chkpt failed with err: %s ", err)
@@ -436,6 +440,7 @@ func (rbft *rbftImpl[T, Constraint]) persistCheckpoint(seqNo uint64, digest, bat
 func (rbft *rbftImpl[T, Constraint]) persistDelCheckpoint(seqNo uint64) {
 	key := fmt.Sprintf("chkpt.%d", seqNo)
 	_ = rbft.storage.DelState(key)
+	_ = rbft.storage.DelState(fmt.Sprintf("%s%d", checkpointKeysPrefix, seqNo))
 }
 
 func (rbft *rbftImpl[T, Constraint]) persistH(seqNo uint64) {
@@ -473,6 +478,7 @@ func (rbft *rbftImpl[T, Constraint]) persistNewView(nv *consensus.NewView, isRec
 				ConsensusVotingPower:           v.ConsensusVotingPower,
 				ConsensusVotingPowerReduced:    v.ConsensusVotingPowerReduced,
 				ConsensusVotingPowerReduceView: v.ConsensusVotingPowerReduceView,
+				ReputationPenalty:              v.ReputationPenalty,
 			}
 		})
 		rbft.chainConfig.LastStableValidatorDynamicInfoMap = lo.SliceToMap(nv.ValidatorDynamicInfo, func(v *consensus.NodeDynamicInfo) (uint64, *ValidatorInfo) {
@@ -481,6 +487,7 @@ func (rbft *rbftImpl[T, Constraint]) persistNewView(nv *consensus.NewView, isRec
 				ConsensusVotingPower:           v.ConsensusVotingPower,
 				ConsensusVotingPowerReduced:    v.ConsensusVotingPowerReduced,
 				ConsensusVotingPowerReduceView: v.ConsensusVotingPowerReduceView,
+				ReputationPenalty:              v.ReputationPenalty,
 			}
 		})
 		rbft.logger.Infof("Replica %d reset ValidatorDynamicInfo by newView-%d from %d, new ValidatorDynamicInfo: %v", rbft.chainConfig.SelfID, nv.View, nv.FromId, formatValidatorDynamicInfo(rbft.chainConfig.ValidatorDynamicInfoMap))
@@ -507,6 +514,7 @@ func (rbft *rbftImpl[T, Constraint]) restoreView() {
 					ConsensusVotingPower:           v.ConsensusVotingPower,
 					ConsensusVotingPowerReduced:    v.ConsensusVotingPowerReduced,
 					ConsensusVotingPowerReduceView: v.ConsensusVotingPowerReduceView,
+					ReputationPenalty:              v.ReputationPenalty,
 				}
 			})
 			rbft.chainConfig.LastStableValidatorDynamicInfoMap = lo.SliceToMap(nv.ValidatorDynamicInfo, func(v *consensus.NodeDynamicInfo) (uint64, *ValidatorInfo) {
@@ -515,6 +523,7 @@ func (rbft *rbftImpl[T, Constraint]) restoreView() {
 					ConsensusVotingPower:           v.ConsensusVotingPower,
 					ConsensusVotingPowerReduced:    v.ConsensusVotingPowerReduced,
 					ConsensusVotingPowerReduceView: v.ConsensusVotingPowerReduceView,
+					ReputationPenalty:              v.ReputationPenalty,
 				}
 			})
 			rbft.logger.Debugf("Replica %d restore ValidatorDynamicInfoMap %v", rbft.chainConfig.SelfID, showSimpleValidatorDynamicInfo(nv.ValidatorDynamicInfo))
@@ -527,11 +536,26 @@ func (rbft *rbftImpl[T, Constraint]) restoreView() {
 		rbft.logger.Debugf("Replica %d could not restore view: %s, set to 0", rbft.chainConfig.SelfID, err)
 	}
 	// initial view 0 in new epoch.
+	rbft.restoreReputationPenalties()
 	rbft.vcMgr.latestNewView = initialNewView
 	rbft.setLastStableView(0)
 	rbft.setView(0)
 }
 
+// restoreReputationPenalties restores the timeout penalties of validators carried into current epoch
+// by the quorum checkpoint of epoch change, which are used before the first view change of the epoch.
+func (rbft *rbftImpl[T, Constraint]) restoreReputationPenalties() {
+	if !rbft.chainConfig.isProposerElectionTypeReputation() || rbft.chainConfig.EpochInfo.Epoch == 0 {
+		return
+	}
+	c, err := rbft.epochMgr.getEpochQuorumCheckpoint(rbft.chainConfig.EpochInfo.Epoch - 1)
+	if err != nil {
+		rbft.logger.Debugf("Replica %d could not restore reputation penalties: %s", rbft.chainConfig.SelfID, err)
+		return
+	}
+	rbft.chainConfig.setReputationPenalties(c.GetCheckpoint().GetReputationPenalties())
+}
+
 // restoreBatchStore restores tx batches from database
 func (rbft *rbftImpl[T, Constraint]) restoreBatchStore() {
 	payload, err := rbft.storage.ReadStateSet("batch.")
@@ -586,6 +610,10 @@ func (rbft *rbftImpl[T, Constraint]) restoreState() error {
 	}
 
 	rbft.restoreEpochInfo()
+	rbft.nonceFilter.restore()
+	if rbft.isKeyRotationEnabled() {
+		rbft.keys.restore()
+	}
 
 	validatorSet, err := rbft.external.GetValidatorSet()
 	if err != nil {
@@ -612,6 +640,14 @@ func (rbft *rbftImpl[T, Constraint]) restoreState() error {
 				digest := strings.Split(val, ",")[0]
 				batchDigest := strings.Split(val, ",")[1]
 				rbft.logger.Debugf("Replica %d found checkpoint [Digest:%s, BatchDigest:%s] for seqNo %d", rbft.chainConfig.SelfID, digest, batchDigest, seqNo)
+				var vrfOutput []byte
+				if fields := strings.Split(val, ","); len(fields) > 2 {
+					if vrfOutput, err = hex.DecodeString(fields[2]); err != nil {
+						return errors.Wrapf(err, "failed to decode vrf output of checkpoint %d", seqNo)
+					}
+					rbft.storeMgr.vrfOutputs[seqNo] = vrfOutput
+				}
+				rbft.restoreCheckpointKeys(seqNo)
 				state := &types.ServiceState{
 					MetaState: &types.MetaState{Height: seqNo, Digest: digest},
 					Epoch:     rbft.chainConfig.EpochInfo.Epoch,
@@ -624,6 +660,7 @@ func (rbft *rbftImpl[T, Constraint]) restoreState() error {
 				if seqNo > maxCheckpointSeqNo {
 					rbft.chainConfig.LastCheckpointExecBlockHash = digest
 					rbft.chainConfig.LastCheckpointExecBlockHeight = seqNo
+					rbft.chainConfig.LastCheckpointVRFOutput = vrfOutput
 					maxCheckpointSeqNo = seqNo
 				}
 			}
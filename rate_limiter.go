@@ -0,0 +1,187 @@
+package rbft
+
+import (
+	"time"
+
+	"github.com/axiomesh/axiom-bft/common"
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+// maxRateLimitBuckets is the max number of token buckets tracked by msgRateLimiter, idle buckets are
+// evicted once it's reached.
+const maxRateLimitBuckets = 4096
+
+// rateLimitBanExemptMsgs are consensus-critical messages which are neither limited nor rejected by ban,
+// as dropping votes of a validator may stall consensus. The sender of a message is not authenticated
+// until the message is verified, so a peer forging the sender could otherwise drain the buckets of a
+// validator.
+var rateLimitBanExemptMsgs = map[consensus.Type]struct{}{
+	consensus.Type_NULL_REQUEST:       {},
+	consensus.Type_PRE_PREPARE:        {},
+	consensus.Type_PREPARE:            {},
+	consensus.Type_COMMIT:             {},
+	consensus.Type_SIGNED_CHECKPOINT:  {},
+	consensus.Type_VIEW_CHANGE:        {},
+	consensus.Type_QUORUM_VIEW_CHANGE: {},
+	consensus.Type_NEW_VIEW:           {},
+	consensus.Type_AGGREGATED_VOTES:   {},
+	consensus.Type_HOTSTUFF_PROPOSAL:  {},
+	consensus.Type_HOTSTUFF_VOTE:      {},
+	consensus.Type_HOTSTUFF_NEW_VIEW:  {},
+}
+
+// MsgRateLimit is the token bucket of a type of consensus messages from a peer.
+type MsgRateLimit struct {
+	// Rate is the number of messages refilled per second.
+	Rate float64
+
+	// Burst is the max number of messages which can be accepted at once.
+	Burst int
+}
+
+type msgRateLimitKey struct {
+	from    uint64
+	msgType consensus.Type
+}
+
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// msgRateLimiter limits the rate of consensus messages from each peer by message type, and
+// temporarily bans peers which keep exceeding the limit. It's only accessed in the event loop, after
+// the sender of message is checked to be a known node, but before the message is verified. So the
+// consensus-critical messages in rateLimitBanExemptMsgs are not limited, as peers could exhaust the
+// tokens of each other by forging the sender.
+type msgRateLimiter struct {
+	limits       map[consensus.Type]MsgRateLimit
+	banThreshold int
+	banDuration  time.Duration
+
+	buckets          map[msgRateLimitKey]*tokenBucket
+	violations       map[uint64]int
+	violationsStarts map[uint64]time.Time
+	bannedUntil      map[uint64]time.Time
+
+	now     func() time.Time
+	metrics *rbftMetrics
+	logger  common.Logger
+}
+
+func newMsgRateLimiter(c Config, metrics *rbftMetrics) *msgRateLimiter {
+	return &msgRateLimiter{
+		limits:           c.MsgRateLimits,
+		banThreshold:     c.MsgRateLimitBanThreshold,
+		banDuration:      c.MsgRateLimitBanDuration,
+		buckets:          make(map[msgRateLimitKey]*tokenBucket),
+		violations:       make(map[uint64]int),
+		violationsStarts: make(map[uint64]time.Time),
+		bannedUntil:      make(map[uint64]time.Time),
+		now:              time.Now,
+		metrics:          metrics,
+		logger:           c.Logger,
+	}
+}
+
+// allow checks if the message of given type from given peer can be accepted, which is rejected if
+// the peer is banned or runs out of tokens of the message type. Messages in rateLimitBanExemptMsgs
+// are always accepted.
+func (l *msgRateLimiter) allow(from uint64, msgType consensus.Type) bool {
+	if len(l.limits) == 0 {
+		return true
+	}
+	if _, exempt := rateLimitBanExemptMsgs[msgType]; exempt {
+		return true
+	}
+	now := l.now()
+
+	if until, ok := l.bannedUntil[from]; ok {
+		if now.Before(until) {
+			l.metrics.rateLimitedMsgCounter.With("type", msgType.String()).Add(1)
+			return false
+		}
+		delete(l.bannedUntil, from)
+		l.metrics.bannedPeersGauge.Set(float64(len(l.bannedUntil)))
+		l.logger.Infof("Peer %d is unbanned", from)
+	}
+
+	limit, ok := l.limits[msgType]
+	if !ok {
+		return true
+	}
+	key := msgRateLimitKey{from: from, msgType: msgType}
+	bucket, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxRateLimitBuckets {
+			l.evict(now)
+		}
+		bucket = &tokenBucket{tokens: float64(limit.Burst), lastRefill: now}
+		l.buckets[key] = bucket
+	}
+	l.refill(bucket, limit, now)
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true
+	}
+
+	l.metrics.rateLimitedMsgCounter.With("type", msgType.String()).Add(1)
+	l.logger.Debugf("Drop msg[%s] from peer %d as it exceeds the rate limit", msgType.String(), from)
+	l.recordViolation(from, now)
+	return false
+}
+
+// refill refills the tokens of bucket by rate since last refill.
+func (l *msgRateLimiter) refill(bucket *tokenBucket, limit MsgRateLimit, now time.Time) {
+	bucket.tokens += now.Sub(bucket.lastRefill).Seconds() * limit.Rate
+	if bucket.tokens > float64(limit.Burst) {
+		bucket.tokens = float64(limit.Burst)
+	}
+	bucket.lastRefill = now
+}
+
+// evict drops the buckets which are refilled to burst, as they are the same as new ones, and the
+// expired violations. Random buckets are dropped if all buckets are in use.
+func (l *msgRateLimiter) evict(now time.Time) {
+	for key, bucket := range l.buckets {
+		l.refill(bucket, l.limits[key.msgType], now)
+		if bucket.tokens >= float64(l.limits[key.msgType].Burst) {
+			delete(l.buckets, key)
+		}
+	}
+	for from, start := range l.violationsStarts {
+		if now.Sub(start) > l.banDuration {
+			delete(l.violations, from)
+			delete(l.violationsStarts, from)
+		}
+	}
+	for key := range l.buckets {
+		if len(l.buckets) < maxRateLimitBuckets {
+			break
+		}
+		delete(l.buckets, key)
+	}
+}
+
+// recordViolation bans the peer once the number of its messages dropped by rate limit reaches the
+// ban threshold within the ban duration.
+func (l *msgRateLimiter) recordViolation(from uint64, now time.Time) {
+	if l.banThreshold <= 0 {
+		return
+	}
+	if start, ok := l.violationsStarts[from]; !ok || now.Sub(start) > l.banDuration {
+		l.violationsStarts[from] = now
+		l.violations[from] = 0
+	}
+	l.violations[from]++
+	if l.violations[from] < l.banThreshold {
+		return
+	}
+
+	delete(l.violations, from)
+	delete(l.violationsStarts, from)
+	l.bannedUntil[from] = now.Add(l.banDuration)
+	l.metrics.bannedPeerCounter.Add(1)
+	l.metrics.bannedPeersGauge.Set(float64(len(l.bannedUntil)))
+	l.logger.Warningf("Peer %d is banned for %v as it keeps exceeding the rate limit", from, l.banDuration)
+}
@@ -0,0 +1,107 @@
+package rbft
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+func TestMsgRateLimiter_allow(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	l := rbfts[0].rateLimiter
+	now := time.Now()
+	l.now = func() time.Time { return now }
+	l.limits = map[consensus.Type]MsgRateLimit{
+		consensus.Type_SYNC_STATE: {Rate: 1, Burst: 2},
+	}
+	l.banThreshold = 3
+	l.banDuration = time.Minute
+
+	// burst is accepted at once, and other types are not limited.
+	assert.True(t, l.allow(2, consensus.Type_SYNC_STATE))
+	assert.True(t, l.allow(2, consensus.Type_SYNC_STATE))
+	assert.False(t, l.allow(2, consensus.Type_SYNC_STATE))
+	assert.True(t, l.allow(2, consensus.Type_PREPARE))
+	assert.True(t, l.allow(3, consensus.Type_SYNC_STATE))
+
+	// tokens are refilled by rate.
+	now = now.Add(time.Second)
+	assert.True(t, l.allow(2, consensus.Type_SYNC_STATE))
+	assert.False(t, l.allow(2, consensus.Type_SYNC_STATE))
+
+	// peer is banned once violations reach the threshold, then all messages are dropped except
+	// consensus-critical ones, which are never limited.
+	assert.False(t, l.allow(2, consensus.Type_SYNC_STATE))
+	assert.Contains(t, l.bannedUntil, uint64(2))
+	assert.False(t, l.allow(2, consensus.Type_FETCH_CHECKPOINT))
+	assert.True(t, l.allow(3, consensus.Type_FETCH_CHECKPOINT))
+	assert.True(t, l.allow(2, consensus.Type_PREPARE))
+	assert.True(t, l.allow(2, consensus.Type_COMMIT))
+	assert.True(t, l.allow(2, consensus.Type_VIEW_CHANGE))
+
+	// peer is unbanned after ban duration.
+	now = now.Add(time.Minute)
+	assert.True(t, l.allow(2, consensus.Type_SYNC_STATE))
+	assert.NotContains(t, l.bannedUntil, uint64(2))
+}
+
+func TestMsgRateLimiter_evict(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	l := rbfts[0].rateLimiter
+	now := time.Now()
+	l.now = func() time.Time { return now }
+	l.limits = map[consensus.Type]MsgRateLimit{
+		consensus.Type_SYNC_STATE: {Rate: 1, Burst: 2},
+	}
+
+	// the number of buckets is bounded.
+	for from := uint64(1); from <= 2*maxRateLimitBuckets; from++ {
+		assert.True(t, l.allow(from, consensus.Type_SYNC_STATE))
+	}
+	assert.LessOrEqual(t, len(l.buckets), maxRateLimitBuckets)
+
+	// idle buckets are evicted first.
+	now = now.Add(time.Second)
+	assert.True(t, l.allow(2*maxRateLimitBuckets+1, consensus.Type_SYNC_STATE))
+	assert.Len(t, l.buckets, 1)
+}
+
+func TestMsgRateLimiter_consensusMessageFilter(t *testing.T) {
+	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	rbfts[0].rateLimiter.limits = map[consensus.Type]MsgRateLimit{
+		consensus.Type_SYNC_STATE: {Rate: 0, Burst: 1},
+	}
+
+	rbfts[1].initSyncState()
+	syncState := nodes[1].broadcastMessageCache
+	assert.Equal(t, consensus.Type_SYNC_STATE, syncState.Type)
+
+	// the first sync state is responded, the second one is dropped.
+	nodes[0].unicastMessageCache = nil
+	rbfts[0].processEvent(syncState)
+	assert.NotNil(t, nodes[0].unicastMessageCache)
+	assert.Equal(t, consensus.Type_SYNC_STATE_RESPONSE, nodes[0].unicastMessageCache.Type)
+
+	nodes[0].unicastMessageCache = nil
+	rbfts[0].processEvent(&consensusMessageWrapper{ctx: context.Background(), ConsensusMessage: syncState.CloneVT()})
+	assert.Nil(t, nodes[0].unicastMessageCache)
+
+	// messages from non-validators are rejected before rate limit, so they can't exhaust tokens.
+	rbfts[0].rateLimiter.limits[consensus.Type_SYNC_STATE_RESPONSE] = MsgRateLimit{Rate: 0, Burst: 1}
+	forged := &consensus.ConsensusMessage{Type: consensus.Type_SYNC_STATE_RESPONSE, From: 100, Epoch: rbfts[0].chainConfig.EpochInfo.Epoch}
+	rbfts[0].processEvent(&consensusMessageWrapper{ctx: context.Background(), ConsensusMessage: forged})
+	assert.NotContains(t, rbfts[0].rateLimiter.buckets, msgRateLimitKey{from: 100, msgType: consensus.Type_SYNC_STATE_RESPONSE})
+
+	// consensus-critical messages are never limited, so a peer forging the sender of a validator
+	// can't drain its buckets.
+	rbfts[0].rateLimiter.limits[consensus.Type_PREPARE] = MsgRateLimit{Rate: 0, Burst: 1}
+	for i := 0; i < 3; i++ {
+		assert.True(t, rbfts[0].rateLimiter.allow(2, consensus.Type_PREPARE))
+	}
+	assert.NotContains(t, rbfts[0].rateLimiter.buckets, msgRateLimitKey{from: 2, msgType: consensus.Type_PREPARE})
+}
@@ -111,6 +111,174 @@ type Config struct {
 
 	// CommittedBlockCacheNumber is committed block cache number after checkpoint
 	CommittedBlockCacheNumber uint64
+
+	// MaxInFlightBatches enables pipelining mode if it's larger than 0, in which primary keeps at most
+	// MaxInFlightBatches batches in consensus at the same time (still limited by high watermark) and
+	// replicas fetch missing txs of different in-flight batches in parallel.
+	MaxInFlightBatches uint64
+
+	// GetVoteAggregationTypeFn returns the vote aggregation type (VoteAggregationTypeAllToAll or
+	// VoteAggregationTypeLinear) of the given epoch, all-to-all is used if it's nil.
+	GetVoteAggregationTypeFn func(epochInfo *kittypes.EpochInfo) string
+
+	// IsFastPathEnabledFn returns whether the optimistic fast path is enabled in the given epoch, in which
+	// a batch is committed without commit phase once prepares from all replicas are collected. A batch
+	// committed by fast path is kept in view change if it's pre-prepared by f+1 view changes even though
+	// none of them has prepared it, so it must be derived from epoch info for all nodes to agree on it.
+	// Fast path is disabled if it's nil.
+	IsFastPathEnabledFn func(epochInfo *kittypes.EpochInfo) bool
+
+	// FastPathTimeout is the time duration one waits for prepares from all replicas before falling back to
+	// commit phase in fast path.
+	FastPathTimeout time.Duration
+
+	// MaxBatchTimestampDrift enables timestamp validation of pre-prepares if it's larger than 0, in which
+	// replicas reject a batch whose timestamp differs from local clock by more than MaxBatchTimestampDrift,
+	// or is not strictly greater than the timestamp of previous batch.
+	MaxBatchTimestampDrift time.Duration
+
+	// EnableBFTTime executes batches with BFT time rather than the timestamp of primary, which only takes
+	// effect in linear vote aggregation mode without fast path, as only then primary collects signed commits.
+	// Primary puts the median timestamp of the latest commit quorum certificate it has, together with the
+	// commits, into the next pre-prepare, which is checked by replicas and covered by the batch digest. A
+	// batch without BFT time, e.g. the first one of an epoch, is executed with the timestamp of primary. The
+	// block time is kept strictly greater than the previous one, which is loaded from BlockMeta.Timestamp of
+	// ledger after restart or state update. All nodes should enable it together.
+	EnableBFTTime bool
+
+	// GetConsensusEngineTypeFn returns the consensus engine type (ConsensusEngineTypeRBFT or
+	// ConsensusEngineTypeChainedHotStuff) of the given epoch, RBFT is used if it's nil.
+	GetConsensusEngineTypeFn func(epochInfo *kittypes.EpochInfo) string
+
+	// HotStuffViewTimeout is the time duration one waits for a proposal of current view before
+	// moving to next view in chained HotStuff.
+	HotStuffViewTimeout time.Duration
+
+	// BatchPolicy limits the number of txs, total size and cumulative gas of a batch, request pool generates
+	// batches under the policy if it implements PolicyBatchGenerator, otherwise primary cuts the batch generated
+	// by request pool to fit the policy. Txs exceeding the policy on their own are never packed and replicas
+	// reject a batch beyond the policy. Batches are only limited by request pool and BatchTimeout if it's nil.
+	// Request pool must implement RequestEvictor if it's set.
+	BatchPolicy BatchPolicy
+
+	// OrderingPolicy decides the order of txs inside a batch, primary reorders the batch generated by
+	// request pool with the policy and replicas reject a batch out of order. The order of request pool
+	// is used if it's nil. Local receive times of txs are provided to the policy if request pool
+	// implements TxReceiveTimeProvider, and primary reports its receive times in pre-prepare if the
+	// policy implements ReceiveTimeVerifier.
+	OrderingPolicy OrderingPolicy
+
+	// ThresholdDecrypter enables the threshold-encrypted mode if it's not nil, in which batches are
+	// ordered over txs encrypted to the threshold key of validators, and validators broadcast their
+	// decryption shares after a batch committed, which are combined before executing the batch.
+	ThresholdDecrypter ThresholdDecrypter
+
+	// DecryptionShareTimeout is the time duration one waits for decryption shares of a committed batch
+	// before resending its own decryption share in threshold-encrypted mode.
+	DecryptionShareTimeout time.Duration
+
+	// EnableVRFSeed derives the proposer seed of WRF from the VRF output published by the proposer of
+	// the last checkpoint block rather than the block hash, so that the next proposer cannot be computed
+	// in advance. The Crypto of ExternalStack must implement VRF.
+	EnableVRFSeed bool
+
+	// EnableKeyRotation allows validators to rotate their consensus keys with Node.RotateKey without being
+	// removed from the validator set. A new key announced by a validator is signed by its current key,
+	// ordered by a batch of primary and carried by the next checkpoint, it takes effect once the checkpoint
+	// becomes stable and the previous key is still accepted for one more checkpoint period as the overlap
+	// window. The Crypto of ExternalStack must implement KeyRotator.
+	EnableKeyRotation bool
+
+	// ForwardTxsToPrimary unicasts request sets only to the current and next primary rather than
+	// broadcasting them to all nodes, request sets are still broadcast when node is abnormal. Pending
+	// request sets are re-forwarded once a new view changes the primary.
+	ForwardTxsToPrimary bool
+
+	// MsgRateLimits limits the rate of consensus messages of the given types from each peer with token
+	// buckets, messages beyond the limit are dropped after the sender is checked and before being
+	// converted into events. Messages are not limited if it's empty. Consensus-critical messages, e.g.
+	// PRE_PREPARE, PREPARE, COMMIT and VIEW_CHANGE, are never limited as their sender can be forged
+	// before the message is verified.
+	MsgRateLimits map[consensus.Type]MsgRateLimit
+
+	// MsgRateLimitBanThreshold bans a peer for MsgRateLimitBanDuration once the number of its messages
+	// dropped by MsgRateLimits reaches the threshold within MsgRateLimitBanDuration, all messages from
+	// a banned peer are dropped except consensus-critical ones such as votes and view changes. Peers
+	// are never banned if it's 0.
+	MsgRateLimitBanThreshold int
+	MsgRateLimitBanDuration  time.Duration
+
+	// InboundQueueSize enables the prioritized inbound scheduler if it's larger than 0, in which remote
+	// consensus messages and request sets are queued by class in bounded queues of the given size.
+	// Control-plane messages (view change, checkpoint, recovery and epoch), local events, normal-case
+	// consensus messages and request sets are processed by weighted round robin, so that no class is
+	// starved. Remote messages are dropped if the same message is still queued. If their queue is full,
+	// control-plane and consensus messages block the caller as with the unbuffered channel, while
+	// rebroadcast request sets are dropped and request sets from application layer are rejected. MsgNonceWindow must be
+	// at least 3 times of it if enabled. All events are processed in FIFO through one unbuffered channel
+	// if it's 0.
+	InboundQueueSize int
+
+	// SigVerifyWorkers is the number of workers which pre-verify signatures of view changes, new views
+	// and checkpoints in parallel before posting them to the event loop. Verified signatures are cached
+	// so that the event loop only checks the cache. Signatures are verified in the event loop if it's 0.
+	// The Verify of ExternalStack must be safe for concurrent use if it's larger than 0.
+	SigVerifyWorkers int
+
+	// MsgNonceWindow enables replay protection if it's larger than 0, in which a consensus message from a
+	// validator is accepted only if its nonce is larger than the highest nonce of the sender, or within
+	// the window below the highest nonce and not seen before. Nonces too far ahead of local time are not
+	// tracked. The sender signs the envelope of every message with nonce, which is verified before the
+	// nonce is tracked, so that forged nonces can't reject messages of the peer. The highest nonce of
+	// each peer is persisted at every stable checkpoint and rejects older messages after restart.
+	MsgNonceWindow int
+
+	// PayloadCompressor compresses the payload of large request sets, batch responses and epoch change
+	// proofs before sending them if it's not nil. Messages are only compressed for validators advertising
+	// the same compression type in their messages, so nodes with different or without compressors still
+	// work together. Broadcast messages are only compressed if all validators accept it.
+	PayloadCompressor PayloadCompressor
+
+	// FetchPeers enables the multi-peer fetcher if it's larger than 0, in which missing batches after
+	// view change and missing txs of a pre-prepare are fetched from FetchPeers peers at a time, spread
+	// across validators in turn. A fetch without a valid response in FetchTimeout is retried against
+	// other peers with exponential backoff, and responses are only accepted from peers asked and
+	// verified against the known digests. Once all peers asked returned invalid responses, the fetch is
+	// retried after 1/16 of the timeout of current attempt. Missing batches are fetched from all peers and missing txs from primary only if it's 0.
+	FetchPeers int
+
+	// FetchTimeout is the time duration one waits for the first attempt of a fetch before retrying.
+	FetchTimeout time.Duration
+
+	// SnapshotConsumer enables snapshot sync if it's not nil, in which a node fell behind downloads the
+	// snapshot at the target checkpoint in chunks from signers of the checkpoint and restores state with
+	// SnapshotConsumer, rather than calling ServiceOutbound.StateUpdate.
+	SnapshotConsumer SnapshotConsumer
+
+	// SnapshotProvider serves snapshots to validators in snapshot sync if it's not nil. Requests are
+	// served by a fixed number of workers, and dropped if too many requests are waiting.
+	SnapshotProvider SnapshotProvider
+
+	// SnapshotSyncTimeout is the time duration one waits for a response of snapshot manifest or chunk
+	// before requesting it from another peer.
+	SnapshotSyncTimeout time.Duration
+
+	// StateUpdateTimeout is the time duration one waits for progress of state update before re-issuing it,
+	// which is disabled if it's 0. A state update which has lasted for StateUpdateTimeout is also re-targeted
+	// once a newer quorum checkpoint is found. It should be long enough for applications to report progress,
+	// as a re-issued state update supersedes the ongoing one.
+	StateUpdateTimeout time.Duration
+
+	// EpochProofCacheSize is the number of latest epochs whose quorum checkpoints are kept in memory,
+	// older ones are read from storage on demand. It also limits the epoch changes cached during epoch
+	// sync. MaxNumEpochEndingCheckpoint is used if it's not larger than 0.
+	EpochProofCacheSize int
+
+	// EpochProofRetention is the number of latest epochs whose quorum checkpoints are kept in storage,
+	// the quorum checkpoint of an older epoch is pruned once a new epoch is persisted, so epoch change
+	// proofs before it cannot be served any more. All epochs are kept if it's 0. EpochService of external
+	// stack must implement EpochStatePruner if it's set.
+	EpochProofRetention uint64
 }
 
 // rbftImpl is the core struct of RBFT service, which handles all functions about consensus.
@@ -119,30 +287,51 @@ type rbftImpl[T any, Constraint kittypes.TXConstraint[T]] struct {
 	chainConfig *ChainConfig
 	external    ExternalStack[T, Constraint] // manage interaction with application layer
 
-	status      *statusManager               // keep all basic status of rbft in this object
-	timerMgr    *timerManager                // manage rbft event timers
-	exec        *executor                    // manage transaction execution
-	storeMgr    *storeManager[T, Constraint] // manage memory log storage
-	batchMgr    *batchManager[T, Constraint] // manage request batch related issues
-	recoveryMgr *recoveryManager             // manage recovery issues
-	vcMgr       *vcManager                   // manage viewchange issues
-	peerMgr     *peerManager                 // manage node status including route table, the connected peers and so on
-	epochMgr    *epochManager                // manage epoch issues
-	storage     Storage                      // manage non-volatile storage of consensus log
+	status      *statusManager                // keep all basic status of rbft in this object
+	timerMgr    *timerManager                 // manage rbft event timers
+	exec        *executor                     // manage transaction execution
+	storeMgr    *storeManager[T, Constraint]  // manage memory log storage
+	batchMgr    *batchManager[T, Constraint]  // manage request batch related issues
+	recoveryMgr *recoveryManager              // manage recovery issues
+	vcMgr       *vcManager                    // manage viewchange issues
+	peerMgr     *peerManager                  // manage node status including route table, the connected peers and so on
+	epochMgr    *epochManager                 // manage epoch issues
+	storage     Storage                       // manage non-volatile storage of consensus log
+	vrf         VRF                           // generate and verify VRF proof of proposer, nil if VRF seed is disabled
+	keyRotator  KeyRotator                    // verify rotated keys and activate new key of local node, nil if key rotation is disabled
+	validator   BatchValidator[T, Constraint] // check batches proposed by primary, nil if not implemented by external stack
+	tracker     StateUpdateTracker            // issue state update with request id, nil if not implemented by external stack
+	rateLimiter *msgRateLimiter               // limit the rate of consensus messages from each peer
+	sigCache    *sigCache                     // cache verified signatures
+	nonceFilter *msgNonceFilter               // reject replayed or duplicated consensus messages by nonce
+	fetcher     *fetcher                      // fetch missing batches and txs from multiple peers
+	keys        *validatorKeys                // consensus key state of validators which have rotated their keys
+
+	snapshotSync *snapshotSync // ongoing snapshot sync, nil if not in snapshot sync
 
 	recvChan chan consensusEvent // channel to receive ordered consensus messages and local events
+	inbound  *inboundScheduler   // queue remote consensus messages and request sets by class, nil if disabled
 	delFlag  chan bool           // channel to stop namespace when there is a non-recoverable error
 	close    chan bool           // channel to close this event process
 
+	sigVerifyJobs     chan *sigVerifyJob // channel to send consensus messages to verify workers
+	snapshotServeJobs chan func()        // channel to send snapshot requests to serve workers
+
 	flowControl       bool // whether limit flow or not
 	flowControlMaxMem int  // the max memory size of txs in request set
 
 	reusableRequestBatch     *consensus.FetchBatchResponse // special struct to reuse the biggest message in rbft.
 	highWatermarkTimerReason string                        // reason to trigger high watermark timer
+	txForwardPrimaryID       uint64                        // primary which request sets are forwarded to last time
+
+	stateUpdateProgress *types.StateUpdateProgress // progress of ongoing state update, nil if not in state update
+	stateUpdateStart    time.Time                  // time the ongoing state update was issued
+	stateUpdateID       uint64                     // id of the latest state update request
 
-	viewLock  sync.RWMutex // mutex to set value of view
-	hLock     sync.RWMutex // mutex to set value of h
-	epochLock sync.RWMutex // mutex to set value of view
+	viewLock        sync.RWMutex // mutex to set value of view
+	hLock           sync.RWMutex // mutex to set value of h
+	epochLock       sync.RWMutex // mutex to set value of view
+	stateUpdateLock sync.RWMutex // mutex to set value of state update progress
 
 	wg sync.WaitGroup // make sure the listener has been closed
 
@@ -166,6 +355,9 @@ func newRBFT[T any, Constraint kittypes.TXConstraint[T]](c Config, external Exte
 			c.CommittedBlockCacheNumber = c.GenesisEpochInfo.ConsensusParams.CheckpointPeriod
 		}
 	}
+	if c.BatchPolicy != nil && c.BatchPolicy.BatchTimeout() > 0 {
+		c.BatchTimeout = c.BatchPolicy.BatchTimeout()
+	}
 
 	// init message event converter
 	once.Do(initMsgEventMap)
@@ -182,11 +374,15 @@ func newRBFT[T any, Constraint kittypes.TXConstraint[T]](c Config, external Exte
 		DynamicChainConfig: DynamicChainConfig{
 			H:                           c.GenesisEpochInfo.StartBlock,
 			RecentBlockProcessorTracker: NewBlockProcessorTracker(external.GetBlockMeta),
+			ReputationTracker:           NewReputationTracker(external.GetBlockMeta),
 		},
 		SelfP2PNodeID:    c.SelfP2PNodeID,
 		logger:           c.Logger,
 		getNodeInfoFn:    external.GetNodeInfo,
 		getNodeIDByP2PID: external.GetNodeIDByP2PID,
+
+		getVoteAggregationTypeFn: c.GetVoteAggregationTypeFn,
+		isFastPathEnabledFn:      c.IsFastPathEnabledFn,
 	}
 	rbft := &rbftImpl[T, Constraint]{
 		chainConfig:          chainConfig,
@@ -204,6 +400,50 @@ func newRBFT[T any, Constraint kittypes.TXConstraint[T]](c Config, external Exte
 		isTest:               isTest,
 	}
 
+	if c.EnableVRFSeed {
+		vrf, ok := any(external).(VRF)
+		if !ok {
+			return nil, errors.New("crypto of external stack doesn't implement VRF")
+		}
+		rbft.vrf = vrf
+	}
+
+	if c.EnableKeyRotation {
+		keyRotator, ok := any(external).(KeyRotator)
+		if !ok {
+			return nil, errors.New("crypto of external stack doesn't implement KeyRotator")
+		}
+		rbft.keyRotator = keyRotator
+	}
+
+	// messages of a peer are reordered by at most the number of queued messages, which must be within
+	// the nonce window, otherwise delayed messages are rejected as stale.
+	if c.InboundQueueSize > 0 && c.MsgNonceWindow > 0 && c.MsgNonceWindow < 3*c.InboundQueueSize {
+		return nil, errors.Errorf("msg nonce window %d must be at least 3 times of inbound queue size %d",
+			c.MsgNonceWindow, c.InboundQueueSize)
+	}
+
+	// txs exceeding the batch policy would block their senders in request pool if they're not evicted.
+	if c.BatchPolicy != nil {
+		if _, ok := any(requestPool).(RequestEvictor); !ok {
+			return nil, errors.New("request pool doesn't implement RequestEvictor")
+		}
+	}
+
+	if c.EpochProofRetention != 0 {
+		if _, ok := any(external).(EpochStatePruner); !ok {
+			return nil, errors.New("epoch service of external stack doesn't implement EpochStatePruner")
+		}
+	}
+
+	if validator, ok := any(external).(BatchValidator[T, Constraint]); ok {
+		rbft.validator = validator
+	}
+
+	if tracker, ok := any(external).(StateUpdateTracker); ok {
+		rbft.tracker = tracker
+	}
+
 	var err error
 	// new metrics instance
 	rbft.metrics, err = newRBFTMetrics(c.MetricsProv)
@@ -213,6 +453,26 @@ func newRBFT[T any, Constraint kittypes.TXConstraint[T]](c Config, external Exte
 		return nil, err
 	}
 
+	// new inbound scheduler
+	if c.InboundQueueSize > 0 {
+		rbft.inbound = newInboundScheduler(c.InboundQueueSize, rbft.metrics)
+	}
+
+	// new signature verification stage
+	rbft.sigCache = newSigCache(sigCacheSize)
+	rbft.sigVerifyJobs = make(chan *sigVerifyJob, c.SigVerifyWorkers)
+	rbft.snapshotServeJobs = make(chan func(), snapshotServeQueueSize)
+	rbft.keys = newValidatorKeys(external, c.Logger)
+
+	// new message nonce filter
+	rbft.nonceFilter = newMsgNonceFilter(c, external, rbft.metrics)
+
+	// new message rate limiter
+	rbft.rateLimiter = newMsgRateLimiter(c, rbft.metrics)
+
+	// new multi-peer fetcher
+	rbft.fetcher = newFetcher(c)
+
 	// new timer manager
 	rbft.timerMgr = newTimerMgr(rbft.recvChan, c)
 
@@ -220,7 +480,7 @@ func newRBFT[T any, Constraint kittypes.TXConstraint[T]](c Config, external Exte
 	rbft.status = newStatusMgr()
 
 	// new peer pool
-	rbft.peerMgr = newPeerManager(chainConfig, rbft.metrics, external, c, isTest)
+	rbft.peerMgr = newPeerManager(chainConfig, rbft.metrics, external, external, external, c, isTest)
 
 	// new executor
 	rbft.exec = newExecutor()
@@ -257,7 +517,12 @@ func (rbft *rbftImpl[T, Constraint]) init() error {
 		rbft.logger.Errorf("Replica restore state failed: %s", rErr)
 		return rErr
 	}
-	rbft.chainConfig.ResetRecentBlockNum(rbft.chainConfig.LastCheckpointExecBlockHeight)
+	// load after restoring epoch which BFT time depends on.
+	rbft.exec.lastExecTimestamp = rbft.loadBlockTimestamp(rbft.exec.lastExec)
+	if err := rbft.chainConfig.ResetRecentBlockNum(rbft.chainConfig.LastCheckpointExecBlockHeight); err != nil {
+		rbft.logger.Errorf("Replica reset recent blocks failed: %s", err)
+		return err
+	}
 
 	rbft.initTimers()
 	rbft.initStatus()
@@ -270,6 +535,23 @@ func (rbft *rbftImpl[T, Constraint]) init() error {
 	rbft.metrics.quorumSizeGauge.Set(float64(rbft.commonCaseQuorum()))
 
 	rbft.logger.Infof("RBFT enable wrf = %v", rbft.chainConfig.isProposerElectionTypeWRF())
+	rbft.logger.Infof("RBFT enable reputation = %v", rbft.chainConfig.isProposerElectionTypeReputation())
+	rbft.logger.Infof("RBFT enable vrf seed = %v", rbft.isVRFSeedEnabled())
+	rbft.logger.Infof("RBFT enable key rotation = %v", rbft.isKeyRotationEnabled())
+	rbft.logger.Infof("RBFT forward txs to primary = %v", rbft.config.ForwardTxsToPrimary)
+	rbft.logger.Infof("RBFT msg rate limits = %v", rbft.config.MsgRateLimits)
+	rbft.logger.Infof("RBFT inbound queue size = %v", rbft.config.InboundQueueSize)
+	rbft.logger.Infof("RBFT signature verify workers = %v", rbft.config.SigVerifyWorkers)
+	rbft.logger.Infof("RBFT msg nonce window = %v", rbft.config.MsgNonceWindow)
+	rbft.logger.Infof("RBFT fetch peers = %v", rbft.config.FetchPeers)
+	rbft.logger.Infof("RBFT snapshot sync = %v", rbft.isSnapshotSyncEnabled())
+	rbft.logger.Infof("RBFT serve snapshot = %v", rbft.config.SnapshotProvider != nil)
+	rbft.logger.Infof("RBFT state update timeout = %v", rbft.config.StateUpdateTimeout)
+	rbft.logger.Infof("RBFT epoch proof cache size = %v", rbft.epochMgr.proofCacheSize)
+	rbft.logger.Infof("RBFT epoch proof retention = %v", rbft.config.EpochProofRetention)
+	if rbft.config.PayloadCompressor != nil {
+		rbft.logger.Infof("RBFT payload compression = %v", rbft.config.PayloadCompressor.Type())
+	}
 	rbft.logger.Infof("RBFT epoch period = %v", rbft.chainConfig.EpochInfo.EpochPeriod)
 	rbft.logger.Infof("RBFT current epoch = %v", rbft.chainConfig.EpochInfo.Epoch)
 	rbft.logger.Infof("RBFT current view = %v", rbft.chainConfig.View)
@@ -284,6 +566,18 @@ func (rbft *rbftImpl[T, Constraint]) init() error {
 	rbft.logger.Infof("RBFT isTimed: %v", rbft.chainConfig.EpochInfo.ConsensusParams.EnableTimedGenEmptyBlock)
 	rbft.logger.Infof("RBFT minimum number of batches to retain after checkpoint = %v", rbft.config.CommittedBlockCacheNumber)
 	rbft.logger.Infof("RBFT check pool timeout = %v", rbft.config.CheckPoolTimeout)
+	rbft.logger.Infof("RBFT max in-flight batches = %v", rbft.config.MaxInFlightBatches)
+	rbft.logger.Infof("RBFT vote aggregation type = %v", rbft.chainConfig.VoteAggregationType)
+	rbft.logger.Infof("RBFT enable fast path = %v", rbft.chainConfig.isFastPathEnabled())
+	rbft.logger.Infof("RBFT max batch timestamp drift = %v", rbft.config.MaxBatchTimestampDrift)
+	rbft.logger.Infof("RBFT enable BFT time = %v", rbft.config.EnableBFTTime)
+	rbft.logger.Infof("RBFT batch policy = %+v", rbft.config.BatchPolicy)
+	rbft.logger.Infof("RBFT ordering policy = %T", rbft.config.OrderingPolicy)
+	rbft.logger.Infof("RBFT enable threshold decryption = %v", rbft.isThresholdDecryptionEnabled())
+	rbft.logger.Infof("RBFT decryption share timeout = %v", rbft.timerMgr.getTimeoutValue(decryptionShareTimer))
+	if rbft.config.EnableBFTTime && !rbft.isBFTTimeEnabled() {
+		rbft.logger.Warningf("RBFT BFT time needs linear vote aggregation without fast path, use timestamp of primary instead")
+	}
 
 	config := txpool.ConsensusConfig{
 		SelfID:                rbft.chainConfig.SelfID,
@@ -321,6 +615,10 @@ func (rbft *rbftImpl[T, Constraint]) start() error {
 	}
 
 	// start listen consensus event
+	rbft.startSigVerifyWorkers()
+	if rbft.config.SnapshotProvider != nil {
+		rbft.startSnapshotServeWorkers()
+	}
 	go rbft.listenEvent()
 
 	// NOTE!!! must use goroutine to post the event to avoid blocking the rbft service.
@@ -348,6 +646,14 @@ func (rbft *rbftImpl[T, Constraint]) stop() []*T {
 		rbft.logger.Errorf("drain channel error: %s", err)
 	}
 
+	if rbft.inbound != nil {
+		for _, ev := range rbft.inbound.drain() {
+			if set, ok := ev.(*RequestSet[T, Constraint]); ok {
+				remainTxs = append(remainTxs, set.Requests...)
+			}
+		}
+	}
+
 	rbft.logger.Debugf("get %d remaining txs from recvChan", len(remainTxs))
 
 	// stop listen consensus event
@@ -432,6 +738,13 @@ func (rbft *rbftImpl[T, Constraint]) step(ctx context.Context, msg *consensus.Co
 		return
 	}
 
+	if rbft.config.SigVerifyWorkers > 0 {
+		if _, ok := preVerifyMsgs[msg.Type]; ok {
+			rbft.sigVerifyJobs <- &sigVerifyJob{ctx: ctx, msg: msg}
+			return
+		}
+	}
+
 	// nolint: errcheck
 	rbft.postMsg(&consensusMessageWrapper{
 		ctx:              ctx,
@@ -439,14 +752,18 @@ func (rbft *rbftImpl[T, Constraint]) step(ctx context.Context, msg *consensus.Co
 	})
 }
 
-// postRequests informs RBFT tx set event which is posted from application layer.
-func (rbft *rbftImpl[T, Constraint]) postRequests(requests *RequestSet[T, Constraint]) {
+// postRequests informs RBFT tx set event which is posted from application layer. With the inbound
+// scheduler, it returns an error rather than blocking if the data queue is full.
+func (rbft *rbftImpl[T, Constraint]) postRequests(requests *RequestSet[T, Constraint]) error {
 	if rbft.atomicIn(Pending) {
 		rbft.logger.Debugf("Replica %d is in pending status, reject propose request", rbft.chainConfig.SelfID)
-		return
+		return nil
+	}
+	if rbft.inbound != nil {
+		return rbft.inbound.postData(requests)
 	}
-
 	rbft.postMsg(requests)
+	return nil
 }
 
 // postBatches informs RBFT batch event which is usually generated by request pool.
@@ -458,6 +775,18 @@ func (rbft *rbftImpl[T, Constraint]) postBatches(batches []*txpool.RequestHashBa
 
 // postMsg posts messages to main loop.
 func (rbft *rbftImpl[T, Constraint]) postMsg(msg any) {
+	if rbft.inbound != nil {
+		switch e := msg.(type) {
+		case *consensusMessageWrapper:
+			rbft.inbound.postMsg(e, rbft.close)
+			return
+		case *RequestSet[T, Constraint]:
+			if err := rbft.inbound.postData(e); err != nil {
+				rbft.logger.Warningf("Replica %d drop request set: %s", rbft.chainConfig.SelfID, err)
+			}
+			return
+		}
+	}
 	rbft.recvChan <- msg
 }
 
@@ -517,6 +846,13 @@ func (rbft *rbftImpl[T, Constraint]) getStatus() (status NodeStatus) {
 	status.EpochInfo = rbft.chainConfig.EpochInfo.Clone()
 	rbft.epochLock.RUnlock()
 
+	rbft.stateUpdateLock.RLock()
+	if rbft.stateUpdateProgress != nil {
+		progress := *rbft.stateUpdateProgress
+		status.StateUpdate = &progress
+	}
+	rbft.stateUpdateLock.RUnlock()
+
 	status.ID = rbft.chainConfig.SelfID
 	switch {
 	case rbft.atomicIn(InConfChange):
@@ -549,30 +885,40 @@ func (rbft *rbftImpl[T, Constraint]) listenEvent() {
 	rbft.wg.Add(1)
 	defer rbft.wg.Done()
 	for {
-		select {
-		case <-rbft.close:
-			rbft.logger.Notice("exit RBFT event listener")
-			return
-		case next := <-rbft.recvChan:
-			cm, isConsensusMessage := next.(*consensusMessageWrapper)
-			for {
-				select {
-				case <-rbft.close:
-					rbft.logger.Notice("exit RBFT event listener")
-					return
-				default:
-				}
-				next = rbft.processEvent(next)
-				if next == nil {
-					break
-				}
+		var next consensusEvent
+		if rbft.inbound != nil {
+			var ok bool
+			if next, ok = rbft.inbound.next(rbft.close, rbft.recvChan); !ok {
+				rbft.logger.Notice("exit RBFT event listener")
+				return
 			}
-			// check view after finished process consensus messages from remote node as current view may
-			// be changed because of above consensus messages.
-			if isConsensusMessage {
-				rbft.checkView(cm.ConsensusMessage)
+		} else {
+			select {
+			case <-rbft.close:
+				rbft.logger.Notice("exit RBFT event listener")
+				return
+			case next = <-rbft.recvChan:
 			}
 		}
+
+		cm, isConsensusMessage := next.(*consensusMessageWrapper)
+		for {
+			select {
+			case <-rbft.close:
+				rbft.logger.Notice("exit RBFT event listener")
+				return
+			default:
+			}
+			next = rbft.processEvent(next)
+			if next == nil {
+				break
+			}
+		}
+		// check view after finished process consensus messages from remote node as current view may
+		// be changed because of above consensus messages.
+		if isConsensusMessage {
+			rbft.checkView(cm.ConsensusMessage)
+		}
 	}
 }
 
@@ -660,10 +1006,59 @@ func (rbft *rbftImpl[T, Constraint]) consensusMessageFilter(ctx context.Context,
 	if !rbft.checkMsgCanAccept(msg.Type, msg.From) {
 		return nil
 	}
+	// only validators are tracked by nonce, so that forged senders can't allocate nonce windows.
+	remoteValidator := msg.From != rbft.chainConfig.SelfID && rbft.chainConfig.CheckValidator(msg.From)
+	if msg.From != rbft.chainConfig.SelfID && !rbft.rateLimiter.allow(msg.From, msg.Type) {
+		return nil
+	}
+	if remoteValidator {
+		rbft.peerMgr.recordCompression(msg.From, msg.AcceptCompression)
+	}
+	if err := rbft.peerMgr.decompress(msg); err != nil {
+		rbft.logger.Warningf("Replica %d reject msg from %d: %v", rbft.chainConfig.SelfID, msg.From, err)
+		return nil
+	}
+	if remoteValidator && rbft.nonceFilter.tracks(msg.Nonce) {
+		if err := rbft.verifyValidatorSignature(msg.From, msg.Signature, envelopeDigest(msg)); err != nil {
+			rbft.logger.Warningf("Replica %d reject msg from %d with invalid envelope signature: %v",
+				rbft.chainConfig.SelfID, msg.From, err)
+			return nil
+		}
+		if !rbft.nonceFilter.accept(msg.From, msg.Nonce) {
+			return nil
+		}
+	}
 	msgEvent, err := rbft.msgToEvent(msg)
 	if err != nil {
 		return nil
 	}
+	// decryption shares are stored before verified against the claimed replica, fetch and snapshot data
+	// are accepted from or rejected against the claimed replica, and snapshots are served to the claimed
+	// replica, so they must be sent by it.
+	var claimed uint64
+	switch e := msgEvent.(type) {
+	case *consensus.DecryptionShare:
+		claimed = e.ReplicaId
+	case *consensus.FetchBatchResponse:
+		claimed = e.ReplicaId
+	case *consensus.FetchMissingResponse:
+		claimed = e.ReplicaId
+	case *consensus.SnapshotManifestRequest:
+		claimed = e.ReplicaId
+	case *consensus.SnapshotManifestResponse:
+		claimed = e.ReplicaId
+	case *consensus.SnapshotChunkRequest:
+		claimed = e.ReplicaId
+	case *consensus.SnapshotChunkResponse:
+		claimed = e.ReplicaId
+	default:
+		claimed = msg.From
+	}
+	if claimed != msg.From {
+		rbft.logger.Warningf("Replica %d received msg[%s] of replica %d from replica %d",
+			rbft.chainConfig.SelfID, msg.Type.String(), claimed, msg.From)
+		return nil
+	}
 	start := time.Now()
 	next := rbft.dispatchConsensusMsg(ctx, originEvent, msgEvent)
 	rbft.metrics.processEventDuration.With("event", "consensus_message_"+msg.Type.String()).Observe(time.Since(start).Seconds())
@@ -678,9 +1073,26 @@ func (rbft *rbftImpl[T, Constraint]) dispatchCoreRbftMsg(ctx context.Context, e
 	case *consensus.PrePrepare:
 		return rbft.recvPrePrepare(ctx, et)
 	case *consensus.Prepare:
+		if rbft.chainConfig.isLinearVoteAggregation() {
+			// votes will be aggregated into quorum certificate, so they must be verified here
+			if err := rbft.verifyPrepare(et); err != nil {
+				rbft.logger.Warningf("Replica %d received prepare from replica %d with invalid signature: %s",
+					rbft.chainConfig.SelfID, et.ReplicaId, err)
+				return nil
+			}
+		}
 		return rbft.recvPrepare(ctx, et)
 	case *consensus.Commit:
+		if rbft.chainConfig.isLinearVoteAggregation() {
+			if err := rbft.verifyCommit(et); err != nil {
+				rbft.logger.Warningf("Replica %d received commit from replica %d with invalid signature: %s",
+					rbft.chainConfig.SelfID, et.ReplicaId, err)
+				return nil
+			}
+		}
 		return rbft.recvCommit(ctx, et)
+	case *consensus.AggregatedVotes:
+		return rbft.recvAggregatedVotes(ctx, et)
 	case *consensus.FetchMissingRequest:
 		return rbft.recvFetchMissingRequest(ctx, et)
 	case *consensus.FetchMissingResponse:
@@ -689,6 +1101,10 @@ func (rbft *rbftImpl[T, Constraint]) dispatchCoreRbftMsg(ctx context.Context, e
 		return rbft.recvCheckpoint(et, false)
 	case *consensus.ReBroadcastRequestSet:
 		return rbft.recvReBroadcastRequestSet(et)
+	case *consensus.DecryptionShare:
+		return rbft.recvDecryptionShare(et)
+	case *consensus.KeyRotation:
+		return rbft.recvKeyRotation(et)
 	}
 	return nil
 }
@@ -987,18 +1403,21 @@ func (rbft *rbftImpl[T, Constraint]) processOutOfDateReqs(timeout bool) {
 func (rbft *rbftImpl[T, Constraint]) recvRequestBatch(reqBatch *txpool.RequestHashBatch[T, Constraint]) error {
 	rbft.logger.Debugf("Replica %d received request batch %s", rbft.chainConfig.SelfID, reqBatch.BatchHash)
 
-	batch := &RequestBatch[T, Constraint]{
-		RequestHashList: reqBatch.TxHashList,
-		RequestList:     reqBatch.TxList,
-		Timestamp:       reqBatch.Timestamp,
-		SeqNo:           rbft.batchMgr.getSeqNo() + 1,
-		LocalList:       reqBatch.LocalList,
-		BatchHash:       reqBatch.BatchHash,
-		Proposer:        rbft.chainConfig.SelfID,
-	}
-
 	// primary node should reject generate batch when there is a config batch in ordering.
 	if rbft.isPrimary(rbft.chainConfig.SelfID) && rbft.isNormal() && !rbft.atomicIn(InConfChange) && rbft.inPrimaryTerm() {
+		if reqBatch = rbft.reorganizeRequestBatch(reqBatch); reqBatch == nil {
+			return nil
+		}
+		batch := &RequestBatch[T, Constraint]{
+			RequestHashList: reqBatch.TxHashList,
+			RequestList:     reqBatch.TxList,
+			Timestamp:       reqBatch.Timestamp,
+			SeqNo:           rbft.batchMgr.getSeqNo() + 1,
+			LocalList:       reqBatch.LocalList,
+			BatchHash:       reqBatch.BatchHash,
+			Proposer:        rbft.chainConfig.SelfID,
+		}
+
 		// enter config change status once we generate a config batch.
 		if isConfigBatch(batch.SeqNo, rbft.chainConfig.EpochInfo) {
 			rbft.logger.Noticef("Primary %d has generated a config batch, start config change", rbft.chainConfig.SelfID)
@@ -1025,7 +1444,7 @@ func (rbft *rbftImpl[T, Constraint]) recvRequestBatch(reqBatch *txpool.RequestHa
 // normal case: pre-prepare, prepare, commit methods
 // =============================================================================
 // sendPrePrepare send prePrepare message.
-func (rbft *rbftImpl[T, Constraint]) sendPrePrepare(seqNo uint64, digest string, reqBatch *RequestBatch[T, Constraint]) {
+func (rbft *rbftImpl[T, Constraint]) sendPrePrepare(seqNo uint64, digest string, reqBatch *RequestBatch[T, Constraint], bftTimeCommits []*consensus.Commit) {
 	rbft.logger.Debugf("Primary %d sending prePrepare for view=%d/seqNo=%d/digest=%s, "+
 		"batch size: %d, timestamp: %d", rbft.chainConfig.SelfID, rbft.chainConfig.View, seqNo, digest, len(reqBatch.RequestHashList), reqBatch.Timestamp)
 
@@ -1033,19 +1452,15 @@ func (rbft *rbftImpl[T, Constraint]) sendPrePrepare(seqNo uint64, digest string,
 	span.SetAttributes(attribute.Int64("seqNo", int64(seqNo)), attribute.String("digest", digest))
 	defer span.End()
 
-	hashBatch := &consensus.HashBatch{
-		RequestHashList: reqBatch.RequestHashList,
-		Timestamp:       reqBatch.Timestamp,
-		Proposer:        reqBatch.Proposer,
-	}
-
 	preprepare := &consensus.PrePrepare{
 		View:           rbft.chainConfig.View,
 		SequenceNumber: seqNo,
 		BatchDigest:    digest,
-		HashBatch:      hashBatch,
+		HashBatch:      reqBatch.hashBatch(),
 		ReplicaId:      rbft.chainConfig.SelfID,
+		BftTimeCommits: bftTimeCommits,
 	}
+	preprepare.HashBatch.ReceiveTimes = rbft.reportReceiveTimes(reqBatch.RequestList)
 
 	cert := rbft.storeMgr.getCert(rbft.chainConfig.View, seqNo, digest)
 	cert.isConfig = isConfigBatch(reqBatch.SeqNo, rbft.chainConfig.EpochInfo)
@@ -1118,6 +1533,13 @@ func (rbft *rbftImpl[T, Constraint]) recvPrePrepare(ctx context.Context, preprep
 		}
 	}
 
+	if err := rbft.checkBatchTimestamp(preprep); err != nil {
+		rbft.logger.Warningf("Replica %d received a prePrepare with invalid timestamp for view=%d/seqNo=%d: %s, "+
+			"send viewChange", rbft.chainConfig.SelfID, preprep.View, preprep.SequenceNumber, err)
+		rbft.sendViewChange()
+		return nil
+	}
+
 	if rbft.beyondRange(preprep.SequenceNumber) {
 		rbft.logger.Debugf("Replica %d received a pre-prepare out of high-watermark", rbft.chainConfig.SelfID)
 		rbft.softStartHighWatermarkTimer("replica received a pre-prepare out of range")
@@ -1135,9 +1557,15 @@ func (rbft *rbftImpl[T, Constraint]) recvPrePrepare(ctx context.Context, preprep
 			rbft.logger.Noticef("Replica %d finds %d duplicate txs with digest %s, detailed: %+v",
 				rbft.chainConfig.SelfID, len(preprep.HashBatch.DeDuplicateRequestHashList), preprep.HashBatch.DeDuplicateRequestHashList)
 		}
+		if err := checkKeyRotationsSorted(preprep.HashBatch.KeyRotations); err != nil {
+			rbft.logger.Warningf("Replica %d received a prePrepare with invalid key rotations: %s, send viewChange",
+				rbft.chainConfig.SelfID, err)
+			rbft.sendViewChange()
+			return nil
+		}
 		// check if the digest sent from primary is really the hash of txHashList, if not, don't
 		// send prepare for this prePrepare
-		batchDigest := calculateMD5Hash(preprep.HashBatch.RequestHashList, preprep.HashBatch.Timestamp)
+		batchDigest := calculateBatchDigest(preprep.HashBatch)
 		if batchDigest != preprep.BatchDigest {
 			rbft.logger.Warningf("Replica %d received a prePrepare with a wrong batch digest, calculated: %s "+
 				"primary calculated: %s, send viewChange", rbft.chainConfig.SelfID, batchDigest, preprep.BatchDigest)
@@ -1146,6 +1574,13 @@ func (rbft *rbftImpl[T, Constraint]) recvPrePrepare(ctx context.Context, preprep
 		}
 	}
 
+	if err := rbft.checkBatchBFTTime(preprep); err != nil {
+		rbft.logger.Warningf("Replica %d received a prePrepare with invalid BFT time for view=%d/seqNo=%d: %s, "+
+			"send viewChange", rbft.chainConfig.SelfID, preprep.View, preprep.SequenceNumber, err)
+		rbft.sendViewChange()
+		return nil
+	}
+
 	// in recovery, we would fetch recovery PQC, and receive these PQC again,
 	// and we cannot stop timer in this situation, so we check seqNo here.
 	if preprep.SequenceNumber > rbft.exec.lastExec {
@@ -1172,6 +1607,7 @@ func (rbft *rbftImpl[T, Constraint]) recvPrePrepare(ctx context.Context, preprep
 	}
 
 	rbft.persistQSet(preprep)
+	rbft.updatePipelineMetrics()
 
 	if !rbft.isPrimary(rbft.chainConfig.SelfID) && !cert.sentPrepare {
 		return rbft.findNextPrepareBatch(ctx, preprep.View, preprep.SequenceNumber, preprep.BatchDigest)
@@ -1197,6 +1633,14 @@ func (rbft *rbftImpl[T, Constraint]) sendPrepare(ctx context.Context, v uint64,
 	}
 
 	if rbft.chainConfig.isValidator() {
+		if rbft.chainConfig.isLinearVoteAggregation() {
+			sig, err := rbft.signPrepare(prep)
+			if err != nil {
+				rbft.logger.Errorf("Replica %d sign prepare failed: %s", rbft.chainConfig.SelfID, err)
+				return nil
+			}
+			prep.Signature = sig
+		}
 		payload, err := prep.MarshalVTStrict()
 		if err != nil {
 			rbft.logger.Errorf("ConsensusMessage_PREPARE Marshal Error: %s", err)
@@ -1206,7 +1650,12 @@ func (rbft *rbftImpl[T, Constraint]) sendPrepare(ctx context.Context, v uint64,
 			Type:    consensus.Type_PREPARE,
 			Payload: payload,
 		}
-		rbft.peerMgr.broadcast(ctx, consensusMsg)
+		if rbft.chainConfig.isLinearVoteAggregation() {
+			// only send to primary which will aggregate prepares into a quorum certificate
+			rbft.peerMgr.unicast(ctx, consensusMsg, rbft.chainConfig.PrimaryID)
+		} else {
+			rbft.peerMgr.broadcast(ctx, consensusMsg)
+		}
 	} else {
 		rbft.logger.Debugf("Replica %d is not validator, not broadcast prepare",
 			rbft.chainConfig.SelfID)
@@ -1221,6 +1670,15 @@ func (rbft *rbftImpl[T, Constraint]) recvPrepare(ctx context.Context, prep *cons
 	ctx, span := rbft.tracer.Start(ctx, "recvPrepare")
 	defer span.End()
 
+	if !rbft.addPrepare(prep) {
+		return nil
+	}
+	return rbft.maybeSendCommit(ctx, prep.View, prep.SequenceNumber, prep.BatchDigest)
+}
+
+// addPrepare checks the given prepare and stores it into cert, returns false if the prepare is
+// illegal or duplicate.
+func (rbft *rbftImpl[T, Constraint]) addPrepare(prep *consensus.Prepare) bool {
 	rbft.logger.Debugf("Replica %d received prepare from replica %d for view=%d/seqNo=%d",
 		rbft.chainConfig.SelfID, prep.ReplicaId, prep.View, prep.SequenceNumber)
 
@@ -1242,7 +1700,7 @@ func (rbft *rbftImpl[T, Constraint]) recvPrepare(ctx context.Context, prep *cons
 			}
 		}
 
-		return nil
+		return false
 	}
 
 	cert := rbft.storeMgr.getCert(prep.View, prep.SequenceNumber, prep.BatchDigest)
@@ -1252,12 +1710,12 @@ func (rbft *rbftImpl[T, Constraint]) recvPrepare(ctx context.Context, prep *cons
 		if prep.SequenceNumber <= rbft.exec.lastExec {
 			rbft.logger.Debugf("Replica %d received duplicate prepare from replica %d, view=%d/seqNo=%d, self lastExec=%d",
 				rbft.chainConfig.SelfID, prep.ReplicaId, prep.View, prep.SequenceNumber, rbft.exec.lastExec)
-			return nil
+			return false
 		}
 		// this is abnormal in consensus case
 		rbft.logger.Infof("Replica %d ignore duplicate prepare from replica %d, view=%d/seqNo=%d",
 			rbft.chainConfig.SelfID, prep.ReplicaId, prep.View, prep.SequenceNumber)
-		return nil
+		return false
 	}
 	if !rbft.chainConfig.isValidator() && prep.ReplicaId == rbft.chainConfig.SelfID {
 		rbft.logger.Debugf("Replica %d is not validator, not store self prepare msg to cert",
@@ -1265,8 +1723,7 @@ func (rbft *rbftImpl[T, Constraint]) recvPrepare(ctx context.Context, prep *cons
 	} else {
 		cert.prepare[prepID] = prep
 	}
-
-	return rbft.maybeSendCommit(ctx, prep.View, prep.SequenceNumber, prep.BatchDigest)
+	return true
 }
 
 // maybeSendCommit check if we could send commit. if no problem,
@@ -1297,11 +1754,32 @@ func (rbft *rbftImpl[T, Constraint]) maybeSendCommit(ctx context.Context, v uint
 		return nil
 	}
 
+	if rbft.chainConfig.isFastPathEnabled() && !cert.fastPathTimeout {
+		// primary and all backups have voted for this batch.
+		if len(cert.prepare) >= rbft.chainConfig.N-1 {
+			return rbft.fastCommit(ctx, v, n, d)
+		}
+		// only the collector waits for the remaining prepares in linear vote aggregation mode, backups
+		// fall back to commit phase directly if the aggregated prepares is not full.
+		if !rbft.chainConfig.isLinearVoteAggregation() || rbft.isPrimary(rbft.chainConfig.SelfID) {
+			rbft.startFastPathTimer(msgID{v: v, n: n, d: d}, cert)
+			return nil
+		}
+	}
+	rbft.stopFastPathTimer(cert)
+
 	if metrics.EnableExpensive() {
 		cert.preparedTime = time.Now().UnixNano()
 		duration := time.Duration(cert.preparedTime - cert.prePreparedTime).Seconds()
 		rbft.metrics.prePreparedToPrepared.Observe(duration)
 	}
+
+	if rbft.isPrimary(rbft.chainConfig.SelfID) && rbft.chainConfig.isLinearVoteAggregation() {
+		rbft.sendAggregatedVotes(ctx, &consensus.AggregatedVotes{
+			ReplicaId: rbft.chainConfig.SelfID,
+			Prepares:  lo.Values(cert.prepare),
+		})
+	}
 	return rbft.sendCommit(ctx, v, n, d)
 }
 
@@ -1322,8 +1800,19 @@ func (rbft *rbftImpl[T, Constraint]) sendCommit(ctx context.Context, v uint64, n
 	}
 
 	rbft.persistPSet(v, n, d)
+	if rbft.isBFTTimeEnabled() {
+		commit.Timestamp = time.Now().UnixNano()
+	}
 
 	if rbft.chainConfig.isValidator() {
+		if rbft.chainConfig.isLinearVoteAggregation() {
+			sig, err := rbft.signCommit(commit)
+			if err != nil {
+				rbft.logger.Errorf("Replica %d sign commit failed: %s", rbft.chainConfig.SelfID, err)
+				return nil
+			}
+			commit.Signature = sig
+		}
 		payload, err := commit.MarshalVTStrict()
 		if err != nil {
 			rbft.logger.Errorf("ConsensusMessage_COMMIT Marshal Error: %s", err)
@@ -1333,7 +1822,14 @@ func (rbft *rbftImpl[T, Constraint]) sendCommit(ctx context.Context, v uint64, n
 			Type:    consensus.Type_COMMIT,
 			Payload: payload,
 		}
-		rbft.peerMgr.broadcast(ctx, consensusMsg)
+		if rbft.chainConfig.isLinearVoteAggregation() {
+			// primary collects commit of itself directly
+			if !rbft.isPrimary(rbft.chainConfig.SelfID) {
+				rbft.peerMgr.unicast(ctx, consensusMsg, rbft.chainConfig.PrimaryID)
+			}
+		} else {
+			rbft.peerMgr.broadcast(ctx, consensusMsg)
+		}
 	} else {
 		rbft.logger.Debugf("Replica %d is not validator, not broadcast commit",
 			rbft.chainConfig.SelfID)
@@ -1394,6 +1890,19 @@ func (rbft *rbftImpl[T, Constraint]) recvCommit(ctx context.Context, commit *con
 		cert.commit[commitID] = commit
 	}
 
+	if cert.fastCommitted && commit.ReplicaId != rbft.chainConfig.SelfID && !rbft.chainConfig.isLinearVoteAggregation() {
+		// some replicas have fallen back to commit phase, help them to collect enough commits.
+		selfCommitID := (&consensus.Commit{
+			ReplicaId:      rbft.chainConfig.SelfID,
+			View:           commit.View,
+			SequenceNumber: commit.SequenceNumber,
+			BatchDigest:    commit.BatchDigest,
+		}).ID()
+		if _, ok := cert.commit[selfCommitID]; !ok && rbft.chainConfig.isValidator() {
+			return rbft.sendCommit(ctx, commit.View, commit.SequenceNumber, commit.BatchDigest)
+		}
+	}
+
 	if rbft.committed(commit.View, commit.SequenceNumber, commit.BatchDigest) {
 		idx := msgID{v: commit.View, n: commit.SequenceNumber, d: commit.BatchDigest}
 		if metrics.EnableExpensive() {
@@ -1402,17 +1911,18 @@ func (rbft *rbftImpl[T, Constraint]) recvCommit(ctx context.Context, commit *con
 			rbft.metrics.preparedToCommitted.Observe(duration)
 		}
 		if !cert.sentExecute && cert.sentCommit {
-			rbft.storeMgr.committedCert[idx] = commit.BatchDigest
-			rbft.commitPendingBlocks()
-
-			if !rbft.in(waitCheckpointBatchExecute) {
-				// reset last new view timeout after commit one block successfully.
-				rbft.vcMgr.lastNewViewTimeout = rbft.timerMgr.getTimeoutValue(newViewTimer)
-				if commit.SequenceNumber == rbft.vcMgr.viewChangeSeqNo {
-					rbft.logger.Warningf("Replica %d cycling view for seqNo=%d", rbft.chainConfig.SelfID, commit.SequenceNumber)
-					rbft.sendViewChange()
+			if rbft.isPrimary(rbft.chainConfig.SelfID) && rbft.chainConfig.isLinearVoteAggregation() && !cert.sentAggregatedCommits {
+				cert.sentAggregatedCommits = true
+				commits := lo.Values(cert.commit)
+				if rbft.isBFTTimeEnabled() {
+					rbft.recordBFTTimeCommits(commits)
 				}
+				rbft.sendAggregatedVotes(ctx, &consensus.AggregatedVotes{
+					ReplicaId: rbft.chainConfig.SelfID,
+					Commits:   commits,
+				})
 			}
+			rbft.commitCert(idx)
 		} else {
 			rbft.logger.Debugf("Replica %d committed for seqNo: %d, but sentExecute: %v",
 				rbft.chainConfig.SelfID, commit.SequenceNumber, cert.sentExecute)
@@ -1421,6 +1931,196 @@ func (rbft *rbftImpl[T, Constraint]) recvCommit(ctx context.Context, commit *con
 	return nil
 }
 
+// commitCert marks the batch with given msgID as committed and tries to execute it.
+func (rbft *rbftImpl[T, Constraint]) commitCert(idx msgID) {
+	rbft.storeMgr.committedCert[idx] = idx.d
+	// release decryption share as soon as the batch is committed rather than waiting for previous batches
+	// to be executed, so that batches committed in a row are decrypted in parallel.
+	if rbft.isThresholdDecryptionEnabled() && idx.d != "" {
+		rbft.decryptCommittedBatch(idx.n, idx.d)
+	}
+	rbft.commitPendingBlocks()
+
+	if !rbft.in(waitCheckpointBatchExecute) {
+		// reset last new view timeout after commit one block successfully.
+		rbft.vcMgr.lastNewViewTimeout = rbft.timerMgr.getTimeoutValue(newViewTimer)
+		if idx.n == rbft.vcMgr.viewChangeSeqNo {
+			rbft.logger.Warningf("Replica %d cycling view for seqNo=%d", rbft.chainConfig.SelfID, idx.n)
+			rbft.sendViewChange()
+		} else {
+			// primary which is transferring leadership may have committed its last in-flight batch.
+			rbft.maybeSendLeaderTransfer()
+		}
+	}
+}
+
+// fastCommit commits the batch without commit phase as prepares from all replicas have been collected,
+// which means all correct replicas have pre-prepared this batch. Other replicas may not have collected
+// a prepared certificate, so the batch survives view change by selectFastPathBatch rather than calcPSet.
+func (rbft *rbftImpl[T, Constraint]) fastCommit(ctx context.Context, v uint64, n uint64, d string) error {
+	cert := rbft.storeMgr.getCert(v, n, d)
+	rbft.stopFastPathTimer(cert)
+	cert.sentCommit = true
+	cert.fastCommitted = true
+
+	rbft.logger.Debugf("Replica %d fast commit for view=%d/seqNo=%d", rbft.chainConfig.SelfID, v, n)
+	rbft.metrics.fastPathCommitCounter.Add(float64(1))
+	rbft.persistPSet(v, n, d)
+
+	if rbft.isPrimary(rbft.chainConfig.SelfID) && rbft.chainConfig.isLinearVoteAggregation() {
+		// backups can fast commit after receiving the full prepares.
+		rbft.sendAggregatedVotes(ctx, &consensus.AggregatedVotes{
+			ReplicaId: rbft.chainConfig.SelfID,
+			Prepares:  lo.Values(cert.prepare),
+		})
+	}
+
+	if cert.sentExecute {
+		return nil
+	}
+	rbft.commitCert(msgID{v: v, n: n, d: d})
+	return nil
+}
+
+// sendAggregatedVotes is used by primary to broadcast the aggregated prepares or commits in linear
+// vote aggregation mode.
+func (rbft *rbftImpl[T, Constraint]) sendAggregatedVotes(ctx context.Context, votes *consensus.AggregatedVotes) {
+	rbft.logger.Debugf("Primary %d sending aggregated votes with %d prepares and %d commits",
+		rbft.chainConfig.SelfID, len(votes.Prepares), len(votes.Commits))
+
+	payload, err := votes.MarshalVTStrict()
+	if err != nil {
+		rbft.logger.Errorf("ConsensusMessage_AGGREGATED_VOTES Marshal Error: %s", err)
+		return
+	}
+	consensusMsg := &consensus.ConsensusMessage{
+		Type:    consensus.Type_AGGREGATED_VOTES,
+		Payload: payload,
+	}
+	rbft.peerMgr.broadcast(ctx, consensusMsg)
+}
+
+// recvAggregatedVotes process logic after receive aggregated votes from primary, the aggregated
+// votes are treated as a quorum certificate only if it contains enough valid votes for the same batch.
+func (rbft *rbftImpl[T, Constraint]) recvAggregatedVotes(ctx context.Context, votes *consensus.AggregatedVotes) error {
+	ctx, span := rbft.tracer.Start(ctx, "recvAggregatedVotes")
+	defer span.End()
+
+	rbft.logger.Debugf("Replica %d received aggregated votes from replica %d with %d prepares and %d commits",
+		rbft.chainConfig.SelfID, votes.ReplicaId, len(votes.Prepares), len(votes.Commits))
+
+	if !rbft.chainConfig.isLinearVoteAggregation() {
+		rbft.logger.Warningf("Replica %d ignore aggregated votes from replica %d as it's not in linear vote aggregation mode",
+			rbft.chainConfig.SelfID, votes.ReplicaId)
+		return nil
+	}
+	if !rbft.isPrimary(votes.ReplicaId) {
+		rbft.logger.Warningf("Replica %d ignore aggregated votes from non-primary replica %d",
+			rbft.chainConfig.SelfID, votes.ReplicaId)
+		return nil
+	}
+
+	if len(votes.Prepares) != 0 && len(votes.Commits) == 0 {
+		if err := rbft.checkAggregatedPrepares(votes.Prepares); err != nil {
+			rbft.logger.Warningf("Replica %d received invalid aggregated prepares from primary %d: %s",
+				rbft.chainConfig.SelfID, votes.ReplicaId, err)
+			return nil
+		}
+		// store all prepares before checking commit, so that fast path could see the full prepares.
+		added := false
+		for _, prep := range votes.Prepares {
+			if prep.ReplicaId == rbft.chainConfig.SelfID {
+				continue
+			}
+			if rbft.addPrepare(prep) {
+				added = true
+			}
+		}
+		if !added {
+			return nil
+		}
+		first := votes.Prepares[0]
+		return rbft.maybeSendCommit(ctx, first.View, first.SequenceNumber, first.BatchDigest)
+	}
+
+	if len(votes.Commits) != 0 && len(votes.Prepares) == 0 {
+		if err := rbft.checkAggregatedCommits(votes.Commits); err != nil {
+			rbft.logger.Warningf("Replica %d received invalid aggregated commits from primary %d: %s",
+				rbft.chainConfig.SelfID, votes.ReplicaId, err)
+			return nil
+		}
+		if rbft.isBFTTimeEnabled() {
+			// carried by the next pre-prepare if this replica becomes primary.
+			rbft.recordBFTTimeCommits(votes.Commits)
+		}
+		for _, commit := range votes.Commits {
+			if commit.ReplicaId == rbft.chainConfig.SelfID {
+				continue
+			}
+			if err := rbft.recvCommit(ctx, commit); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	rbft.logger.Warningf("Replica %d received aggregated votes from primary %d with %d prepares and %d commits, "+
+		"expect exactly one kind of votes", rbft.chainConfig.SelfID, votes.ReplicaId, len(votes.Prepares), len(votes.Commits))
+	return nil
+}
+
+// checkAggregatedPrepares checks whether the given prepares form a valid prepare quorum certificate.
+func (rbft *rbftImpl[T, Constraint]) checkAggregatedPrepares(prepares []*consensus.Prepare) error {
+	first := prepares[0]
+	voters := make(map[uint64]struct{}, len(prepares))
+	for _, prep := range prepares {
+		if prep.View != first.View || prep.SequenceNumber != first.SequenceNumber || prep.BatchDigest != first.BatchDigest {
+			return errors.Errorf("mismatch prepare from replica %d for view=%d/seqNo=%d/digest=%s",
+				prep.ReplicaId, prep.View, prep.SequenceNumber, prep.BatchDigest)
+		}
+		if _, ok := voters[prep.ReplicaId]; ok {
+			return errors.Errorf("duplicate prepare from replica %d", prep.ReplicaId)
+		}
+		if !rbft.chainConfig.CheckValidator(prep.ReplicaId) {
+			return errors.Errorf("prepare from non-validator replica %d", prep.ReplicaId)
+		}
+		if err := rbft.verifyPrepare(prep); err != nil {
+			return errors.Wrapf(err, "invalid signature of prepare from replica %d", prep.ReplicaId)
+		}
+		voters[prep.ReplicaId] = struct{}{}
+	}
+	if len(voters) < rbft.commonCaseQuorum()-1 {
+		return errors.Errorf("not enough prepares, need %d, got %d", rbft.commonCaseQuorum()-1, len(voters))
+	}
+	return nil
+}
+
+// checkAggregatedCommits checks whether the given commits form a valid commit quorum certificate.
+func (rbft *rbftImpl[T, Constraint]) checkAggregatedCommits(commits []*consensus.Commit) error {
+	first := commits[0]
+	voters := make(map[uint64]struct{}, len(commits))
+	for _, commit := range commits {
+		if commit.View != first.View || commit.SequenceNumber != first.SequenceNumber || commit.BatchDigest != first.BatchDigest {
+			return errors.Errorf("mismatch commit from replica %d for view=%d/seqNo=%d/digest=%s",
+				commit.ReplicaId, commit.View, commit.SequenceNumber, commit.BatchDigest)
+		}
+		if _, ok := voters[commit.ReplicaId]; ok {
+			return errors.Errorf("duplicate commit from replica %d", commit.ReplicaId)
+		}
+		if !rbft.chainConfig.CheckValidator(commit.ReplicaId) {
+			return errors.Errorf("commit from non-validator replica %d", commit.ReplicaId)
+		}
+		if err := rbft.verifyCommit(commit); err != nil {
+			return errors.Wrapf(err, "invalid signature of commit from replica %d", commit.ReplicaId)
+		}
+		voters[commit.ReplicaId] = struct{}{}
+	}
+	if len(voters) < rbft.commonCaseQuorum() {
+		return errors.Errorf("not enough commits, need %d, got %d", rbft.commonCaseQuorum(), len(voters))
+	}
+	return nil
+}
+
 // fetchMissingTxs fetch missing txs from primary which this node didn't receive but primary received
 func (rbft *rbftImpl[T, Constraint]) fetchMissingTxs(ctx context.Context, prePrep *consensus.PrePrepare, missingTxHashes map[uint64]string) {
 	// avoid fetch the same batch again.
@@ -1457,6 +2157,22 @@ func (rbft *rbftImpl[T, Constraint]) fetchMissingTxs(ctx context.Context, prePre
 		n: prePrep.SequenceNumber,
 		d: prePrep.BatchDigest,
 	}
+	rbft.metrics.pipelineFetchingGauge.Set(float64(len(rbft.storeMgr.missingBatchesInFetching)))
+	if rbft.fetcher.enabled() {
+		rbft.startFetch(&fetchTask{
+			key:             fetchKey{typ: fetchTypeTxs, digest: prePrep.BatchDigest},
+			msg:             consensusMsg,
+			preferred:       prePrep.ReplicaId,
+			missingTxHashes: missingTxHashes,
+		})
+		return
+	}
+	if rbft.isPipelining() {
+		// don't block the event loop on network so that missing txs of different
+		// in-flight batches can be fetched in parallel.
+		rbft.peerMgr.unicastAsync(ctx, consensusMsg, prePrep.ReplicaId)
+		return
+	}
 	rbft.peerMgr.unicast(ctx, consensusMsg, prePrep.ReplicaId)
 }
 
@@ -1571,6 +2287,21 @@ func (rbft *rbftImpl[T, Constraint]) recvFetchMissingResponse(ctx context.Contex
 		return nil
 	}
 
+	// with multi-peer fetcher, responses are accepted from any peer asked, which are verified
+	// against the missing tx hashes.
+	task := rbft.fetcher.get(fetchTypeTxs, re.BatchDigest)
+	if task != nil {
+		if _, ok := task.asked[re.ReplicaId]; !ok {
+			rbft.logger.Warningf("Replica %d received fetchMissingResponse from replica %d which is not "+
+				"asked, ignore it", rbft.chainConfig.SelfID, re.ReplicaId)
+			return nil
+		}
+		if re.Status != consensus.FetchMissingResponse_Success {
+			rbft.rejectFetchResponse(task, re.ReplicaId, errors.New("failure status"))
+			return nil
+		}
+	}
+
 	if len(re.MissingRequests) != len(re.MissingRequestHashes) {
 		rbft.logger.Warningf("Replica %d received mismatch length fetchMissingResponse %v", rbft.chainConfig.SelfID, re)
 		return nil
@@ -1582,7 +2313,7 @@ func (rbft *rbftImpl[T, Constraint]) recvFetchMissingResponse(ctx context.Contex
 		return nil
 	}
 
-	if !rbft.isPrimary(re.ReplicaId) {
+	if task == nil && !rbft.isPrimary(re.ReplicaId) {
 		rbft.logger.Warningf("Replica %d received fetchMissingResponse from replica %d which is not "+
 			"primary, ignore it", rbft.chainConfig.SelfID, re.ReplicaId)
 		return nil
@@ -1610,14 +2341,28 @@ func (rbft *rbftImpl[T, Constraint]) recvFetchMissingResponse(ctx context.Contex
 		requests[i] = &req
 	}
 
+	if task != nil {
+		if err := verifyFetchedTxs[T, Constraint](task.missingTxHashes, requests); err != nil {
+			rbft.rejectFetchResponse(task, re.ReplicaId, err)
+			return nil
+		}
+	}
+
 	err := rbft.batchMgr.requestPool.ReceiveMissingRequests(re.BatchDigest, requests)
 	if err != nil {
+		if task != nil && !rbft.isPrimary(re.ReplicaId) {
+			rbft.rejectFetchResponse(task, re.ReplicaId, err)
+			return nil
+		}
 		// there is something wrong with primary for it propose a transaction with mismatched hash,
 		// so that we should send view-change directly to expect a new leader.
 		rbft.logger.Warningf("Replica %d find something wrong with fetchMissingResponse, error: %v",
 			rbft.chainConfig.SelfID, err)
 		return rbft.sendViewChange()
 	}
+	if task != nil {
+		rbft.finishFetch(task)
+	}
 
 	_ = rbft.findNextPrepareBatch(ctx, re.View, re.SequenceNumber, re.BatchDigest)
 	return nil
@@ -1676,7 +2421,18 @@ func (rbft *rbftImpl[T, Constraint]) commitPendingBlocks() {
 				rbft.metrics.batchToCommitDuration.Observe(batchToCommit)
 				rbft.logger.Noticef("======== Replica %d Call execute, epoch=%d/view=%d/seqNo=%d/txCount=%d/digest=%s",
 					rbft.chainConfig.SelfID, rbft.chainConfig.EpochInfo.Epoch, idx.v, idx.n, len(txList), idx.d)
-				rbft.external.Execute(txList, localList, idx.n, cert.prePrepare.HashBatch.Timestamp, proposerNodeID)
+				timestamp := rbft.batchExecTimestamp(cert)
+				rbft.exec.lastExecTimestamp = timestamp
+				rbft.external.Execute(txList, localList, idx.n, timestamp, proposerNodeID)
+				if rbft.isVRFSeedEnabled() {
+					rbft.recordVRFOutput(idx.n, idx.d)
+				}
+				if rbft.isKeyRotationEnabled() {
+					rbft.orderKeyRotations(idx.n, idx.d)
+				}
+				if rbft.isThresholdDecryptionEnabled() {
+					rbft.cleanDecryptionShares(idx.n)
+				}
 			}
 			delete(rbft.storeMgr.outstandingReqBatches, idx.d)
 			rbft.metrics.outstandingBatchesGauge.Set(float64(len(rbft.storeMgr.outstandingReqBatches)))
@@ -1690,6 +2446,8 @@ func (rbft *rbftImpl[T, Constraint]) commitPendingBlocks() {
 		}
 	}
 	rbft.logger.Debugf("Replica %d attempting to commitTransactions finished, times=%d", rbft.chainConfig.SelfID, foreachIdx)
+	rbft.updatePipelineMetrics()
+	rbft.maybeProposeCachedBatches()
 
 	if !rbft.in(waitCheckpointBatchExecute) {
 		rbft.startTimerIfOutstandingRequests()
@@ -1704,6 +2462,8 @@ func (rbft *rbftImpl[T, Constraint]) filterExecutableTxs(digest string, deDuplic
 	)
 	txList = rbft.storeMgr.batchStore[digest].RequestList
 	localList = rbft.storeMgr.batchStore[digest].LocalList
+	// txs are de-duplicated by hash of encrypted txs in threshold-encrypted mode.
+	decryptedList, decrypted := rbft.storeMgr.decryptedBatches[digest]
 	dupHashes := make(map[string]bool)
 	for _, dupHash := range deDuplicateRequestHashes {
 		dupHashes[dupHash] = true
@@ -1714,6 +2474,9 @@ func (rbft *rbftImpl[T, Constraint]) filterExecutableTxs(digest string, deDuplic
 			rbft.logger.Noticef("Replica %d kick out de-duplicate request %s before execute batch %s", rbft.chainConfig.SelfID, reqHash, digest)
 			continue
 		}
+		if decrypted {
+			request = decryptedList[i]
+		}
 		executableTxs = append(executableTxs, request)
 		executableLocalList = append(executableLocalList, localList[i])
 	}
@@ -1761,6 +2524,11 @@ func (rbft *rbftImpl[T, Constraint]) findNextCommitBatch() (find bool, idx msgID
 				rbft.logger.Warningf("Replica %d cannot find corresponding batch %s in batchStore", rbft.chainConfig.SelfID, idx.d)
 				continue
 			}
+
+			// encrypted batch can only be executed after decrypted.
+			if rbft.isThresholdDecryptionEnabled() && !rbft.decryptCommittedBatch(idx.n, idx.d) {
+				continue
+			}
 		}
 
 		find = true
@@ -1907,7 +2675,8 @@ func (rbft *rbftImpl[T, Constraint]) checkpoint(state *types.ServiceState, isCon
 	}
 
 	rbft.storeMgr.saveCheckpoint(seqNo, signedCheckpoint)
-	rbft.persistCheckpoint(seqNo, digest, batchDigest)
+	rbft.persistCheckpoint(seqNo, digest, batchDigest, signedCheckpoint.Checkpoint.ExecuteState.VrfOutput)
+	rbft.persistCheckpointKeys(seqNo, signedCheckpoint.Checkpoint.ValidatorKeys)
 
 	if isConfig {
 		// use fetchCheckpointTimer to fetch the missing config checkpoint
@@ -2040,6 +2809,7 @@ func (rbft *rbftImpl[T, Constraint]) recvCheckpoint(signedCheckpoint *consensus.
 
 	rbft.chainConfig.LastCheckpointExecBlockHash = checkpointDigest
 	rbft.chainConfig.LastCheckpointExecBlockHeight = checkpointHeight
+	rbft.chainConfig.LastCheckpointVRFOutput = signedCheckpoint.GetCheckpoint().GetExecuteState().GetVrfOutput()
 	blockMeta, err := rbft.external.GetBlockMeta(checkpointHeight)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get block meta %d after checkpoint", checkpointHeight)
@@ -2048,7 +2818,16 @@ func (rbft *rbftImpl[T, Constraint]) recvCheckpoint(signedCheckpoint *consensus.
 		ProcessorNodeID: blockMeta.ProcessorNodeID,
 		BlockNum:        checkpointHeight,
 	})
+	if rbft.chainConfig.isProposerElectionTypeReputation() {
+		if err = rbft.chainConfig.ReputationTracker.AddBlock(types.BlockMeta{
+			ProcessorNodeID: blockMeta.ProcessorNodeID,
+			BlockNum:        checkpointHeight,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to track reputation of block %d after checkpoint", checkpointHeight)
+		}
+	}
 	rbft.off(waitCheckpointFinished)
+	rbft.activateKeyRotations(checkpointHeight, rbft.storeMgr.localCheckpoints[checkpointHeight].GetCheckpoint().GetValidatorKeys())
 	// the checkpoint is trigger by config batch
 	if signedCheckpoint.Checkpoint.NeedUpdateEpoch {
 		return rbft.finishConfigCheckpoint(checkpointHeight, checkpointDigest, matchingCheckpoints)
@@ -2164,6 +2943,7 @@ func (rbft *rbftImpl[T, Constraint]) finishNormalCheckpoint(checkpointHeight uin
 			}
 			nv.Signature = sig
 			rbft.persistNewView(nv, true)
+			rbft.updateTxForwardPrimary()
 
 			// Slave -> Primary： need update self seqNo(because only primary will update)
 			rbft.batchMgr.setSeqNo(checkpointHeight)
@@ -2194,6 +2974,12 @@ func (rbft *rbftImpl[T, Constraint]) finishNormalCheckpoint(checkpointHeight uin
 		rbft.processWRFHighViewMsgs()
 	}
 
+	// primary which is transferring leadership may have committed its last in-flight batch
+	// while waiting for this checkpoint.
+	if rbft.vcMgr.leaderTransferring {
+		return rbft.maybeSendLeaderTransfer()
+	}
+
 	// make sure node is in normal status before try to batch, as we may reach stable
 	// checkpoint in vc.
 	if rbft.isNormal() && rbft.isPrimary(rbft.chainConfig.SelfID) {
@@ -2306,6 +3092,10 @@ func (rbft *rbftImpl[T, Constraint]) moveWatermarks(n uint64, newEpoch bool) {
 		}
 	}
 	rbft.storeMgr.cleanCommittedCertCache(h)
+	rbft.cleanDecryptionShares(h)
+	rbft.cleanVRFOutputs(h)
+	rbft.cleanCheckpointKeys(h)
+	rbft.nonceFilter.persist()
 	rbft.metrics.outstandingBatchesGauge.Set(float64(len(rbft.storeMgr.outstandingReqBatches)))
 
 	// retain most recent 10 block info in txBatchStore cache as non-primary
@@ -2496,6 +3286,14 @@ func (rbft *rbftImpl[T, Constraint]) tryStateTransfer() {
 
 	// attempts to synchronize state to a particular target, implicitly calls rollback if needed
 	rbft.metrics.stateUpdateCounter.Add(float64(1))
+	rbft.beginStateUpdate(target)
+	if rbft.isSnapshotSyncEnabled() && rbft.startSnapshotSync(target) {
+		return
+	}
+	if rbft.tracker != nil {
+		go rbft.tracker.TrackedStateUpdate(rbft.stateUpdateID, rbft.chainConfig.H, target.metaState.Height, target.metaState.Digest, target.checkpointSet, target.epochChanges...)
+		return
+	}
 	go rbft.external.StateUpdate(rbft.chainConfig.H, target.metaState.Height, target.metaState.Digest, target.checkpointSet, target.epochChanges...)
 }
 
@@ -2544,7 +3342,9 @@ func (rbft *rbftImpl[T, Constraint]) recvStateUpdatedEvent(ss *types.ServiceSync
 
 	rbft.logger.Debugf("Replica %d state updated, lastExec = %d, seqNo = %d", rbft.chainConfig.SelfID, rbft.exec.lastExec, seqNo)
 	// reset recent blocks
-	rbft.chainConfig.ResetRecentBlockNum(seqNo)
+	if err := rbft.chainConfig.ResetRecentBlockNum(seqNo); err != nil {
+		rbft.logger.Errorf("Replica %d failed to reset recent blocks: %v", rbft.chainConfig.SelfID, err)
+	}
 	if ss.EpochChanged || epochChanged {
 		rbft.logger.Debugf("Replica %d accept epoch proof for %d", rbft.chainConfig.SelfID, ss.Epoch)
 		if ec, ok := rbft.epochMgr.epochProofCache[ss.Epoch-1]; ok {
@@ -2576,9 +3376,11 @@ func (rbft *rbftImpl[T, Constraint]) recvStateUpdatedEvent(ss *types.ServiceSync
 	rbft.logger.Noticef(finishMsg)
 	rbft.external.SendFilterEvent(types.InformTypeFilterFinishStateUpdate, finishMsg)
 	rbft.exec.setLastExec(seqNo)
+	rbft.exec.lastExecTimestamp = rbft.loadBlockTimestamp(seqNo)
 	rbft.batchMgr.setSeqNo(seqNo)
 	rbft.storeMgr.missingBatchesInFetching = make(map[string]msgID)
 	rbft.off(SkipInProgress)
+	rbft.endStateUpdate()
 	rbft.atomicOff(StateTransferring)
 	rbft.metrics.statusGaugeStateTransferring.Set(0)
 	rbft.maybeSetNormal()
@@ -2618,7 +3420,10 @@ func (rbft *rbftImpl[T, Constraint]) recvStateUpdatedEvent(ss *types.ServiceSync
 		rbft.storeMgr.saveCheckpoint(seqNo, signedCheckpoint)
 		rbft.chainConfig.LastCheckpointExecBlockHash = digest
 		rbft.chainConfig.LastCheckpointExecBlockHeight = seqNo
-		rbft.persistCheckpoint(seqNo, digest, checkpoint.ExecuteState.BatchDigest)
+		rbft.chainConfig.LastCheckpointVRFOutput = checkpoint.ExecuteState.VrfOutput
+		rbft.persistCheckpoint(seqNo, digest, checkpoint.ExecuteState.BatchDigest, checkpoint.ExecuteState.VrfOutput)
+		rbft.persistCheckpointKeys(seqNo, checkpoint.ValidatorKeys)
+		rbft.activateKeyRotations(seqNo, checkpoint.ValidatorKeys)
 		rbft.moveWatermarks(seqNo, epochChanged)
 	}
 
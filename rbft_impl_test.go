@@ -387,6 +387,70 @@ func TestRBFT_processOutOfDateReqs(t *testing.T) {
 	assert.Equal(t, 25, len(set.Requests))
 }
 
+func TestRBFT_forwardReqSetToPrimary(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	rbfts[2].config.ForwardTxsToPrimary = true
+	rbfts[2].setNormal()
+	primaryID := rbfts[2].chainConfig.PrimaryID
+	nextPrimaryID := rbfts[2].chainConfig.calPrimaryIDByView(rbfts[2].chainConfig.View+1, rbfts[2].chainConfig.ValidatorDynamicInfoMap)
+	assert.NotEqual(t, rbfts[2].chainConfig.SelfID, primaryID)
+	assert.NotEqual(t, rbfts[2].chainConfig.SelfID, nextPrimaryID)
+	ext := rbfts[2].external.(*testExternal[consensus.FltTransaction, *consensus.FltTransaction])
+
+	// broadcast before a new view is applied, and getting targets doesn't change the primary.
+	rs := &RequestSet[consensus.FltTransaction, *consensus.FltTransaction]{Requests: []*consensus.FltTransaction{newTx()}}
+	rbfts[2].broadcastReqSet(rs)
+	rvc := <-ext.ListenMsg()
+	assert.Equal(t, consensus.Type_REBROADCAST_REQUEST_SET, rvc.msg.Type)
+	assert.Equal(t, "", rvc.to)
+	rbfts[2].broadcastReqSet(rs)
+	rvc = <-ext.ListenMsg()
+	assert.Equal(t, "", rvc.to)
+
+	// pending request sets are re-forwarded to the current and next primary once the new view is applied.
+	tx := newTx()
+	assert.Nil(t, rbfts[2].batchMgr.requestPool.AddLocalTx(tx))
+	// sleep to trigger txpool tolerance time.
+	time.Sleep(1 * time.Second)
+	rbfts[2].updateTxForwardPrimary()
+	assert.Equal(t, primaryID, rbfts[2].txForwardPrimaryID)
+	var tos []string
+	for i := 0; i < 2; i++ {
+		rvc = <-ext.ListenMsg()
+		assert.Equal(t, consensus.Type_REBROADCAST_REQUEST_SET, rvc.msg.Type)
+		set := &RequestSet[consensus.FltTransaction, *consensus.FltTransaction]{}
+		assert.Nil(t, set.Unmarshal(rvc.msg.Payload))
+		assert.Equal(t, tx.RbftGetTxHash(), set.Requests[0].RbftGetTxHash())
+		tos = append(tos, rvc.to)
+	}
+	primary, _ := rbfts[2].chainConfig.getNodeInfo(primaryID)
+	nextPrimary, _ := rbfts[2].chainConfig.getNodeInfo(nextPrimaryID)
+	assert.ElementsMatch(t, []string{primary.P2PNodeID, nextPrimary.P2PNodeID}, tos)
+
+	// forward to the current and next primary.
+	rbfts[2].broadcastReqSet(rs)
+	tos = nil
+	for i := 0; i < 2; i++ {
+		rvc = <-ext.ListenMsg()
+		assert.Equal(t, consensus.Type_REBROADCAST_REQUEST_SET, rvc.msg.Type)
+		tos = append(tos, rvc.to)
+	}
+	assert.ElementsMatch(t, []string{primary.P2PNodeID, nextPrimary.P2PNodeID}, tos)
+
+	// broadcast once the primary changed.
+	rbfts[2].chainConfig.View++
+	rbfts[2].chainConfig.updatePrimaryID()
+	rbfts[2].broadcastReqSet(rs)
+	rvc = <-ext.ListenMsg()
+	assert.Equal(t, "", rvc.to)
+
+	// broadcast in abnormal status.
+	rbfts[2].setAbNormal()
+	rbfts[2].broadcastReqSet(rs)
+	rvc = <-ext.ListenMsg()
+	assert.Equal(t, "", rvc.to)
+}
+
 func TestRBFT_sendNullRequest(t *testing.T) {
 	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
 
@@ -444,6 +508,50 @@ func TestRBFT_recvPrePrepare_EmptyDigest(t *testing.T) {
 	assert.Equal(t, consensus.Type_VIEW_CHANGE, nodes[1].broadcastMessageCache.Type)
 }
 
+func TestRBFT_recvPrePrepare_InvalidTimestamp(t *testing.T) {
+	newPrePrepare := func(rbft *rbftImpl[consensus.FltTransaction, *consensus.FltTransaction], timestamp int64) *consensus.PrePrepare {
+		hashBatch := &consensus.HashBatch{
+			RequestHashList: []string{newTx().RbftGetTxHash()},
+			Timestamp:       timestamp,
+		}
+		return &consensus.PrePrepare{
+			View:           rbft.chainConfig.View,
+			SequenceNumber: uint64(1),
+			BatchDigest:    calculateMD5Hash(hashBatch.RequestHashList, hashBatch.Timestamp),
+			HashBatch:      hashBatch,
+			ReplicaId:      rbft.chainConfig.SelfID,
+		}
+	}
+
+	t.Run("timestamp out of drift window", func(t *testing.T) {
+		nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+		rbfts[1].config.MaxBatchTimestampDrift = time.Second
+		preprep := newPrePrepare(rbfts[0], time.Now().Add(-time.Minute).UnixNano())
+		assert.Nil(t, rbfts[1].recvPrePrepare(context.TODO(), preprep))
+		assert.Equal(t, consensus.Type_VIEW_CHANGE, nodes[1].broadcastMessageCache.Type)
+	})
+
+	t.Run("timestamp not greater than last executed batch", func(t *testing.T) {
+		nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+		rbfts[1].config.MaxBatchTimestampDrift = time.Second
+		now := time.Now().UnixNano()
+		rbfts[1].exec.lastExecTimestamp = now + int64(100*time.Millisecond)
+		preprep := newPrePrepare(rbfts[0], now)
+		assert.Nil(t, rbfts[1].recvPrePrepare(context.TODO(), preprep))
+		assert.Equal(t, consensus.Type_VIEW_CHANGE, nodes[1].broadcastMessageCache.Type)
+	})
+
+	t.Run("valid timestamp", func(t *testing.T) {
+		nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+		rbfts[1].config.MaxBatchTimestampDrift = time.Second
+		rbfts[1].exec.lastExecTimestamp = time.Now().Add(-time.Minute).UnixNano()
+		preprep := newPrePrepare(rbfts[0], time.Now().UnixNano())
+		assert.Nil(t, rbfts[1].recvPrePrepare(context.TODO(), preprep))
+		assert.Nil(t, nodes[1].broadcastMessageCache)
+		assert.Equal(t, consensus.Type_FETCH_MISSING_REQUEST, nodes[1].unicastMessageCache.Type)
+	})
+}
+
 func TestRBFT_recvPrePrepare_WrongSeqNo(t *testing.T) {
 	tx := newTx()
 	txBytes, err := tx.RbftMarshal()
@@ -605,3 +713,266 @@ func TestRBFT_recvFetchMissingResponse(t *testing.T) {
 	ret = rbfts[1].recvFetchMissingResponse(context.TODO(), re)
 	assert.Nil(t, ret)
 }
+
+func TestRBFT_linearVoteAggregation(t *testing.T) {
+	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	for _, r := range rbfts {
+		r.chainConfig.getVoteAggregationTypeFn = func(epochInfo *kittypes.EpochInfo) string {
+			return VoteAggregationTypeLinear
+		}
+		err := r.chainConfig.updateDerivedData(r.chainConfig.ValidatorSet)
+		assert.Nil(t, err)
+		assert.True(t, r.chainConfig.isLinearVoteAggregation())
+	}
+
+	tx := newTx()
+	for _, r := range rbfts {
+		_ = r.batchMgr.requestPool.AddLocalTx(tx)
+	}
+	rbfts[0].processEvent(&LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreBatchTimerEvent,
+	})
+	prePrep := nodes[0].broadcastMessageCache
+	assert.Equal(t, consensus.Type_PRE_PREPARE, prePrep.Type)
+
+	// backups unicast signed prepares to primary instead of broadcasting them.
+	var prepares []*consensusMessageWrapper
+	for i := 1; i < 4; i++ {
+		rbfts[i].processEvent(prePrep)
+		assert.Nil(t, nodes[i].broadcastMessageCache)
+		prep := nodes[i].unicastMessageCache
+		assert.Equal(t, consensus.Type_PREPARE, prep.Type)
+		prepares = append(prepares, prep)
+	}
+
+	// primary aggregates a quorum of prepares.
+	rbfts[0].processEvent(prepares[0])
+	assert.Equal(t, prePrep, nodes[0].broadcastMessageCache)
+	rbfts[0].processEvent(prepares[1])
+	aggPrepares := nodes[0].broadcastMessageCache
+	assert.Equal(t, consensus.Type_AGGREGATED_VOTES, aggPrepares.Type)
+	rbfts[0].processEvent(prepares[2])
+	assert.Equal(t, aggPrepares, nodes[0].broadcastMessageCache)
+
+	// backups send commits to primary after receiving the aggregated prepares.
+	var commits []*consensusMessageWrapper
+	for i := 1; i < 4; i++ {
+		rbfts[i].processEvent(aggPrepares)
+		commit := nodes[i].unicastMessageCache
+		assert.Equal(t, consensus.Type_COMMIT, commit.Type)
+		commits = append(commits, commit)
+	}
+
+	executed := func(r *rbftImpl[consensus.FltTransaction, *consensus.FltTransaction]) bool {
+		for idx, cert := range r.storeMgr.certStore {
+			if idx.n == 1 {
+				return cert.sentExecute
+			}
+		}
+		return false
+	}
+
+	// primary commits the batch after aggregating a quorum of commits.
+	rbfts[0].processEvent(commits[0])
+	assert.False(t, executed(rbfts[0]))
+	rbfts[0].processEvent(commits[1])
+	assert.True(t, executed(rbfts[0]))
+	aggCommits := nodes[0].broadcastMessageCache
+	assert.Equal(t, consensus.Type_AGGREGATED_VOTES, aggCommits.Type)
+
+	// forge aggregated commits from a non-primary replica.
+	votes := &consensus.AggregatedVotes{}
+	err := votes.UnmarshalVT(aggCommits.Payload)
+	assert.Nil(t, err)
+	votes.ReplicaId = rbfts[1].chainConfig.SelfID
+	payload, err := votes.MarshalVTStrict()
+	assert.Nil(t, err)
+	fakeAggCommits := &consensusMessageWrapper{
+		ctx: context.TODO(),
+		ConsensusMessage: &consensus.ConsensusMessage{
+			Type:    consensus.Type_AGGREGATED_VOTES,
+			From:    rbfts[1].chainConfig.SelfID,
+			Epoch:   aggCommits.Epoch,
+			View:    aggCommits.View,
+			Payload: payload,
+		},
+	}
+	for i := 1; i < 4; i++ {
+		if i == 3 {
+			// aggregated votes from non-primary is ignored.
+			rbfts[i].processEvent(fakeAggCommits)
+			assert.False(t, executed(rbfts[i]))
+		}
+		rbfts[i].processEvent(aggCommits)
+		assert.True(t, executed(rbfts[i]))
+	}
+}
+
+func TestRBFT_linearVoteAggregationBFTTime(t *testing.T) {
+	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	for _, r := range rbfts {
+		r.config.EnableBFTTime = true
+		r.chainConfig.getVoteAggregationTypeFn = func(epochInfo *kittypes.EpochInfo) string {
+			return VoteAggregationTypeLinear
+		}
+		err := r.chainConfig.updateDerivedData(r.chainConfig.ValidatorSet)
+		assert.Nil(t, err)
+		assert.True(t, r.isBFTTimeEnabled())
+	}
+
+	// commitBatch orders a batch with the given tx, and returns its pre-prepare and commit quorum certificate.
+	commitBatch := func(tx *consensus.FltTransaction) (*consensus.PrePrepare, []*consensus.Commit) {
+		for _, r := range rbfts {
+			_ = r.batchMgr.requestPool.AddLocalTx(tx)
+		}
+		rbfts[0].batchMgr.lastBatchTime = 0
+		rbfts[0].processEvent(&LocalEvent{
+			Service:   CoreRbftService,
+			EventType: CoreBatchTimerEvent,
+		})
+		prePrepMsg := nodes[0].broadcastMessageCache
+		assert.Equal(t, consensus.Type_PRE_PREPARE, prePrepMsg.Type)
+		for i := 1; i < 4; i++ {
+			rbfts[i].processEvent(prePrepMsg)
+			rbfts[0].processEvent(nodes[i].unicastMessageCache)
+		}
+		aggPrepares := nodes[0].broadcastMessageCache
+		assert.Equal(t, consensus.Type_AGGREGATED_VOTES, aggPrepares.Type)
+
+		var commits []*consensusMessageWrapper
+		for i := 1; i < 4; i++ {
+			rbfts[i].processEvent(aggPrepares)
+			commits = append(commits, nodes[i].unicastMessageCache)
+		}
+		rbfts[0].processEvent(commits[0])
+		rbfts[0].processEvent(commits[1])
+		aggCommits := nodes[0].broadcastMessageCache
+		assert.Equal(t, consensus.Type_AGGREGATED_VOTES, aggCommits.Type)
+		for i := 1; i < 4; i++ {
+			rbfts[i].processEvent(aggCommits)
+		}
+
+		prePrep := &consensus.PrePrepare{}
+		assert.Nil(t, prePrep.UnmarshalVT(prePrepMsg.Payload))
+		votes := &consensus.AggregatedVotes{}
+		assert.Nil(t, votes.UnmarshalVT(aggCommits.Payload))
+		return prePrep, votes.Commits
+	}
+
+	// the first batch carries no BFT time as no batch is committed before, so it's executed with the
+	// timestamp of primary.
+	tx1 := newTx()
+	prePrep1, commits1 := commitBatch(tx1)
+	assert.Equal(t, int64(0), prePrep1.HashBatch.BftTime)
+	assert.Empty(t, prePrep1.BftTimeCommits)
+	timestamp := prePrep1.HashBatch.Timestamp
+	for i := 0; i < 4; i++ {
+		assert.Equal(t, calculateMD5Hash([]string{tx1.RbftGetTxHash()}, timestamp), nodes[i].blocks[1])
+		assert.Equal(t, timestamp, rbfts[i].exec.lastExecTimestamp)
+	}
+
+	// the next batch carries the BFT time computed from the commits of the first batch, which is covered
+	// by the batch digest, and all replicas execute it with the same BFT time.
+	tx2 := newTx()
+	prePrep2, _ := commitBatch(tx2)
+	bftTime := medianCommitTimestamp(commits1)
+	assert.Equal(t, bftTime, prePrep2.HashBatch.BftTime)
+	assert.ElementsMatch(t, commits1, prePrep2.BftTimeCommits)
+	assert.Equal(t, calculateBatchDigest(prePrep2.HashBatch), prePrep2.BatchDigest)
+	assert.NotEqual(t, calculateMD5Hash(prePrep2.HashBatch.RequestHashList, prePrep2.HashBatch.Timestamp), prePrep2.BatchDigest)
+	for i := 0; i < 4; i++ {
+		assert.Equal(t, calculateMD5Hash([]string{tx2.RbftGetTxHash()}, bftTime), nodes[i].blocks[2])
+		assert.Equal(t, bftTime, rbfts[i].exec.lastExecTimestamp)
+	}
+
+	// BFT time which is not the median of a valid commit quorum certificate is rejected.
+	forged := prePrep2.CloneVT()
+	forged.SequenceNumber = 3
+	forged.BatchDigest = "forged"
+	assert.Nil(t, rbfts[1].checkBatchBFTTime(forged))
+	forged.HashBatch.BftTime++
+	assert.NotNil(t, rbfts[1].checkBatchBFTTime(forged))
+	forged.HashBatch.BftTime--
+	forged.BftTimeCommits = forged.BftTimeCommits[1:]
+	assert.NotNil(t, rbfts[1].checkBatchBFTTime(forged))
+	forged.BftTimeCommits = nil
+	assert.NotNil(t, rbfts[1].checkBatchBFTTime(forged))
+
+	// timestamp of previous batch is loaded from ledger after restart or state update, skipping empty blocks.
+	nodes[1].blockTimestamps[3] = 0
+	assert.Equal(t, bftTime, rbfts[1].loadBlockTimestamp(3))
+
+	// ledger is not read if BFT time is disabled.
+	rbfts[1].config.EnableBFTTime = false
+	assert.Equal(t, int64(0), rbfts[1].loadBlockTimestamp(3))
+}
+
+func TestRBFT_fastPath(t *testing.T) {
+	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	for _, r := range rbfts {
+		r.chainConfig.isFastPathEnabledFn = func(epochInfo *kittypes.EpochInfo) bool {
+			return true
+		}
+		err := r.chainConfig.updateDerivedData(r.chainConfig.ValidatorSet)
+		assert.Nil(t, err)
+		assert.True(t, r.chainConfig.isFastPathEnabled())
+	}
+
+	tx := newTx()
+	for _, r := range rbfts {
+		_ = r.batchMgr.requestPool.AddLocalTx(tx)
+	}
+	rbfts[0].processEvent(&LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreBatchTimerEvent,
+	})
+	prePrep := nodes[0].broadcastMessageCache
+	assert.Equal(t, consensus.Type_PRE_PREPARE, prePrep.Type)
+
+	var prepares []*consensusMessageWrapper
+	for i := 1; i < 4; i++ {
+		rbfts[i].processEvent(prePrep)
+		prep := nodes[i].broadcastMessageCache
+		assert.Equal(t, consensus.Type_PREPARE, prep.Type)
+		prepares = append(prepares, prep)
+	}
+
+	var idx msgID
+	for id := range rbfts[1].storeMgr.certStore {
+		idx = id
+	}
+
+	// replica 2 commits the batch without commit phase after receiving prepares from all replicas.
+	rbfts[1].processEvent(prepares[1])
+	rbfts[1].processEvent(prepares[2])
+	assert.True(t, rbfts[1].storeMgr.certStore[idx].fastCommitted)
+	assert.True(t, rbfts[1].storeMgr.certStore[idx].sentExecute)
+	assert.Equal(t, prepares[0], nodes[1].broadcastMessageCache)
+
+	// replica 3 only receives a quorum of prepares, waits for the remaining prepares.
+	rbfts[2].processEvent(prepares[0])
+	cert := rbfts[2].storeMgr.certStore[idx]
+	assert.NotEqual(t, "", cert.fastPathTimerKey)
+	assert.False(t, cert.sentCommit)
+	assert.Equal(t, prepares[1], nodes[2].broadcastMessageCache)
+
+	// fall back to commit phase after fast path timer expired.
+	rbfts[2].processEvent(&LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreFastPathTimerEvent,
+		Event:     idx,
+	})
+	assert.True(t, cert.fastPathTimeout)
+	assert.True(t, cert.sentCommit)
+	assert.False(t, cert.fastCommitted)
+	commit := nodes[2].broadcastMessageCache
+	assert.Equal(t, consensus.Type_COMMIT, commit.Type)
+
+	// replica which has fast committed sends commit to help others collecting commits.
+	rbfts[1].processEvent(commit)
+	assert.Equal(t, consensus.Type_COMMIT, nodes[1].broadcastMessageCache.Type)
+}
@@ -52,6 +52,14 @@ func (rbft *rbftImpl[T, Constraint]) dispatchRecoveryMsg(e consensusEvent) conse
 		return rbft.recvFetchPQCRequest(et)
 	case *consensus.FetchPQCResponse:
 		return rbft.recvFetchPQCResponse(et)
+	case *consensus.SnapshotManifestRequest:
+		return rbft.recvSnapshotManifestRequest(et)
+	case *consensus.SnapshotManifestResponse:
+		return rbft.recvSnapshotManifestResponse(et)
+	case *consensus.SnapshotChunkRequest:
+		return rbft.recvSnapshotChunkRequest(et)
+	case *consensus.SnapshotChunkResponse:
+		return rbft.recvSnapshotChunkResponse(et)
 	}
 	return nil
 }
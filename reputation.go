@@ -0,0 +1,181 @@
+package rbft
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-bft/types"
+)
+
+const (
+	// reputationBaseScore is the score of a validator without any recent proposal or timeout, the
+	// voting power of a validator is scaled by its score divided by the base score.
+	reputationBaseScore uint64 = 1000
+
+	// reputationMinScore keeps validators with a poor reputation electable with a low probability.
+	reputationMinScore uint64 = 1
+
+	// reputationWindowBlockNum is the number of recent blocks used to build the proposal score.
+	reputationWindowBlockNum uint64 = 100
+
+	// reputationProposalReward is the score rewarded to the proposer of a committed block.
+	reputationProposalReward uint64 = 50
+
+	// reputationProposalDecayPercentage is the percentage of proposal score kept after each block.
+	reputationProposalDecayPercentage uint64 = 95
+
+	// reputationTimeoutPenalty is the penalty of a primary which failed to make progress in its view.
+	reputationTimeoutPenalty uint64 = 500
+
+	// reputationPenaltyDecayPercentage is the percentage of timeout penalty kept after each view change.
+	reputationPenaltyDecayPercentage uint64 = 80
+)
+
+// ReputationTracker tracks the proposers of recent blocks to build the proposal score of validators.
+// Unlike BlockProcessorTracker, the tracked blocks span epochs so that reputation is kept across
+// epochs, and the score is always rebuilt from the tracked blocks so that it can be recovered from
+// chain data after restart or state update.
+type ReputationTracker struct {
+	getBlockFunc    func(uint64) (*types.BlockMeta, error)
+	BlockProcessors []*types.BlockMeta
+	WindowBlockNum  uint64
+	ProposalScores  map[uint64]uint64
+}
+
+func NewReputationTracker(getBlockFunc func(uint64) (*types.BlockMeta, error)) *ReputationTracker {
+	return &ReputationTracker{
+		getBlockFunc:    getBlockFunc,
+		BlockProcessors: []*types.BlockMeta{},
+		WindowBlockNum:  reputationWindowBlockNum,
+		ProposalScores:  map[uint64]uint64{},
+	}
+}
+
+// Reset rebuilds the tracked blocks which end at the given block, the tracked blocks are kept
+// unchanged if failed to get blocks from chain data.
+func (t *ReputationTracker) Reset(lastCheckpointExecBlockHeight uint64) error {
+	oldBlockProcessors := make(map[uint64]*types.BlockMeta, len(t.BlockProcessors))
+	for _, oldBlockProcessor := range t.BlockProcessors {
+		oldBlockProcessors[oldBlockProcessor.BlockNum] = oldBlockProcessor
+	}
+	blockProcessors := make([]*types.BlockMeta, 0, t.WindowBlockNum)
+
+	var startBlockNum uint64 = 1
+	if lastCheckpointExecBlockHeight > t.WindowBlockNum {
+		startBlockNum = lastCheckpointExecBlockHeight - t.WindowBlockNum + 1
+	}
+	for i := startBlockNum; i <= lastCheckpointExecBlockHeight; i++ {
+		if oldBlockProcessor, ok := oldBlockProcessors[i]; ok {
+			blockProcessors = append(blockProcessors, oldBlockProcessor)
+			continue
+		}
+		m, err := t.getBlockFunc(i)
+		if err != nil {
+			return fmt.Errorf("failed to get block %d when reset reputation: %w", i, err)
+		}
+		blockProcessors = append(blockProcessors, &types.BlockMeta{
+			ProcessorNodeID: m.ProcessorNodeID,
+			BlockNum:        i,
+		})
+	}
+	t.BlockProcessors = blockProcessors
+	t.updateProposalScores()
+	return nil
+}
+
+// AddBlock tracks the given checkpoint block, blocks between the last tracked block and the given
+// one are fetched so that the tracked blocks are always continuous.
+func (t *ReputationTracker) AddBlock(lastCheckpointExecBlockMeta types.BlockMeta) error {
+	if len(t.BlockProcessors) != 0 {
+		lastBlockNum := t.BlockProcessors[len(t.BlockProcessors)-1].BlockNum
+		if lastCheckpointExecBlockMeta.BlockNum <= lastBlockNum {
+			return nil
+		}
+		for i := lastBlockNum + 1; i < lastCheckpointExecBlockMeta.BlockNum; i++ {
+			m, err := t.getBlockFunc(i)
+			if err != nil {
+				return fmt.Errorf("failed to get block %d: %w", i, err)
+			}
+			t.BlockProcessors = append(t.BlockProcessors, &types.BlockMeta{
+				ProcessorNodeID: m.ProcessorNodeID,
+				BlockNum:        i,
+			})
+		}
+	}
+	t.BlockProcessors = append(t.BlockProcessors, &lastCheckpointExecBlockMeta)
+	if uint64(len(t.BlockProcessors)) > t.WindowBlockNum {
+		t.BlockProcessors = t.BlockProcessors[uint64(len(t.BlockProcessors))-t.WindowBlockNum:]
+	}
+	t.updateProposalScores()
+	return nil
+}
+
+// updateProposalScores rewards the proposer of each tracked block, and decays the scores of all
+// validators after each block, so that recent proposals weigh more.
+func (t *ReputationTracker) updateProposalScores() {
+	scores := make(map[uint64]uint64)
+	for _, blockProcessor := range t.BlockProcessors {
+		for id, score := range scores {
+			scores[id] = score * reputationProposalDecayPercentage / 100
+		}
+		scores[blockProcessor.ProcessorNodeID] += reputationProposalReward
+	}
+	t.ProposalScores = scores
+}
+
+// reputationScore returns the reputation score of the given validator, which is built from the base
+// score, the proposal score and the timeout penalty.
+func (c *ChainConfig) reputationScore(info *ValidatorInfo) uint64 {
+	score := reputationBaseScore + c.ReputationTracker.ProposalScores[info.ID]
+	if score < info.ReputationPenalty+reputationMinScore {
+		return reputationMinScore
+	}
+	return score - info.ReputationPenalty
+}
+
+func (c *ChainConfig) reputationCalPrimaryIDByView(v uint64, validatorDynamicInfoMap map[uint64]*ValidatorInfo) uint64 {
+	nodeID2VotingPower := make(map[uint64]int64)
+	for nodeID, info := range validatorDynamicInfoMap {
+		// exclude nodes that have recently produced blocks
+		if _, ok := c.RecentBlockProcessorTracker.GetRecentProcessorSet()[nodeID]; ok {
+			continue
+		}
+		weight := new(big.Int).Mul(big.NewInt(info.ConsensusVotingPower), new(big.Int).SetUint64(c.reputationScore(info)))
+		weight.Div(weight, new(big.Int).SetUint64(reputationBaseScore))
+		if weight.Sign() == 0 && info.ConsensusVotingPower > 0 {
+			weight.SetInt64(1)
+		}
+		nodeID2VotingPower[nodeID] = weight.Int64()
+	}
+	return wrfSelectNodeByVotingPower(c.wrfSeed(v), nodeID2VotingPower)
+}
+
+// checkpointReputationPenalties returns the timeout penalties of validators in the last stable view,
+// which are carried into the next epoch by the checkpoint of epoch change.
+func (c *ChainConfig) checkpointReputationPenalties() []*consensus.NodeDynamicInfo {
+	var penalties []*consensus.NodeDynamicInfo
+	for id, info := range c.LastStableValidatorDynamicInfoMap {
+		if info.ReputationPenalty != 0 {
+			penalties = append(penalties, &consensus.NodeDynamicInfo{Id: id, ReputationPenalty: info.ReputationPenalty})
+		}
+	}
+	sort.Slice(penalties, func(i, j int) bool {
+		return penalties[i].Id < penalties[j].Id
+	})
+	return penalties
+}
+
+// setReputationPenalties sets the timeout penalties of validators, validators not in the current
+// validator set are ignored.
+func (c *ChainConfig) setReputationPenalties(penalties []*consensus.NodeDynamicInfo) {
+	for _, item := range penalties {
+		if info, ok := c.ValidatorDynamicInfoMap[item.Id]; ok {
+			info.ReputationPenalty = item.ReputationPenalty
+		}
+		if info, ok := c.LastStableValidatorDynamicInfoMap[item.Id]; ok {
+			info.ReputationPenalty = item.ReputationPenalty
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package rbft
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+// sigCacheSize is the max number of verified signatures kept in sigCache.
+const sigCacheSize = 10000
+
+type sigCacheKey [sha256.Size]byte
+
+// sigCache caches verified signatures, it's accessed by the event loop and the verify workers
+// concurrently. The oldest signature is evicted once the cache is full.
+type sigCache struct {
+	lock     sync.Mutex
+	verified map[sigCacheKey]struct{}
+	keys     []sigCacheKey
+	next     int
+}
+
+func newSigCache(size int) *sigCache {
+	return &sigCache{
+		verified: make(map[sigCacheKey]struct{}, size),
+		keys:     make([]sigCacheKey, 0, size),
+	}
+}
+
+func newSigCacheKey(signer uint64, sig []byte, msg []byte) sigCacheKey {
+	h := sha256.New()
+	_ = binary.Write(h, binary.BigEndian, signer)
+	_ = binary.Write(h, binary.BigEndian, uint64(len(msg)))
+	_, _ = h.Write(msg)
+	_, _ = h.Write(sig)
+	var key sigCacheKey
+	copy(key[:], h.Sum(nil))
+	return key
+}
+
+func (c *sigCache) contains(key sigCacheKey) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, ok := c.verified[key]
+	return ok
+}
+
+func (c *sigCache) add(key sigCacheKey) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.verified[key]; ok {
+		return
+	}
+	if len(c.keys) < cap(c.keys) {
+		c.keys = append(c.keys, key)
+	} else {
+		delete(c.verified, c.keys[c.next])
+		c.keys[c.next] = key
+		c.next = (c.next + 1) % len(c.keys)
+	}
+	c.verified[key] = struct{}{}
+}
+
+// reset drops all verified signatures.
+func (c *sigCache) reset() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.verified = make(map[sigCacheKey]struct{}, cap(c.keys))
+	c.keys = c.keys[:0]
+	c.next = 0
+}
+
+// verifySignature verifies the signature of given signer with its current consensus key, signatures
+// verified before are accepted from sigCache directly.
+func (rbft *rbftImpl[T, Constraint]) verifySignature(signer uint64, sig []byte, msg []byte) error {
+	key := newSigCacheKey(signer, sig, msg)
+	if rbft.sigCache.contains(key) {
+		return nil
+	}
+	if err := rbft.verifyValidatorSignature(signer, sig, msg); err != nil {
+		return err
+	}
+	rbft.sigCache.add(key)
+	return nil
+}
+
+// preVerifyMsgs are consensus messages which carry signatures verified in the event loop.
+var preVerifyMsgs = map[consensus.Type]struct{}{
+	consensus.Type_SIGNED_CHECKPOINT:   {},
+	consensus.Type_VIEW_CHANGE:         {},
+	consensus.Type_QUORUM_VIEW_CHANGE:  {},
+	consensus.Type_NEW_VIEW:            {},
+	consensus.Type_RECOVERY_RESPONSE:   {},
+	consensus.Type_SYNC_STATE_RESPONSE: {},
+}
+
+// sigVerifyJob is a consensus message waiting for pre-verification.
+type sigVerifyJob struct {
+	ctx context.Context
+	msg *consensus.ConsensusMessage
+}
+
+// startSigVerifyWorkers starts workers which pre-verify signatures of consensus messages before
+// posting them to the event loop, so that signatures are verified in parallel and the event loop
+// only hits sigCache.
+func (rbft *rbftImpl[T, Constraint]) startSigVerifyWorkers() {
+	for i := 0; i < rbft.config.SigVerifyWorkers; i++ {
+		go func() {
+			for {
+				select {
+				case <-rbft.close:
+					return
+				case job := <-rbft.sigVerifyJobs:
+					rbft.preVerifyMsg(job.msg)
+					rbft.postMsg(&consensusMessageWrapper{
+						ctx:              job.ctx,
+						ConsensusMessage: job.msg,
+					})
+				}
+			}
+		}()
+	}
+}
+
+// preVerifyMsg verifies all signatures carried by the given message to fill sigCache. Invalid
+// messages are still posted to the event loop which rejects them as usual.
+func (rbft *rbftImpl[T, Constraint]) preVerifyMsg(msg *consensus.ConsensusMessage) {
+	verifyViewChanges := func(vcs []*consensus.ViewChange) {
+		for _, vc := range vcs {
+			_ = rbft.verifySignedViewChange(vc, vc.GetBasis().GetReplicaId())
+		}
+	}
+	verifyNewView := func(nv *consensus.NewView) {
+		if nv == nil {
+			return
+		}
+		_, _ = rbft.verifySignedNewView(nv)
+		verifyViewChanges(nv.GetViewChangeSet().GetViewChanges())
+	}
+	verifyCheckpoint := func(sc *consensus.SignedCheckpoint) {
+		if sc.GetCheckpoint() == nil {
+			return
+		}
+		_ = rbft.verifySignedCheckpoint(sc)
+	}
+
+	switch msg.Type {
+	case consensus.Type_SIGNED_CHECKPOINT:
+		sc := &consensus.SignedCheckpoint{}
+		if sc.UnmarshalVT(msg.Payload) == nil {
+			verifyCheckpoint(sc)
+		}
+	case consensus.Type_VIEW_CHANGE:
+		vc := &consensus.ViewChange{}
+		if vc.UnmarshalVT(msg.Payload) == nil {
+			verifyViewChanges([]*consensus.ViewChange{vc})
+		}
+	case consensus.Type_QUORUM_VIEW_CHANGE:
+		qvc := &consensus.QuorumViewChange{}
+		if qvc.UnmarshalVT(msg.Payload) == nil {
+			verifyViewChanges(qvc.GetViewChanges())
+		}
+	case consensus.Type_NEW_VIEW:
+		nv := &consensus.NewView{}
+		if nv.UnmarshalVT(msg.Payload) == nil {
+			verifyNewView(nv)
+		}
+	case consensus.Type_RECOVERY_RESPONSE:
+		rsp := &consensus.RecoveryResponse{}
+		if rsp.UnmarshalVT(msg.Payload) == nil {
+			verifyNewView(rsp.GetNewView())
+			verifyCheckpoint(rsp.GetInitialCheckpoint())
+		}
+	case consensus.Type_SYNC_STATE_RESPONSE:
+		rsp := &consensus.SyncStateResponse{}
+		if rsp.UnmarshalVT(msg.Payload) == nil {
+			verifyCheckpoint(rsp.GetSignedCheckpoint())
+		}
+	}
+}
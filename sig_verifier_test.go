@@ -0,0 +1,63 @@
+package rbft
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+func TestSigCache_add(t *testing.T) {
+	c := newSigCache(2)
+	k1 := newSigCacheKey(1, []byte("sig"), []byte("msg"))
+	k2 := newSigCacheKey(2, []byte("sig"), []byte("msg"))
+	k3 := newSigCacheKey(1, []byte("sig2"), []byte("msg"))
+	assert.NotEqual(t, k1, k2)
+	assert.NotEqual(t, k1, k3)
+
+	c.add(k1)
+	c.add(k2)
+	c.add(k2)
+	assert.True(t, c.contains(k1))
+	assert.True(t, c.contains(k2))
+
+	// the oldest signature is evicted once cache is full.
+	c.add(k3)
+	assert.False(t, c.contains(k1))
+	assert.True(t, c.contains(k2))
+	assert.True(t, c.contains(k3))
+	assert.Equal(t, 2, len(c.verified))
+}
+
+func TestSigVerifier_preVerifyViewChange(t *testing.T) {
+	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+
+	rbfts[1].sendViewChange()
+	vcMsg := nodes[1].broadcastMessageCache
+	assert.Equal(t, consensus.Type_VIEW_CHANGE, vcMsg.Type)
+	vc := &consensus.ViewChange{}
+	assert.Nil(t, vc.UnmarshalVT(vcMsg.Payload))
+	hash, err := rbfts[0].calculateViewChangeHash(vc)
+	assert.Nil(t, err)
+	key := newSigCacheKey(vc.Basis.ReplicaId, vc.Signature, hash)
+
+	// view change is pre-verified by workers before posted to event loop.
+	rbfts[0].config.SigVerifyWorkers = 2
+	rbfts[0].startSigVerifyWorkers()
+	assert.False(t, rbfts[0].sigCache.contains(key))
+	rbfts[0].step(context.Background(), vcMsg.ConsensusMessage)
+	ev := <-rbfts[0].recvChan
+	assert.Equal(t, vcMsg.ConsensusMessage, ev.(*consensusMessageWrapper).ConsensusMessage)
+	assert.True(t, rbfts[0].sigCache.contains(key))
+
+	// messages without signatures are posted directly.
+	prepare := &consensus.ConsensusMessage{Type: consensus.Type_PREPARE, Epoch: vcMsg.Epoch}
+	rbfts[0].step(context.Background(), prepare)
+	ev = <-rbfts[0].recvChan
+	assert.Equal(t, prepare, ev.(*consensusMessageWrapper).ConsensusMessage)
+
+	close(rbfts[0].close)
+}
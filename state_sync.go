@@ -0,0 +1,501 @@
+package rbft
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-bft/types"
+)
+
+const (
+	// snapshotSyncKey is the storage key of the progress of snapshot sync, whose value is
+	// "height,digest,index of next chunk to apply".
+	snapshotSyncKey = "snapshot.sync"
+
+	// snapshotChunkWindow is the max number of chunks requested but not applied yet.
+	snapshotChunkWindow = 8
+
+	// snapshotServeWorkers is the number of workers serving snapshot requests with SnapshotProvider.
+	snapshotServeWorkers = 4
+
+	// snapshotServeQueueSize is the max number of snapshot requests waiting for workers, requests are
+	// dropped once it's full.
+	snapshotServeQueueSize = 64
+)
+
+// SnapshotProvider serves the state snapshot at stable checkpoints to nodes in snapshot sync.
+type SnapshotProvider interface {
+	// SnapshotManifest returns the manifest of the snapshot at the checkpoint with the given height
+	// and digest, or an error if the snapshot is not available.
+	SnapshotManifest(height uint64, digest string) (*consensus.SnapshotManifest, error)
+
+	// SnapshotChunk returns the chunk with the given index of the snapshot at the given checkpoint.
+	SnapshotChunk(height uint64, digest string, index uint64) ([]byte, error)
+}
+
+// SnapshotConsumer restores state from the snapshot downloaded in snapshot sync.
+type SnapshotConsumer interface {
+	// VerifyManifest checks if the manifest belongs to the checkpoint it claims, such as comparing the
+	// state root in metadata with the one in the checkpoint block. Chunks are then verified against
+	// the chunk hashes of the verified manifest.
+	VerifyManifest(manifest *consensus.SnapshotManifest) error
+
+	// ApplyChunk applies the chunk with the given index, chunks are applied in order. The chunk must be
+	// persisted before returning as snapshot sync resumes from the next chunk after restart. Chunks of
+	// a different manifest may be applied from index 0 if the sync target has changed.
+	ApplyChunk(manifest *consensus.SnapshotManifest, index uint64, chunk []byte) error
+
+	// CompleteSnapshot is invoked after all chunks applied, with the checkpoints and epoch changes proving
+	// the snapshot. Like StateUpdate, applications should call ServiceInbound.ReportStateUpdated with the
+	// restored state later.
+	CompleteSnapshot(manifest *consensus.SnapshotManifest, checkpoints []*consensus.SignedCheckpoint, epochChanges ...*consensus.EpochChange) error
+}
+
+// snapshotChunkRequest is an in-flight request of a chunk.
+type snapshotChunkRequest struct {
+	peer   uint64
+	sentAt time.Time
+}
+
+// snapshotSync is an ongoing snapshot sync to a stable checkpoint, which downloads the manifest and
+// chunks of the snapshot from signers of the checkpoint. It's only accessed in the event loop.
+type snapshotSync struct {
+	target *stateUpdateTarget
+
+	// peers to download from and the ones returned invalid data.
+	peers    []uint64
+	excluded map[uint64]struct{}
+	cursor   int
+
+	manifest    *consensus.SnapshotManifest
+	nextApply   uint64
+	nextRequest uint64
+	inFlight    map[uint64]*snapshotChunkRequest
+	downloaded  map[uint64][]byte
+}
+
+// snapshotServeMsg is a response of snapshot prepared out of the event loop.
+type snapshotServeMsg struct {
+	to  uint64
+	msg *consensus.ConsensusMessage
+}
+
+func (rbft *rbftImpl[T, Constraint]) isSnapshotSyncEnabled() bool {
+	return rbft.config.SnapshotConsumer != nil
+}
+
+// startSnapshotSync starts snapshot sync to the given target, which resumes from the persisted progress
+// if it's the same target. It returns false if there is no peer to download from.
+func (rbft *rbftImpl[T, Constraint]) startSnapshotSync(target *stateUpdateTarget) bool {
+	var peers []uint64
+	for _, signedCheckpoint := range target.checkpointSet {
+		if signedCheckpoint.Author != rbft.chainConfig.SelfID {
+			peers = append(peers, signedCheckpoint.Author)
+		}
+	}
+	if len(peers) == 0 {
+		peers = rbft.fetchCandidates()
+	}
+	if len(peers) == 0 {
+		rbft.logger.Warningf("Replica %d could not find peers to sync snapshot of height %d from",
+			rbft.chainConfig.SelfID, target.metaState.Height)
+		return false
+	}
+
+	s := &snapshotSync{
+		target:     target,
+		peers:      peers,
+		excluded:   make(map[uint64]struct{}),
+		inFlight:   make(map[uint64]*snapshotChunkRequest),
+		downloaded: make(map[uint64][]byte),
+	}
+	if height, digest, next, err := rbft.restoreSnapshotSyncProgress(); err == nil &&
+		height == target.metaState.Height && digest == target.metaState.Digest {
+		rbft.logger.Noticef("Replica %d resume snapshot sync to height %d from chunk %d",
+			rbft.chainConfig.SelfID, height, next)
+		s.nextApply = next
+	}
+	rbft.snapshotSync = s
+
+	rbft.logger.Noticef("Replica %d start snapshot sync to height %d, digest %s, peers %v",
+		rbft.chainConfig.SelfID, target.metaState.Height, target.metaState.Digest, peers)
+	rbft.requestSnapshotManifest()
+	rbft.startSnapshotSyncTimer()
+	return true
+}
+
+// nextSnapshotPeer returns the next peer to download from in turn, or false if there is no peer.
+func (s *snapshotSync) nextSnapshotPeer() (uint64, bool) {
+	if len(s.peers) == 0 {
+		return 0, false
+	}
+	if len(s.excluded) >= len(s.peers) {
+		// all peers returned invalid data, try them again as some may have been in a different state.
+		s.excluded = make(map[uint64]struct{})
+	}
+	for {
+		peer := s.peers[s.cursor%len(s.peers)]
+		s.cursor++
+		if _, ok := s.excluded[peer]; !ok {
+			return peer, true
+		}
+	}
+}
+
+func (rbft *rbftImpl[T, Constraint]) requestSnapshotManifest() {
+	s := rbft.snapshotSync
+	req := &consensus.SnapshotManifestRequest{
+		ReplicaId: rbft.chainConfig.SelfID,
+		Height:    s.target.metaState.Height,
+		Digest:    s.target.metaState.Digest,
+	}
+	payload, err := req.MarshalVTStrict()
+	if err != nil {
+		rbft.logger.Errorf("ConsensusMessage_SnapshotManifestRequest Marshal Error: %s", err)
+		return
+	}
+	consensusMsg := &consensus.ConsensusMessage{
+		Type:    consensus.Type_SNAPSHOT_MANIFEST_REQUEST,
+		Payload: payload,
+	}
+	peer, ok := s.nextSnapshotPeer()
+	if !ok {
+		return
+	}
+	rbft.logger.Debugf("Replica %d request snapshot manifest of height %d from replica %d",
+		rbft.chainConfig.SelfID, req.Height, peer)
+	rbft.peerMgr.unicast(context.TODO(), consensusMsg, peer)
+}
+
+// requestSnapshotChunks requests chunks in order until the window is full.
+func (rbft *rbftImpl[T, Constraint]) requestSnapshotChunks() {
+	s := rbft.snapshotSync
+	total := uint64(len(s.manifest.ChunkHashes))
+	for s.nextRequest < total && s.nextRequest < s.nextApply+snapshotChunkWindow {
+		rbft.requestSnapshotChunk(s.nextRequest)
+		s.nextRequest++
+	}
+}
+
+func (rbft *rbftImpl[T, Constraint]) requestSnapshotChunk(index uint64) {
+	s := rbft.snapshotSync
+	req := &consensus.SnapshotChunkRequest{
+		ReplicaId: rbft.chainConfig.SelfID,
+		Height:    s.manifest.Height,
+		Digest:    s.manifest.Digest,
+		Index:     index,
+	}
+	payload, err := req.MarshalVTStrict()
+	if err != nil {
+		rbft.logger.Errorf("ConsensusMessage_SnapshotChunkRequest Marshal Error: %s", err)
+		return
+	}
+	consensusMsg := &consensus.ConsensusMessage{
+		Type:    consensus.Type_SNAPSHOT_CHUNK_REQUEST,
+		Payload: payload,
+	}
+	peer, ok := s.nextSnapshotPeer()
+	if !ok {
+		return
+	}
+	s.inFlight[index] = &snapshotChunkRequest{peer: peer, sentAt: time.Now()}
+	rbft.peerMgr.unicast(context.TODO(), consensusMsg, peer)
+}
+
+// recvSnapshotManifestResponse verifies the manifest and starts to download chunks.
+func (rbft *rbftImpl[T, Constraint]) recvSnapshotManifestResponse(resp *consensus.SnapshotManifestResponse) consensusEvent {
+	s := rbft.snapshotSync
+	if s == nil || s.manifest != nil {
+		return nil
+	}
+	manifest := resp.Manifest
+	if manifest == nil || manifest.Height != s.target.metaState.Height || manifest.Digest != s.target.metaState.Digest {
+		rbft.logger.Debugf("Replica %d ignore snapshot manifest from replica %d of other checkpoint",
+			rbft.chainConfig.SelfID, resp.ReplicaId)
+		return nil
+	}
+	if err := rbft.config.SnapshotConsumer.VerifyManifest(manifest); err != nil {
+		rbft.rejectSnapshotData(resp.ReplicaId, "snapshot_manifest", err)
+		rbft.requestSnapshotManifest()
+		return nil
+	}
+
+	total := uint64(len(manifest.ChunkHashes))
+	if s.nextApply > total {
+		rbft.logger.Warningf("Replica %d found invalid snapshot sync progress %d of %d chunks, restart from 0",
+			rbft.chainConfig.SelfID, s.nextApply, total)
+		s.nextApply = 0
+	}
+	s.manifest = manifest
+	s.nextRequest = s.nextApply
+	rbft.logger.Infof("Replica %d received snapshot manifest of height %d with %d chunks from replica %d",
+		rbft.chainConfig.SelfID, manifest.Height, total, resp.ReplicaId)
+	rbft.metrics.snapshotSyncChunksGauge.With("type", "total").Set(float64(total))
+	rbft.metrics.snapshotSyncChunksGauge.With("type", "applied").Set(float64(s.nextApply))
+
+	if s.nextApply == total {
+		rbft.completeSnapshotSync()
+		return nil
+	}
+	rbft.requestSnapshotChunks()
+	return nil
+}
+
+// recvSnapshotChunkResponse verifies the chunk against the manifest and applies chunks in order.
+func (rbft *rbftImpl[T, Constraint]) recvSnapshotChunkResponse(resp *consensus.SnapshotChunkResponse) consensusEvent {
+	s := rbft.snapshotSync
+	if s == nil || s.manifest == nil || resp.Height != s.manifest.Height || resp.Digest != s.manifest.Digest {
+		return nil
+	}
+	if _, ok := s.inFlight[resp.Index]; !ok {
+		return nil
+	}
+	hash := sha256.Sum256(resp.Chunk)
+	if !bytes.Equal(hash[:], s.manifest.ChunkHashes[resp.Index]) {
+		rbft.rejectSnapshotData(resp.ReplicaId, "snapshot_chunk", errors.Errorf("mismatch hash of chunk %d", resp.Index))
+		rbft.requestSnapshotChunk(resp.Index)
+		return nil
+	}
+	delete(s.inFlight, resp.Index)
+	s.downloaded[resp.Index] = resp.Chunk
+
+	applied := s.nextApply
+	for {
+		chunk, ok := s.downloaded[s.nextApply]
+		if !ok {
+			break
+		}
+		if err := rbft.config.SnapshotConsumer.ApplyChunk(s.manifest, s.nextApply, chunk); err != nil {
+			rbft.logger.Errorf("Replica %d apply snapshot chunk %d failed: %v, restart snapshot sync",
+				rbft.chainConfig.SelfID, s.nextApply, err)
+			rbft.restartSnapshotSync()
+			return nil
+		}
+		delete(s.downloaded, s.nextApply)
+		s.nextApply++
+		rbft.persistSnapshotSyncProgress()
+		rbft.metrics.snapshotSyncChunksGauge.With("type", "applied").Set(float64(s.nextApply))
+		rbft.logger.Debugf("Replica %d applied snapshot chunk %d/%d", rbft.chainConfig.SelfID,
+			s.nextApply, len(s.manifest.ChunkHashes))
+	}
+
+	if s.nextApply == uint64(len(s.manifest.ChunkHashes)) {
+		rbft.completeSnapshotSync()
+		return nil
+	}
+	rbft.requestSnapshotChunks()
+	if s.nextApply > applied {
+		rbft.recvStateUpdateProgress(&types.StateUpdateProgress{
+			RequestID:    rbft.stateUpdateID,
+			TargetHeight: s.target.metaState.Height,
+			Synced:       s.nextApply,
+			Total:        uint64(len(s.manifest.ChunkHashes)),
+		})
+	}
+	return nil
+}
+
+func (rbft *rbftImpl[T, Constraint]) rejectSnapshotData(from uint64, typ string, err error) {
+	rbft.logger.Warningf("Replica %d received invalid %s from replica %d: %v", rbft.chainConfig.SelfID, typ, from, err)
+	rbft.metrics.invalidFetchResponseCounter.With("type", typ).Add(float64(1))
+	rbft.snapshotSync.excluded[from] = struct{}{}
+}
+
+// completeSnapshotSync hands the restored snapshot over to application.
+func (rbft *rbftImpl[T, Constraint]) completeSnapshotSync() {
+	s := rbft.snapshotSync
+	err := rbft.config.SnapshotConsumer.CompleteSnapshot(s.manifest, s.target.checkpointSet, s.target.epochChanges...)
+	if err != nil {
+		rbft.logger.Errorf("Replica %d complete snapshot of height %d failed: %v, restart snapshot sync",
+			rbft.chainConfig.SelfID, s.manifest.Height, err)
+		rbft.restartSnapshotSync()
+		return
+	}
+	rbft.logger.Noticef("Replica %d finished snapshot sync to height %d", rbft.chainConfig.SelfID, s.manifest.Height)
+	rbft.stopSnapshotSync()
+}
+
+// restartSnapshotSync downloads the snapshot of current target from the beginning.
+func (rbft *rbftImpl[T, Constraint]) restartSnapshotSync() {
+	s := rbft.snapshotSync
+	_ = rbft.storage.DelState(snapshotSyncKey)
+	s.manifest = nil
+	s.nextApply = 0
+	s.nextRequest = 0
+	s.inFlight = make(map[uint64]*snapshotChunkRequest)
+	s.downloaded = make(map[uint64][]byte)
+	rbft.requestSnapshotManifest()
+}
+
+func (rbft *rbftImpl[T, Constraint]) stopSnapshotSync() {
+	rbft.timerMgr.stopTimer(snapshotSyncTimer)
+	_ = rbft.storage.DelState(snapshotSyncKey)
+	rbft.snapshotSync = nil
+}
+
+func (rbft *rbftImpl[T, Constraint]) startSnapshotSyncTimer() {
+	event := &LocalEvent{
+		Service:   RecoveryService,
+		EventType: RecoverySnapshotSyncTimerEvent,
+	}
+	rbft.timerMgr.startTimer(snapshotSyncTimer, event)
+}
+
+// handleSnapshotSyncTimerEvent re-requests the manifest or chunks without response in time from
+// other peers.
+func (rbft *rbftImpl[T, Constraint]) handleSnapshotSyncTimerEvent() {
+	s := rbft.snapshotSync
+	if s == nil {
+		return
+	}
+	if s.manifest == nil {
+		rbft.logger.Infof("Replica %d request snapshot manifest timeout, retry against other peers", rbft.chainConfig.SelfID)
+		rbft.metrics.fetchRetryCounter.With("type", "snapshot_manifest").Add(float64(1))
+		rbft.requestSnapshotManifest()
+	} else {
+		timeout := rbft.timerMgr.getTimeoutValue(snapshotSyncTimer)
+		for index, req := range s.inFlight {
+			if time.Since(req.sentAt) < timeout {
+				continue
+			}
+			rbft.logger.Infof("Replica %d request snapshot chunk %d from replica %d timeout, retry against other peers",
+				rbft.chainConfig.SelfID, index, req.peer)
+			rbft.metrics.fetchRetryCounter.With("type", "snapshot_chunk").Add(float64(1))
+			rbft.requestSnapshotChunk(index)
+		}
+	}
+	rbft.startSnapshotSyncTimer()
+}
+
+func (rbft *rbftImpl[T, Constraint]) persistSnapshotSyncProgress() {
+	s := rbft.snapshotSync
+	val := fmt.Sprintf("%d,%s,%d", s.manifest.Height, s.manifest.Digest, s.nextApply)
+	if err := rbft.storage.StoreState(snapshotSyncKey, []byte(val)); err != nil {
+		rbft.logger.Errorf("Persist snapshot sync progress failed with err: %s ", err)
+	}
+}
+
+func (rbft *rbftImpl[T, Constraint]) restoreSnapshotSyncProgress() (height uint64, digest string, next uint64, err error) {
+	raw, err := rbft.storage.ReadState(snapshotSyncKey)
+	if err != nil {
+		return 0, "", 0, err
+	}
+	fields := strings.Split(string(raw), ",")
+	if len(fields) != 3 {
+		return 0, "", 0, errors.Errorf("invalid snapshot sync progress %s", raw)
+	}
+	if height, err = strconv.ParseUint(fields[0], 10, 64); err != nil {
+		return 0, "", 0, err
+	}
+	if next, err = strconv.ParseUint(fields[2], 10, 64); err != nil {
+		return 0, "", 0, err
+	}
+	return height, fields[1], next, nil
+}
+
+// startSnapshotServeWorkers starts workers which serve snapshot requests with SnapshotProvider out of
+// the event loop, so that reading snapshots neither blocks the event loop nor starts unbounded goroutines.
+func (rbft *rbftImpl[T, Constraint]) startSnapshotServeWorkers() {
+	for i := 0; i < snapshotServeWorkers; i++ {
+		go func() {
+			for {
+				select {
+				case <-rbft.close:
+					return
+				case job := <-rbft.snapshotServeJobs:
+					job()
+				}
+			}
+		}()
+	}
+}
+
+// postSnapshotServeJob queues the job to serve a snapshot request from the given replica. Only requests
+// from validators are served, and requests are dropped if the queue is full.
+func (rbft *rbftImpl[T, Constraint]) postSnapshotServeJob(from uint64, job func()) {
+	if !rbft.chainConfig.CheckValidator(from) {
+		rbft.logger.Debugf("Replica %d not serve snapshot to non-validator %d", rbft.chainConfig.SelfID, from)
+		return
+	}
+	select {
+	case rbft.snapshotServeJobs <- job:
+	default:
+		rbft.logger.Warningf("Replica %d drop snapshot request from replica %d as serve queue is full",
+			rbft.chainConfig.SelfID, from)
+	}
+}
+
+// recvSnapshotManifestRequest serves the manifest with SnapshotProvider by serve workers.
+func (rbft *rbftImpl[T, Constraint]) recvSnapshotManifestRequest(req *consensus.SnapshotManifestRequest) consensusEvent {
+	provider := rbft.config.SnapshotProvider
+	if provider == nil {
+		return nil
+	}
+	rbft.postSnapshotServeJob(req.ReplicaId, func() {
+		manifest, err := provider.SnapshotManifest(req.Height, req.Digest)
+		if err != nil {
+			rbft.logger.Debugf("Replica %d could not serve snapshot manifest of height %d: %v",
+				rbft.chainConfig.SelfID, req.Height, err)
+			return
+		}
+		resp := &consensus.SnapshotManifestResponse{
+			ReplicaId: rbft.chainConfig.SelfID,
+			Manifest:  manifest,
+		}
+		rbft.postSnapshotServeMsg(req.ReplicaId, consensus.Type_SNAPSHOT_MANIFEST_RESPONSE, resp)
+	})
+	return nil
+}
+
+// recvSnapshotChunkRequest serves the chunk with SnapshotProvider by serve workers.
+func (rbft *rbftImpl[T, Constraint]) recvSnapshotChunkRequest(req *consensus.SnapshotChunkRequest) consensusEvent {
+	provider := rbft.config.SnapshotProvider
+	if provider == nil {
+		return nil
+	}
+	rbft.postSnapshotServeJob(req.ReplicaId, func() {
+		chunk, err := provider.SnapshotChunk(req.Height, req.Digest, req.Index)
+		if err != nil {
+			rbft.logger.Debugf("Replica %d could not serve snapshot chunk %d of height %d: %v",
+				rbft.chainConfig.SelfID, req.Index, req.Height, err)
+			return
+		}
+		resp := &consensus.SnapshotChunkResponse{
+			ReplicaId: rbft.chainConfig.SelfID,
+			Height:    req.Height,
+			Digest:    req.Digest,
+			Index:     req.Index,
+			Chunk:     chunk,
+		}
+		rbft.postSnapshotServeMsg(req.ReplicaId, consensus.Type_SNAPSHOT_CHUNK_RESPONSE, resp)
+	})
+	return nil
+}
+
+// postSnapshotServeMsg posts the response to the event loop to send.
+func (rbft *rbftImpl[T, Constraint]) postSnapshotServeMsg(to uint64, typ consensus.Type, resp consensus.Message) {
+	payload, err := resp.MarshalVTStrict()
+	if err != nil {
+		rbft.logger.Errorf("ConsensusMessage_%s Marshal Error: %s", typ, err)
+		return
+	}
+	rbft.postMsg(&LocalEvent{
+		Service:   RecoveryService,
+		EventType: RecoverySnapshotServeEvent,
+		Event: &snapshotServeMsg{
+			to: to,
+			msg: &consensus.ConsensusMessage{
+				Type:    typ,
+				Payload: payload,
+			},
+		},
+	})
+}
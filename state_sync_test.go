@@ -0,0 +1,194 @@
+package rbft
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-bft/types"
+)
+
+type testSnapshot struct {
+	manifest *consensus.SnapshotManifest
+	chunks   [][]byte
+
+	applied   [][]byte
+	completed bool
+}
+
+func newTestSnapshot(height uint64, digest string, chunks ...[]byte) *testSnapshot {
+	manifest := &consensus.SnapshotManifest{Height: height, Digest: digest, Metadata: []byte("state-root")}
+	for _, chunk := range chunks {
+		hash := sha256.Sum256(chunk)
+		manifest.ChunkHashes = append(manifest.ChunkHashes, hash[:])
+	}
+	return &testSnapshot{manifest: manifest, chunks: chunks}
+}
+
+func (s *testSnapshot) SnapshotManifest(height uint64, digest string) (*consensus.SnapshotManifest, error) {
+	if height != s.manifest.Height || digest != s.manifest.Digest {
+		return nil, errors.New("snapshot not found")
+	}
+	return s.manifest, nil
+}
+
+func (s *testSnapshot) SnapshotChunk(height uint64, digest string, index uint64) ([]byte, error) {
+	if height != s.manifest.Height || digest != s.manifest.Digest || index >= uint64(len(s.chunks)) {
+		return nil, errors.New("chunk not found")
+	}
+	return s.chunks[index], nil
+}
+
+func (s *testSnapshot) VerifyManifest(manifest *consensus.SnapshotManifest) error {
+	if !manifest.EqualVT(s.manifest) {
+		return errors.New("mismatch manifest")
+	}
+	return nil
+}
+
+func (s *testSnapshot) ApplyChunk(_ *consensus.SnapshotManifest, index uint64, chunk []byte) error {
+	if index != uint64(len(s.applied)) {
+		return errors.Errorf("apply chunk %d out of order", index)
+	}
+	s.applied = append(s.applied, chunk)
+	return nil
+}
+
+func (s *testSnapshot) CompleteSnapshot(*consensus.SnapshotManifest, []*consensus.SignedCheckpoint, ...*consensus.EpochChange) error {
+	s.completed = true
+	return nil
+}
+
+func TestRBFT_snapshotSync(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	rbft := rbfts[0]
+	ext := rbft.external.(*testExternal[consensus.FltTransaction, *consensus.FltTransaction])
+	snapshot := newTestSnapshot(10, "checkpoint-digest", []byte("chunk-0"), []byte("chunk-1"), []byte("chunk-2"))
+	rbft.config.SnapshotConsumer = snapshot
+
+	target := &stateUpdateTarget{
+		metaState:     &types.MetaState{Height: 10, Digest: "checkpoint-digest"},
+		checkpointSet: []*consensus.SignedCheckpoint{{Author: 1}, {Author: 2}, {Author: 3}, {Author: 4}},
+	}
+	listenRequests := func(typ consensus.Type, n int) {
+		for n > 0 {
+			// skip messages of other nodes in the shared cluster channel.
+			rvc := <-ext.ListenMsg()
+			if rvc.msg.Type == typ && rvc.msg.From == rbft.chainConfig.SelfID {
+				n--
+			}
+		}
+	}
+	chunkResp := func(from, index uint64, chunk []byte) *consensus.SnapshotChunkResponse {
+		return &consensus.SnapshotChunkResponse{ReplicaId: from, Height: 10, Digest: "checkpoint-digest", Index: index, Chunk: chunk}
+	}
+
+	rbft.storeMgr.highStateTarget = target
+	rbft.tryStateTransfer()
+	assert.Equal(t, []uint64{2, 3, 4}, rbft.snapshotSync.peers)
+	listenRequests(consensus.Type_SNAPSHOT_MANIFEST_REQUEST, 1)
+
+	// manifest rejected by consumer is requested from another peer.
+	invalid := snapshot.manifest.CloneVT()
+	invalid.Metadata = []byte("other-state-root")
+	rbft.recvSnapshotManifestResponse(&consensus.SnapshotManifestResponse{ReplicaId: 2, Manifest: invalid})
+	assert.Contains(t, rbft.snapshotSync.excluded, uint64(2))
+	assert.Nil(t, rbft.snapshotSync.manifest)
+	listenRequests(consensus.Type_SNAPSHOT_MANIFEST_REQUEST, 1)
+
+	rbft.recvSnapshotManifestResponse(&consensus.SnapshotManifestResponse{ReplicaId: 3, Manifest: snapshot.manifest})
+	listenRequests(consensus.Type_SNAPSHOT_CHUNK_REQUEST, 3)
+	assert.Len(t, rbft.snapshotSync.inFlight, 3)
+
+	// chunk mismatching the manifest is requested again.
+	rbft.recvSnapshotChunkResponse(chunkResp(3, 1, []byte("chunk-x")))
+	assert.Contains(t, rbft.snapshotSync.excluded, uint64(3))
+	listenRequests(consensus.Type_SNAPSHOT_CHUNK_REQUEST, 1)
+
+	// chunks are applied in order.
+	rbft.recvSnapshotChunkResponse(chunkResp(3, 1, []byte("chunk-1")))
+	assert.Empty(t, snapshot.applied)
+	rbft.recvSnapshotChunkResponse(chunkResp(3, 0, []byte("chunk-0")))
+	assert.Equal(t, [][]byte{[]byte("chunk-0"), []byte("chunk-1")}, snapshot.applied)
+	progress, err := rbft.storage.ReadState(snapshotSyncKey)
+	assert.Nil(t, err)
+	assert.Equal(t, "10,checkpoint-digest,2", string(progress))
+
+	// resume from persisted progress after restart.
+	rbft.timerMgr.stopTimer(snapshotSyncTimer)
+	rbft.snapshotSync = nil
+	assert.True(t, rbft.startSnapshotSync(target))
+	assert.Equal(t, uint64(2), rbft.snapshotSync.nextApply)
+	rbft.recvSnapshotManifestResponse(&consensus.SnapshotManifestResponse{ReplicaId: 3, Manifest: snapshot.manifest})
+	assert.Len(t, rbft.snapshotSync.inFlight, 1)
+	assert.Contains(t, rbft.snapshotSync.inFlight, uint64(2))
+
+	rbft.recvSnapshotChunkResponse(chunkResp(2, 2, []byte("chunk-2")))
+	assert.Len(t, snapshot.applied, 3)
+	assert.True(t, snapshot.completed)
+	assert.Nil(t, rbft.snapshotSync)
+	_, err = rbft.storage.ReadState(snapshotSyncKey)
+	assert.NotNil(t, err)
+}
+
+func TestRBFT_snapshotSyncWithoutPeers(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	rbft := rbfts[0]
+	rbft.config.SnapshotConsumer = newTestSnapshot(10, "checkpoint-digest")
+	rbft.chainConfig.ValidatorDynamicInfoMap = map[uint64]*ValidatorInfo{rbft.chainConfig.SelfID: {}}
+
+	target := &stateUpdateTarget{
+		metaState:     &types.MetaState{Height: 10, Digest: "checkpoint-digest"},
+		checkpointSet: []*consensus.SignedCheckpoint{{Author: rbft.chainConfig.SelfID}},
+	}
+	assert.False(t, rbft.startSnapshotSync(target))
+	assert.Nil(t, rbft.snapshotSync)
+
+	s := &snapshotSync{excluded: make(map[uint64]struct{})}
+	_, ok := s.nextSnapshotPeer()
+	assert.False(t, ok)
+}
+
+func TestRBFT_serveSnapshot(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	rbft := rbfts[1]
+	snapshot := newTestSnapshot(10, "checkpoint-digest", []byte("chunk-0"))
+
+	// not served without provider.
+	rbft.recvSnapshotChunkRequest(&consensus.SnapshotChunkRequest{ReplicaId: 1, Height: 10, Digest: "checkpoint-digest"})
+	assert.Len(t, rbft.snapshotServeJobs, 0)
+
+	// not served to non-validators.
+	rbft.config.SnapshotProvider = snapshot
+	rbft.recvSnapshotChunkRequest(&consensus.SnapshotChunkRequest{ReplicaId: 100, Height: 10, Digest: "checkpoint-digest"})
+	assert.Len(t, rbft.snapshotServeJobs, 0)
+
+	rbft.recvSnapshotChunkRequest(&consensus.SnapshotChunkRequest{ReplicaId: 1, Height: 10, Digest: "checkpoint-digest"})
+	(<-rbft.snapshotServeJobs)()
+	ev := (<-rbft.recvChan).(*LocalEvent)
+	assert.Equal(t, RecoverySnapshotServeEvent, ev.EventType)
+	serve := ev.Event.(*snapshotServeMsg)
+	assert.Equal(t, uint64(1), serve.to)
+	assert.Equal(t, consensus.Type_SNAPSHOT_CHUNK_RESPONSE, serve.msg.Type)
+	resp := &consensus.SnapshotChunkResponse{}
+	assert.Nil(t, resp.UnmarshalVT(serve.msg.Payload))
+	assert.Equal(t, []byte("chunk-0"), resp.Chunk)
+
+	rbft.recvSnapshotManifestRequest(&consensus.SnapshotManifestRequest{ReplicaId: 1, Height: 10, Digest: "checkpoint-digest"})
+	(<-rbft.snapshotServeJobs)()
+	ev = (<-rbft.recvChan).(*LocalEvent)
+	serve = ev.Event.(*snapshotServeMsg)
+	manifestResp := &consensus.SnapshotManifestResponse{}
+	assert.Nil(t, manifestResp.UnmarshalVT(serve.msg.Payload))
+	assert.True(t, manifestResp.Manifest.EqualVT(snapshot.manifest))
+
+	// requests are dropped once the serve queue is full.
+	for i := 0; i < snapshotServeQueueSize+1; i++ {
+		rbft.recvSnapshotManifestRequest(&consensus.SnapshotManifestRequest{ReplicaId: 1, Height: 10, Digest: "checkpoint-digest"})
+	}
+	assert.Len(t, rbft.snapshotServeJobs, snapshotServeQueueSize)
+}
@@ -0,0 +1,133 @@
+package rbft
+
+import (
+	"time"
+
+	"github.com/axiomesh/axiom-bft/types"
+)
+
+// reportStateUpdateProgress informs RBFT the progress of ongoing state update.
+func (rbft *rbftImpl[T, Constraint]) reportStateUpdateProgress(progress *types.StateUpdateProgress) {
+	if rbft.atomicIn(Pending) {
+		rbft.logger.Debugf("Replica %d is in pending status, reject report state update progress", rbft.chainConfig.SelfID)
+		return
+	}
+	event := &LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreStateUpdateProgressEvent,
+		Event:     progress,
+	}
+
+	go rbft.postMsg(event)
+}
+
+// beginStateUpdate tracks the state update issued to the given target with a new request id.
+func (rbft *rbftImpl[T, Constraint]) beginStateUpdate(target *stateUpdateTarget) {
+	rbft.stateUpdateID++
+	rbft.stateUpdateStart = time.Now()
+	rbft.setStateUpdateProgress(&types.StateUpdateProgress{
+		RequestID:    rbft.stateUpdateID,
+		TargetHeight: target.metaState.Height,
+	})
+	rbft.startStateUpdateTimer()
+}
+
+// endStateUpdate stops tracking the finished state update.
+func (rbft *rbftImpl[T, Constraint]) endStateUpdate() {
+	rbft.timerMgr.stopTimer(stateUpdateTimer)
+	rbft.setStateUpdateProgress(nil)
+}
+
+func (rbft *rbftImpl[T, Constraint]) setStateUpdateProgress(progress *types.StateUpdateProgress) {
+	rbft.stateUpdateLock.Lock()
+	defer rbft.stateUpdateLock.Unlock()
+	rbft.stateUpdateProgress = progress
+}
+
+// isStateUpdateTimeoutEnabled checks if stalled or outdated state updates are re-issued on timeout.
+func (rbft *rbftImpl[T, Constraint]) isStateUpdateTimeoutEnabled() bool {
+	return rbft.config.StateUpdateTimeout > 0
+}
+
+func (rbft *rbftImpl[T, Constraint]) startStateUpdateTimer() {
+	if !rbft.isStateUpdateTimeoutEnabled() {
+		return
+	}
+	event := &LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreStateUpdateTimerEvent,
+	}
+	rbft.timerMgr.startTimer(stateUpdateTimer, event)
+}
+
+// recvStateUpdateProgress records the progress of ongoing state update, and re-issues the state update
+// if it failed, or it has lasted for StateUpdateTimeout and there is a newer target.
+func (rbft *rbftImpl[T, Constraint]) recvStateUpdateProgress(progress *types.StateUpdateProgress) {
+	current := rbft.stateUpdateProgress
+	if !rbft.atomicIn(StateTransferring) || current == nil || progress.TargetHeight != current.TargetHeight {
+		rbft.logger.Debugf("Replica %d ignore state update progress of target %d, not in state update to it",
+			rbft.chainConfig.SelfID, progress.TargetHeight)
+		return
+	}
+	// progress without request id can't be told from the one of a superseded request to the same target,
+	// so it's only accepted if the request id is not passed to application.
+	if progress.RequestID != current.RequestID && (progress.RequestID != 0 || rbft.tracker != nil) {
+		rbft.logger.Debugf("Replica %d ignore state update progress of request %d, current request %d",
+			rbft.chainConfig.SelfID, progress.RequestID, current.RequestID)
+		return
+	}
+
+	if progress.Err != nil {
+		rbft.logger.Warningf("Replica %d state update to %d failed: %v", rbft.chainConfig.SelfID,
+			progress.TargetHeight, progress.Err)
+		rbft.reissueStateUpdate("error")
+		return
+	}
+
+	rbft.logger.Debugf("Replica %d state update to %d progress %d/%d", rbft.chainConfig.SelfID,
+		progress.TargetHeight, progress.Synced, progress.Total)
+	rbft.setStateUpdateProgress(&types.StateUpdateProgress{
+		RequestID:    current.RequestID,
+		TargetHeight: progress.TargetHeight,
+		Synced:       progress.Synced,
+		Total:        progress.Total,
+	})
+	if rbft.isStateUpdateTimeoutEnabled() && rbft.isStateUpdateTargetMoved() &&
+		time.Since(rbft.stateUpdateStart) >= rbft.timerMgr.getTimeoutValue(stateUpdateTimer) {
+		rbft.reissueStateUpdate("retarget")
+		return
+	}
+	rbft.startStateUpdateTimer()
+}
+
+// handleStateUpdateTimerEvent re-issues the state update without progress in StateUpdateTimeout, to the
+// newer target if there is one.
+func (rbft *rbftImpl[T, Constraint]) handleStateUpdateTimerEvent() {
+	if !rbft.isStateUpdateTimeoutEnabled() || !rbft.atomicIn(StateTransferring) {
+		return
+	}
+	reason := "timeout"
+	if rbft.isStateUpdateTargetMoved() {
+		reason = "retarget"
+	}
+	rbft.logger.Warningf("Replica %d has no progress of state update in %v", rbft.chainConfig.SelfID,
+		rbft.timerMgr.getTimeoutValue(stateUpdateTimer))
+	rbft.reissueStateUpdate(reason)
+}
+
+// isStateUpdateTargetMoved checks if high state target has moved beyond the target of ongoing state update.
+func (rbft *rbftImpl[T, Constraint]) isStateUpdateTargetMoved() bool {
+	return rbft.stateUpdateProgress != nil && rbft.storeMgr.highStateTarget != nil &&
+		rbft.storeMgr.highStateTarget.metaState.Height > rbft.stateUpdateProgress.TargetHeight
+}
+
+// reissueStateUpdate issues state update to current high state target again, which supersedes the
+// ongoing one.
+func (rbft *rbftImpl[T, Constraint]) reissueStateUpdate(reason string) {
+	rbft.logger.Noticef("Replica %d re-issue state update to %d because of %s", rbft.chainConfig.SelfID,
+		rbft.storeMgr.highStateTarget.metaState.Height, reason)
+	rbft.metrics.stateUpdateRetryCounter.With("reason", reason).Add(float64(1))
+	rbft.atomicOff(StateTransferring)
+	rbft.metrics.statusGaugeStateTransferring.Set(0)
+	rbft.tryStateTransfer()
+}
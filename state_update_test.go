@@ -0,0 +1,111 @@
+package rbft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-bft/types"
+)
+
+func TestRBFT_stateUpdateProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	rbft := newMockRbft[consensus.FltTransaction, *consensus.FltTransaction](t, ctrl)
+	assert.Nil(t, rbft.init())
+
+	newTarget := func(height uint64, digest string) (*types.MetaState, []*consensus.SignedCheckpoint) {
+		checkpoint := &consensus.Checkpoint{
+			Epoch:        1,
+			ExecuteState: &consensus.Checkpoint_ExecuteState{Height: height, Digest: digest},
+		}
+		return &types.MetaState{Height: height, Digest: digest}, []*consensus.SignedCheckpoint{
+			{Author: 2, Checkpoint: checkpoint, Signature: []byte("sig-2")},
+			{Author: 3, Checkpoint: checkpoint, Signature: []byte("sig-3")},
+			{Author: 4, Checkpoint: checkpoint, Signature: []byte("sig-4")},
+		}
+	}
+
+	rbft.updateHighStateTarget(newTarget(10, "block-10"))
+	rbft.tryStateTransfer()
+	assert.True(t, rbft.atomicIn(StateTransferring))
+	assert.Equal(t, &types.StateUpdateProgress{RequestID: 1, TargetHeight: 10}, rbft.getStatus().StateUpdate)
+
+	// progress of other targets is ignored.
+	rbft.recvStateUpdateProgress(&types.StateUpdateProgress{TargetHeight: 5, Synced: 1, Total: 5})
+	assert.Equal(t, uint64(0), rbft.getStatus().StateUpdate.Synced)
+	rbft.recvStateUpdateProgress(&types.StateUpdateProgress{TargetHeight: 10, Synced: 3, Total: 10})
+	assert.Equal(t, &types.StateUpdateProgress{RequestID: 1, TargetHeight: 10, Synced: 3, Total: 10}, rbft.getStatus().StateUpdate)
+
+	// state update is not re-issued on timeout or re-targeted without StateUpdateTimeout.
+	rbft.updateHighStateTarget(newTarget(20, "block-20"))
+	rbft.stateUpdateStart = time.Now().Add(-time.Hour)
+	rbft.recvStateUpdateProgress(&types.StateUpdateProgress{TargetHeight: 10, Synced: 4, Total: 10})
+	rbft.handleStateUpdateTimerEvent()
+	assert.Equal(t, &types.StateUpdateProgress{RequestID: 1, TargetHeight: 10, Synced: 4, Total: 10}, rbft.getStatus().StateUpdate)
+
+	// newer target only re-targets state update which has lasted for StateUpdateTimeout.
+	rbft.config.StateUpdateTimeout = time.Minute
+	rbft.timerMgr.newTimer(stateUpdateTimer, rbft.config.StateUpdateTimeout)
+	rbft.stateUpdateStart = time.Now()
+	rbft.recvStateUpdateProgress(&types.StateUpdateProgress{TargetHeight: 10, Synced: 5, Total: 10})
+	assert.Equal(t, uint64(10), rbft.getStatus().StateUpdate.TargetHeight)
+	rbft.stateUpdateStart = time.Now().Add(-time.Minute)
+	rbft.recvStateUpdateProgress(&types.StateUpdateProgress{TargetHeight: 10, Synced: 6, Total: 10})
+	assert.Equal(t, &types.StateUpdateProgress{RequestID: 2, TargetHeight: 20}, rbft.getStatus().StateUpdate)
+	assert.True(t, rbft.atomicIn(StateTransferring))
+
+	// failed state update is re-issued right away.
+	rbft.recvStateUpdateProgress(&types.StateUpdateProgress{TargetHeight: 20, Synced: 1, Total: 20})
+	rbft.recvStateUpdateProgress(&types.StateUpdateProgress{TargetHeight: 20, Err: errors.New("sync failed")})
+	assert.Equal(t, &types.StateUpdateProgress{RequestID: 3, TargetHeight: 20}, rbft.getStatus().StateUpdate)
+	assert.True(t, rbft.atomicIn(StateTransferring))
+
+	// progress of superseded requests is ignored.
+	rbft.recvStateUpdateProgress(&types.StateUpdateProgress{RequestID: 2, TargetHeight: 20, Err: errors.New("sync cancelled")})
+	assert.Equal(t, &types.StateUpdateProgress{RequestID: 3, TargetHeight: 20}, rbft.getStatus().StateUpdate)
+	rbft.recvStateUpdateProgress(&types.StateUpdateProgress{RequestID: 3, TargetHeight: 20, Synced: 1, Total: 20})
+	assert.Equal(t, uint64(1), rbft.getStatus().StateUpdate.Synced)
+
+	// state update without progress is re-issued on timeout.
+	rbft.recvStateUpdateProgress(&types.StateUpdateProgress{TargetHeight: 20, Synced: 2, Total: 20})
+	rbft.handleStateUpdateTimerEvent()
+	assert.Equal(t, &types.StateUpdateProgress{RequestID: 4, TargetHeight: 20}, rbft.getStatus().StateUpdate)
+
+	rbft.endStateUpdate()
+	assert.Nil(t, rbft.getStatus().StateUpdate)
+}
+
+func TestRBFT_trackedStateUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	rbft := newMockRbft[consensus.FltTransaction, *consensus.FltTransaction](t, ctrl)
+	assert.Nil(t, rbft.init())
+	tracker := NewMockStateUpdateTracker(ctrl)
+	rbft.tracker = tracker
+
+	done := make(chan struct{})
+	tracker.EXPECT().TrackedStateUpdate(uint64(1), gomock.Any(), uint64(10), "block-10", gomock.Any()).
+		Do(func(uint64, uint64, uint64, string, []*consensus.SignedCheckpoint, ...*consensus.EpochChange) {
+			close(done)
+		})
+	checkpoint := &consensus.Checkpoint{
+		Epoch:        1,
+		ExecuteState: &consensus.Checkpoint_ExecuteState{Height: 10, Digest: "block-10"},
+	}
+	rbft.updateHighStateTarget(&types.MetaState{Height: 10, Digest: "block-10"}, []*consensus.SignedCheckpoint{
+		{Author: 2, Checkpoint: checkpoint, Signature: []byte("sig-2")},
+		{Author: 3, Checkpoint: checkpoint, Signature: []byte("sig-3")},
+		{Author: 4, Checkpoint: checkpoint, Signature: []byte("sig-4")},
+	})
+	rbft.tryStateTransfer()
+	<-done
+
+	// progress must carry the request id if it's passed to application.
+	rbft.recvStateUpdateProgress(&types.StateUpdateProgress{TargetHeight: 10, Synced: 1, Total: 10})
+	assert.Equal(t, uint64(0), rbft.getStatus().StateUpdate.Synced)
+	rbft.recvStateUpdateProgress(&types.StateUpdateProgress{RequestID: 1, TargetHeight: 10, Synced: 1, Total: 10})
+	assert.Equal(t, uint64(1), rbft.getStatus().StateUpdate.Synced)
+}
@@ -17,7 +17,8 @@ package rbft
 import (
 	"sync/atomic"
 
-	"github.com/axiomesh/axiom-kit/types"
+	"github.com/axiomesh/axiom-bft/types"
+	kittypes "github.com/axiomesh/axiom-kit/types"
 )
 
 // StatusType defines the RBFT internal status.
@@ -51,9 +52,12 @@ const (
 type NodeStatus struct {
 	ID        uint64
 	View      uint64
-	EpochInfo *types.EpochInfo
+	EpochInfo *kittypes.EpochInfo
 	H         uint64
 	Status    StatusType
+
+	// StateUpdate is the progress of ongoing state update, nil if not in state update.
+	StateUpdate *types.StateUpdateProgress
 }
 
 type statusManager struct {
@@ -51,8 +51,8 @@ type storeManager[T any, Constraint types2.TXConstraint[T]] struct {
 	batchStore map[string]*RequestBatch[T, Constraint]
 
 	// for all the assigned, non-checkpointed request batches we might miss
-	// some transactions in some batches, record batch no
-	missingReqBatches map[string]bool
+	// some transactions in some batches, record the seqNo assigned to each batch digest
+	missingReqBatches map[string]uint64
 
 	// used by backup node to record all missing tx batches which are in fetching
 	// to avoid fetch the same batch repeatedly.
@@ -84,6 +84,25 @@ type storeManager[T any, Constraint types2.TXConstraint[T]] struct {
 	wrfHighViewMsgCache map[uint64]*wrfHighViewCacheMsg
 
 	beforeCheckpointEventCache []consensusEvent
+
+	// ---------------threshold decryption related--------------------
+	// verified decryption shares of committed batches, map {seqNo, digest} to share of each validator
+	decryptionShares map[msgID]map[uint64][]byte
+
+	// decryption shares received before the batch is known, which are verified once the batch is
+	// known, map {seqNo, digest} to candidate shares of each validator
+	unverifiedDecryptionShares map[msgID]map[uint64][][]byte
+
+	// decrypted txs of committed batches waiting for execution, map digest to decrypted txs
+	decryptedBatches map[string][]*T
+
+	// ---------------vrf seed related--------------------
+	// VRF output of proposers of executed batches, map seqNo to VRF output
+	vrfOutputs map[uint64][]byte
+
+	// ---------------key rotation related--------------------
+	// validator keys carried by restored local checkpoints, map seqNo to keys
+	checkpointKeys map[uint64][]*consensus.ValidatorInfo
 }
 
 type wrfHighViewCacheMsg struct {
@@ -106,20 +125,25 @@ type stateUpdateTarget struct {
 // newStoreMgr news an instance of storeManager
 func newStoreMgr[T any, Constraint types2.TXConstraint[T]](c Config) *storeManager[T, Constraint] {
 	sm := &storeManager[T, Constraint]{
-		committedCertCache:       make(map[msgID]*msgCert),
-		localCheckpoints:         make(map[uint64]*consensus.SignedCheckpoint),
-		higherCheckpoints:        make(map[uint64]*consensus.SignedCheckpoint),
-		checkpointStore:          make(map[chkptID]*consensus.SignedCheckpoint),
-		wrfHighViewMsgCache:      make(map[uint64]*wrfHighViewCacheMsg),
-		certStore:                make(map[msgID]*msgCert),
-		committedCert:            make(map[msgID]string),
-		seqMap:                   make(map[uint64]string),
-		outstandingReqBatches:    make(map[string]*RequestBatch[T, Constraint]),
-		batchStore:               make(map[string]*RequestBatch[T, Constraint]),
-		missingReqBatches:        make(map[string]bool),
-		missingBatchesInFetching: make(map[string]msgID),
-		logger:                   c.Logger,
-		config:                   c,
+		committedCertCache:         make(map[msgID]*msgCert),
+		localCheckpoints:           make(map[uint64]*consensus.SignedCheckpoint),
+		higherCheckpoints:          make(map[uint64]*consensus.SignedCheckpoint),
+		checkpointStore:            make(map[chkptID]*consensus.SignedCheckpoint),
+		wrfHighViewMsgCache:        make(map[uint64]*wrfHighViewCacheMsg),
+		certStore:                  make(map[msgID]*msgCert),
+		committedCert:              make(map[msgID]string),
+		seqMap:                     make(map[uint64]string),
+		outstandingReqBatches:      make(map[string]*RequestBatch[T, Constraint]),
+		batchStore:                 make(map[string]*RequestBatch[T, Constraint]),
+		missingReqBatches:          make(map[string]uint64),
+		missingBatchesInFetching:   make(map[string]msgID),
+		decryptionShares:           make(map[msgID]map[uint64][]byte),
+		unverifiedDecryptionShares: make(map[msgID]map[uint64][][]byte),
+		decryptedBatches:           make(map[string][]*T),
+		vrfOutputs:                 make(map[uint64][]byte),
+		checkpointKeys:             make(map[uint64][]*consensus.ValidatorInfo),
+		logger:                     c.Logger,
+		config:                     c,
 	}
 	return sm
 }
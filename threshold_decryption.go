@@ -0,0 +1,292 @@
+package rbft
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+// ThresholdDecrypter decrypts batches of txs encrypted to the threshold key of validators, in which
+// batches are ordered over ciphertexts and decrypted only after committed, so that the primary cannot
+// front-run txs in the batch. The threshold key must be shared so that any F+1 validators can decrypt,
+// and txs are passed in their marshaled form.
+type ThresholdDecrypter interface {
+	// DecryptionShare returns the decryption share of local validator for the given encrypted txs.
+	DecryptionShare(seqNo uint64, batchDigest string, txs [][]byte) ([]byte, error)
+
+	// VerifyDecryptionShare verifies the decryption share generated by the given validator.
+	VerifyDecryptionShare(seqNo uint64, batchDigest string, txs [][]byte, nodeID uint64, share []byte) error
+
+	// Combine combines the decryption shares of validators and returns the decrypted txs, which must be
+	// in the same order as the encrypted txs. A tx which cannot be decrypted should be returned as it is
+	// rather than failing the whole batch, as the batch has been committed.
+	Combine(seqNo uint64, batchDigest string, txs [][]byte, shares map[uint64][]byte) ([][]byte, error)
+}
+
+// maxDecryptionShareCandidates is the max number of unverified decryption shares kept for each validator
+// before the batch is known.
+const maxDecryptionShareCandidates = 4
+
+// isThresholdDecryptionEnabled returns if txs are threshold-encrypted before committed.
+func (rbft *rbftImpl[T, Constraint]) isThresholdDecryptionEnabled() bool {
+	return rbft.config.ThresholdDecrypter != nil
+}
+
+// decryptionShareIdx returns the index of decryption shares, which is irrelevant to view as the same
+// batch may be committed in different views.
+func decryptionShareIdx(n uint64, d string) msgID {
+	return msgID{n: n, d: d}
+}
+
+// encryptedTxs returns the marshaled txs of the given batch.
+func (rbft *rbftImpl[T, Constraint]) encryptedTxs(batch *RequestBatch[T, Constraint]) ([][]byte, error) {
+	txs := make([][]byte, len(batch.RequestList))
+	for i, tx := range batch.RequestList {
+		raw, err := Constraint(tx).RbftMarshal()
+		if err != nil {
+			return nil, err
+		}
+		txs[i] = raw
+	}
+	return txs, nil
+}
+
+// decryptCommittedBatch is called once a batch is committed and before executing it, it broadcasts
+// decryption share of local validator and tries to combine the decryption shares, returns whether the
+// batch has been decrypted.
+func (rbft *rbftImpl[T, Constraint]) decryptCommittedBatch(n uint64, d string) bool {
+	if _, ok := rbft.storeMgr.decryptedBatches[d]; ok {
+		return true
+	}
+	batch, ok := rbft.storeMgr.batchStore[d]
+	if !ok {
+		return false
+	}
+	txs, err := rbft.encryptedTxs(batch)
+	if err != nil {
+		rbft.logger.Errorf("Replica %d marshal txs of batch %s failed: %s", rbft.chainConfig.SelfID, d, err)
+		return false
+	}
+
+	idx := decryptionShareIdx(n, d)
+	shares, ok := rbft.storeMgr.decryptionShares[idx]
+	if !ok {
+		shares = make(map[uint64][]byte)
+		rbft.storeMgr.decryptionShares[idx] = shares
+	}
+	if _, ok = shares[rbft.chainConfig.SelfID]; !ok && rbft.chainConfig.isValidator() {
+		rbft.sendDecryptionShare(n, d, txs, shares)
+	}
+	rbft.verifyDecryptionShareCandidates(n, d, txs, shares)
+	if len(shares) < rbft.chainConfig.F+1 {
+		rbft.logger.Debugf("Replica %d waits for decryption shares of seqNo=%d/digest=%s, current %d",
+			rbft.chainConfig.SelfID, n, d, len(shares))
+		rbft.softStartDecryptionShareTimer()
+		return false
+	}
+
+	raws, err := rbft.config.ThresholdDecrypter.Combine(n, d, txs, shares)
+	if err == nil && len(raws) != len(txs) {
+		err = fmt.Errorf("expect %d decrypted txs, but got %d", len(txs), len(raws))
+	}
+	if err != nil {
+		rbft.logger.Errorf("Replica %d combine decryption shares of seqNo=%d/digest=%s failed: %s",
+			rbft.chainConfig.SelfID, n, d, err)
+		return false
+	}
+	decryptedTxs := make([]*T, len(raws))
+	for i, raw := range raws {
+		tx := new(T)
+		if err = Constraint(tx).RbftUnmarshal(raw); err != nil {
+			rbft.logger.Errorf("Replica %d unmarshal decrypted tx of seqNo=%d/digest=%s failed: %s",
+				rbft.chainConfig.SelfID, n, d, err)
+			return false
+		}
+		decryptedTxs[i] = tx
+	}
+	rbft.storeMgr.decryptedBatches[d] = decryptedTxs
+	rbft.logger.Debugf("Replica %d decrypted batch seqNo=%d/digest=%s with %d shares",
+		rbft.chainConfig.SelfID, n, d, len(shares))
+	return true
+}
+
+// verifyDecryptionShareCandidates verifies the shares received before the batch is known, the first
+// valid share of each validator is kept and the others are dropped, so that a validator whose shares
+// are all invalid may send its share again.
+func (rbft *rbftImpl[T, Constraint]) verifyDecryptionShareCandidates(n uint64, d string, txs [][]byte, shares map[uint64][]byte) {
+	idx := decryptionShareIdx(n, d)
+	for id, candidates := range rbft.storeMgr.unverifiedDecryptionShares[idx] {
+		if _, ok := shares[id]; ok {
+			continue
+		}
+		for _, share := range candidates {
+			if err := rbft.config.ThresholdDecrypter.VerifyDecryptionShare(n, d, txs, id, share); err != nil {
+				rbft.logger.Warningf("Replica %d received invalid decryption share of seqNo=%d/digest=%s from replica %d: %s",
+					rbft.chainConfig.SelfID, n, d, id, err)
+				continue
+			}
+			shares[id] = share
+			break
+		}
+	}
+	delete(rbft.storeMgr.unverifiedDecryptionShares, idx)
+}
+
+// sendDecryptionShare broadcasts decryption share of local validator for the committed batch.
+func (rbft *rbftImpl[T, Constraint]) sendDecryptionShare(n uint64, d string, txs [][]byte, shares map[uint64][]byte) {
+	share, err := rbft.config.ThresholdDecrypter.DecryptionShare(n, d, txs)
+	if err != nil {
+		rbft.logger.Errorf("Replica %d generate decryption share of seqNo=%d/digest=%s failed: %s",
+			rbft.chainConfig.SelfID, n, d, err)
+		return
+	}
+	shares[rbft.chainConfig.SelfID] = share
+	rbft.broadcastDecryptionShare(n, d, share)
+}
+
+// broadcastDecryptionShare broadcasts the given decryption share of local validator.
+func (rbft *rbftImpl[T, Constraint]) broadcastDecryptionShare(n uint64, d string, share []byte) {
+	decryptionShare := &consensus.DecryptionShare{
+		ReplicaId:      rbft.chainConfig.SelfID,
+		SequenceNumber: n,
+		BatchDigest:    d,
+		Share:          share,
+	}
+	payload, err := decryptionShare.MarshalVTStrict()
+	if err != nil {
+		rbft.logger.Errorf("ConsensusMessage_DECRYPTION_SHARE Marshal Error: %s", err)
+		return
+	}
+	consensusMsg := &consensus.ConsensusMessage{
+		Type:    consensus.Type_DECRYPTION_SHARE,
+		Payload: payload,
+	}
+	rbft.logger.Debugf("Replica %d broadcast decryption share of seqNo=%d/digest=%s", rbft.chainConfig.SelfID, n, d)
+	rbft.peerMgr.broadcast(context.TODO(), consensusMsg)
+}
+
+// softStartDecryptionShareTimer starts the decryption share timer if it's not running.
+func (rbft *rbftImpl[T, Constraint]) softStartDecryptionShareTimer() {
+	event := &LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreDecryptionShareTimerEvent,
+	}
+	rbft.timerMgr.softStartTimerWithNewTT(decryptionShareTimer, rbft.timerMgr.getTimeoutValue(decryptionShareTimer), event)
+}
+
+// handleDecryptionShareTimerEvent resends decryption share of local validator for every committed batch
+// not decrypted yet, as shares broadcast once may be lost or dropped by replicas which have not known the
+// batch, and restarts the timer while any batch is still waiting for decryption.
+func (rbft *rbftImpl[T, Constraint]) handleDecryptionShareTimerEvent() {
+	rbft.timerMgr.stopTimer(decryptionShareTimer)
+	if !rbft.isThresholdDecryptionEnabled() {
+		return
+	}
+	pending := false
+	for idx := range rbft.storeMgr.committedCert {
+		if idx.d == "" || idx.n <= rbft.exec.lastExec {
+			continue
+		}
+		if _, ok := rbft.storeMgr.decryptedBatches[idx.d]; ok {
+			continue
+		}
+		share, ok := rbft.storeMgr.decryptionShares[decryptionShareIdx(idx.n, idx.d)][rbft.chainConfig.SelfID]
+		if !ok {
+			// share has not been generated, e.g. the batch was unknown when committed.
+			if !rbft.decryptCommittedBatch(idx.n, idx.d) {
+				pending = true
+			}
+			continue
+		}
+		rbft.logger.Debugf("Replica %d resend decryption share of seqNo=%d/digest=%s", rbft.chainConfig.SelfID, idx.n, idx.d)
+		rbft.broadcastDecryptionShare(idx.n, idx.d, share)
+		pending = true
+	}
+	if pending {
+		rbft.softStartDecryptionShareTimer()
+	}
+}
+
+// recvDecryptionShare process logic after receive decryption share from other validators.
+func (rbft *rbftImpl[T, Constraint]) recvDecryptionShare(share *consensus.DecryptionShare) consensusEvent {
+	if !rbft.isThresholdDecryptionEnabled() {
+		rbft.logger.Debugf("Replica %d ignore decryption share as threshold decryption is disabled", rbft.chainConfig.SelfID)
+		return nil
+	}
+	n, d := share.SequenceNumber, share.BatchDigest
+	rbft.logger.Debugf("Replica %d received decryption share of seqNo=%d/digest=%s from replica %d",
+		rbft.chainConfig.SelfID, n, d, share.ReplicaId)
+
+	if n <= rbft.exec.lastExec || rbft.beyondRange(n) || d == "" {
+		rbft.logger.Debugf("Replica %d ignore decryption share of seqNo=%d/digest=%s, last exec %d",
+			rbft.chainConfig.SelfID, n, d, rbft.exec.lastExec)
+		return nil
+	}
+	if _, ok := rbft.storeMgr.decryptedBatches[d]; ok {
+		return nil
+	}
+
+	idx := decryptionShareIdx(n, d)
+	shares, ok := rbft.storeMgr.decryptionShares[idx]
+	if !ok {
+		shares = make(map[uint64][]byte)
+		rbft.storeMgr.decryptionShares[idx] = shares
+	}
+	if _, ok = shares[share.ReplicaId]; ok {
+		rbft.logger.Debugf("Replica %d ignore duplicate decryption share from replica %d", rbft.chainConfig.SelfID, share.ReplicaId)
+		return nil
+	}
+
+	batch, ok := rbft.storeMgr.batchStore[d]
+	if !ok {
+		// keep a few candidates as the share cannot be verified until the batch is known, so that an
+		// invalid share cannot occupy the place of the valid one.
+		candidates, ok := rbft.storeMgr.unverifiedDecryptionShares[idx]
+		if !ok {
+			candidates = make(map[uint64][][]byte)
+			rbft.storeMgr.unverifiedDecryptionShares[idx] = candidates
+		}
+		for _, c := range candidates[share.ReplicaId] {
+			if bytes.Equal(c, share.Share) {
+				return nil
+			}
+		}
+		if len(candidates[share.ReplicaId]) >= maxDecryptionShareCandidates {
+			rbft.logger.Debugf("Replica %d ignore decryption share from replica %d as too many candidates",
+				rbft.chainConfig.SelfID, share.ReplicaId)
+			return nil
+		}
+		candidates[share.ReplicaId] = append(candidates[share.ReplicaId], share.Share)
+		return nil
+	}
+
+	txs, err := rbft.encryptedTxs(batch)
+	if err == nil {
+		err = rbft.config.ThresholdDecrypter.VerifyDecryptionShare(n, d, txs, share.ReplicaId, share.Share)
+	}
+	if err != nil {
+		rbft.logger.Warningf("Replica %d received invalid decryption share of seqNo=%d/digest=%s from replica %d: %s",
+			rbft.chainConfig.SelfID, n, d, share.ReplicaId, err)
+		return nil
+	}
+	shares[share.ReplicaId] = share.Share
+
+	// try to execute the batch waiting for decryption.
+	if n == rbft.exec.lastExec+1 && len(shares) >= rbft.chainConfig.F+1 {
+		rbft.commitPendingBlocks()
+	}
+	return nil
+}
+
+// cleanDecryptionShares cleans decryption shares and decrypted batches with seqNo not larger than h.
+func (rbft *rbftImpl[T, Constraint]) cleanDecryptionShares(h uint64) {
+	for idx := range rbft.storeMgr.decryptionShares {
+		if idx.n <= h {
+			delete(rbft.storeMgr.decryptionShares, idx)
+			delete(rbft.storeMgr.unverifiedDecryptionShares, idx)
+			delete(rbft.storeMgr.decryptedBatches, idx.d)
+		}
+	}
+}
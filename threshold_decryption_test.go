@@ -0,0 +1,176 @@
+package rbft
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+)
+
+// testThresholdDecrypter "decrypts" a tx by prefixing its value, the share of a validator is derived
+// from its ID and the batch digest.
+type testThresholdDecrypter struct {
+	id uint64
+}
+
+func testDecryptionShare(id uint64, batchDigest string) []byte {
+	return []byte(fmt.Sprintf("share-%d-%s", id, batchDigest))
+}
+
+func (d *testThresholdDecrypter) DecryptionShare(_ uint64, batchDigest string, _ [][]byte) ([]byte, error) {
+	return testDecryptionShare(d.id, batchDigest), nil
+}
+
+func (d *testThresholdDecrypter) VerifyDecryptionShare(_ uint64, batchDigest string, _ [][]byte, nodeID uint64, share []byte) error {
+	if !bytes.Equal(share, testDecryptionShare(nodeID, batchDigest)) {
+		return errors.New("invalid share")
+	}
+	return nil
+}
+
+func (d *testThresholdDecrypter) Combine(_ uint64, _ string, txs [][]byte, _ map[uint64][]byte) ([][]byte, error) {
+	res := make([][]byte, len(txs))
+	for i, raw := range txs {
+		tx := &consensus.FltTransaction{}
+		if err := tx.UnmarshalVT(raw); err != nil {
+			return nil, err
+		}
+		tx.Value = append([]byte("decrypted-"), tx.Value...)
+		decrypted, err := tx.MarshalVTStrict()
+		if err != nil {
+			return nil, err
+		}
+		res[i] = decrypted
+	}
+	return res, nil
+}
+
+func TestThresholdDecryption_ExecuteDecryptedBatch(t *testing.T) {
+	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	for _, r := range rbfts {
+		r.config.ThresholdDecrypter = &testThresholdDecrypter{id: r.chainConfig.SelfID}
+	}
+
+	tx := newTx()
+	for _, r := range rbfts {
+		_ = r.batchMgr.requestPool.AddLocalTx(tx)
+	}
+	rbfts[0].processEvent(&LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreBatchTimerEvent,
+	})
+	prePrep := nodes[0].broadcastMessageCache
+	assert.Equal(t, consensus.Type_PRE_PREPARE, prePrep.Type)
+
+	prepares := make([]*consensusMessageWrapper, 4)
+	for i := 1; i < 4; i++ {
+		rbfts[i].processEvent(prePrep)
+		prepares[i] = nodes[i].broadcastMessageCache
+		assert.Equal(t, consensus.Type_PREPARE, prepares[i].Type)
+	}
+
+	// every node collects a quorum of prepares and broadcasts commit.
+	commits := make([]*consensusMessageWrapper, 4)
+	for i := 0; i < 4; i++ {
+		for j := 1; j < 4 && nodes[i].broadcastMessageCache.Type != consensus.Type_COMMIT; j++ {
+			if j != i {
+				rbfts[i].processEvent(prepares[j])
+			}
+		}
+		commits[i] = nodes[i].broadcastMessageCache
+		assert.Equal(t, consensus.Type_COMMIT, commits[i].Type)
+	}
+
+	// every node commits the batch, but waits for decryption shares before executing.
+	shares := make([]*consensusMessageWrapper, 4)
+	for i := 0; i < 4; i++ {
+		rbfts[i].processEvent(commits[(i+1)%4])
+		rbfts[i].processEvent(commits[(i+2)%4])
+		shares[i] = nodes[i].broadcastMessageCache
+		assert.Equal(t, consensus.Type_DECRYPTION_SHARE, shares[i].Type)
+		assert.Equal(t, "", nodes[i].blocks[1])
+		assert.Equal(t, uint64(0), rbfts[i].exec.lastExec)
+	}
+
+	// share claimed to be of another replica is dropped.
+	invalid := &consensus.DecryptionShare{}
+	assert.Nil(t, invalid.UnmarshalVT(shares[2].Payload))
+	invalid.ReplicaId = 2
+	invalidMsg := shares[2].CloneVT()
+	invalidMsg.Payload, _ = invalid.MarshalVTStrict()
+	rbfts[0].processEvent(&consensusMessageWrapper{ConsensusMessage: invalidMsg})
+	assert.Equal(t, "", nodes[0].blocks[1])
+
+	decrypted := tx.CloneVT()
+	decrypted.Value = append([]byte("decrypted-"), decrypted.Value...)
+	for i := 0; i < 4; i++ {
+		rbfts[i].processEvent(shares[(i+1)%4])
+		ts := rbfts[i].exec.lastExecTimestamp
+		assert.Equal(t, calculateMD5Hash([]string{decrypted.RbftGetTxHash()}, ts), nodes[i].blocks[1])
+		assert.Equal(t, 0, len(rbfts[i].storeMgr.decryptionShares))
+		assert.Equal(t, 0, len(rbfts[i].storeMgr.decryptedBatches))
+	}
+}
+
+func TestThresholdDecryption_shareCandidates(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	rbft := rbfts[0]
+	rbft.config.ThresholdDecrypter = &testThresholdDecrypter{id: rbft.chainConfig.SelfID}
+
+	// shares of unknown batch are kept as candidates, so an invalid one cannot occupy the place of the valid one.
+	d := "digest"
+	idx := decryptionShareIdx(1, d)
+	rbft.recvDecryptionShare(&consensus.DecryptionShare{ReplicaId: 2, SequenceNumber: 1, BatchDigest: d, Share: []byte("invalid")})
+	rbft.recvDecryptionShare(&consensus.DecryptionShare{ReplicaId: 2, SequenceNumber: 1, BatchDigest: d, Share: testDecryptionShare(2, d)})
+	assert.Equal(t, 2, len(rbft.storeMgr.unverifiedDecryptionShares[idx][2]))
+	assert.Equal(t, 0, len(rbft.storeMgr.decryptionShares[idx]))
+
+	rbft.storeMgr.batchStore[d] = &RequestBatch[consensus.FltTransaction, *consensus.FltTransaction]{BatchHash: d}
+	shares := rbft.storeMgr.decryptionShares[idx]
+	rbft.verifyDecryptionShareCandidates(1, d, nil, shares)
+	assert.Equal(t, testDecryptionShare(2, d), shares[2])
+	assert.Nil(t, rbft.storeMgr.unverifiedDecryptionShares[idx])
+
+	// share of known batch is verified directly.
+	rbft.recvDecryptionShare(&consensus.DecryptionShare{ReplicaId: 3, SequenceNumber: 1, BatchDigest: d, Share: []byte("invalid")})
+	assert.Nil(t, shares[3])
+	rbft.recvDecryptionShare(&consensus.DecryptionShare{ReplicaId: 3, SequenceNumber: 1, BatchDigest: d, Share: testDecryptionShare(3, d)})
+	assert.Equal(t, testDecryptionShare(3, d), shares[3])
+}
+
+func TestThresholdDecryption_resendShares(t *testing.T) {
+	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	rbft := rbfts[0]
+	rbft.config.ThresholdDecrypter = &testThresholdDecrypter{id: rbft.chainConfig.SelfID}
+
+	// share is released once a batch is committed, even though previous batches have not been executed.
+	d := "digest"
+	idx := msgID{v: 0, n: 2, d: d}
+	rbft.storeMgr.batchStore[d] = &RequestBatch[consensus.FltTransaction, *consensus.FltTransaction]{BatchHash: d}
+	rbft.commitCert(idx)
+	assert.Equal(t, consensus.Type_DECRYPTION_SHARE, nodes[0].broadcastMessageCache.Type)
+	assert.Equal(t, testDecryptionShare(1, d), rbft.storeMgr.decryptionShares[decryptionShareIdx(2, d)][1])
+	assert.Equal(t, 1, rbft.timerMgr.tTimers[decryptionShareTimer].count())
+
+	// share is resent while the batch is waiting for decryption.
+	nodes[0].broadcastMessageCache = nil
+	rbft.handleDecryptionShareTimerEvent()
+	assert.Equal(t, consensus.Type_DECRYPTION_SHARE, nodes[0].broadcastMessageCache.Type)
+	share := &consensus.DecryptionShare{}
+	assert.Nil(t, share.UnmarshalVT(nodes[0].broadcastMessageCache.Payload))
+	assert.Equal(t, uint64(2), share.SequenceNumber)
+	assert.Equal(t, testDecryptionShare(1, d), share.Share)
+	assert.Equal(t, 1, rbft.timerMgr.tTimers[decryptionShareTimer].count())
+
+	// timer stops once the batch is decrypted.
+	nodes[0].broadcastMessageCache = nil
+	rbft.storeMgr.decryptedBatches[d] = nil
+	rbft.handleDecryptionShareTimerEvent()
+	assert.Nil(t, nodes[0].broadcastMessageCache)
+	assert.Equal(t, 0, rbft.timerMgr.tTimers[decryptionShareTimer].count())
+}
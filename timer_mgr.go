@@ -109,6 +109,16 @@ func (tm *timerManager) newTimer(name string, d time.Duration) {
 			d = DefaultFetchCheckpointTimeout
 		case fetchViewTimer:
 			d = DefaultFetchViewTimeout
+		case fastPathTimer:
+			d = DefaultFastPathTimeout
+		case hotstuffViewTimer:
+			d = DefaultHotStuffViewTimeout
+		case fetchTimer:
+			d = DefaultFetchTimeout
+		case snapshotSyncTimer:
+			d = DefaultSnapshotSyncTimeout
+		case decryptionShareTimer:
+			d = DefaultDecryptionShareTimeout
 		}
 	}
 
@@ -222,6 +232,11 @@ func (rbft *rbftImpl[T, Constraint]) initTimers() {
 	rbft.timerMgr.newTimer(checkPoolTimer, rbft.config.CheckPoolTimeout)
 	rbft.timerMgr.newTimer(fetchCheckpointTimer, rbft.config.FetchCheckpointTimeout)
 	rbft.timerMgr.newTimer(fetchViewTimer, rbft.config.FetchViewTimeout)
+	rbft.timerMgr.newTimer(fastPathTimer, rbft.config.FastPathTimeout)
+	rbft.timerMgr.newTimer(fetchTimer, rbft.config.FetchTimeout)
+	rbft.timerMgr.newTimer(snapshotSyncTimer, rbft.config.SnapshotSyncTimeout)
+	rbft.timerMgr.newTimer(stateUpdateTimer, rbft.config.StateUpdateTimeout)
+	rbft.timerMgr.newTimer(decryptionShareTimer, rbft.config.DecryptionShareTimeout)
 
 	rbft.timerMgr.makeNullRequestTimeoutLegal()
 	rbft.timerMgr.makeRequestTimeoutLegal()
@@ -366,3 +381,27 @@ func (rbft *rbftImpl[T, Constraint]) stopFetchViewTimer() {
 	rbft.logger.Debugf("Replica %d stop a running fetchView timer", rbft.chainConfig.SelfID)
 	rbft.timerMgr.stopTimer(fetchViewTimer)
 }
+
+// startFastPathTimer starts a fast path timer for the given batch, fall back to commit phase
+// if we cannot receive prepares from all replicas before timeout.
+func (rbft *rbftImpl[T, Constraint]) startFastPathTimer(idx msgID, cert *msgCert) {
+	if cert.fastPathTimerKey != "" {
+		return
+	}
+	rbft.logger.Debugf("Replica %d start a fast path timer for view=%d/seqNo=%d", rbft.chainConfig.SelfID, idx.v, idx.n)
+	event := &LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreFastPathTimerEvent,
+		Event:     idx,
+	}
+	cert.fastPathTimerKey = rbft.timerMgr.createTimer(fastPathTimer, rbft.timerMgr.getTimeoutValue(fastPathTimer), event)
+}
+
+// stopFastPathTimer stops the running fast path timer of the given batch.
+func (rbft *rbftImpl[T, Constraint]) stopFastPathTimer(cert *msgCert) {
+	if cert.fastPathTimerKey == "" {
+		return
+	}
+	rbft.timerMgr.stopOneTimer(fastPathTimer, cert.fastPathTimerKey)
+	cert.fastPathTimerKey = ""
+}
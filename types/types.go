@@ -32,6 +32,23 @@ type ServiceSyncState struct {
 	EpochChanged bool
 }
 
+// StateUpdateProgress indicates the progress of an ongoing state update.
+type StateUpdateProgress struct {
+	// RequestID is the id of the state update request passed to StateUpdateTracker, progress without it
+	// is matched by TargetHeight only if StateUpdateTracker is not implemented.
+	RequestID uint64
+
+	// TargetHeight is the height of the state update target.
+	TargetHeight uint64
+
+	// Synced and Total are the amount of synced and total work, such as blocks or snapshot chunks.
+	Synced uint64
+	Total  uint64
+
+	// Err is the error failed the state update, nil if it's still in progress.
+	Err error
+}
+
 // MetaState is the basic info for block.
 type MetaState struct {
 	Height      uint64
@@ -43,6 +60,8 @@ type BlockMeta struct {
 	ProcessorNodeID uint64
 	BlockNum        uint64
 	BlockHash       string
+	// Timestamp is the timestamp the block was executed with, 0 for empty blocks.
+	Timestamp int64
 }
 
 // CheckpointState is the basic info for checkpoint.
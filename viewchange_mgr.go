@@ -65,6 +65,13 @@ type vcManager struct {
 
 	continuousNullRequestCounter uint64
 	lastNullRequestSeqNo         uint64
+
+	// leaderTransferring indicates current primary is handing over its leadership,
+	// primary won't propose any new batch in this status.
+	leaderTransferring bool
+	// leaderTransferView is the target view of the latest accepted leader transfer,
+	// the old primary won't be punished when we move to this view.
+	leaderTransferView uint64
 }
 
 // quorumViewChangeCache is the cache of a QuorumViewChange message.
@@ -111,6 +118,8 @@ func (rbft *rbftImpl[T, Constraint]) dispatchViewChangeMsg(e consensusEvent) con
 		return rbft.recvRecoveryResponse(et)
 	case *consensus.QuorumViewChange:
 		return rbft.recvQuorumViewChange(et)
+	case *consensus.LeaderTransfer:
+		return rbft.recvLeaderTransfer(et)
 	}
 	return nil
 }
@@ -150,6 +159,13 @@ func (rbft *rbftImpl[T, Constraint]) punishAbnormalNodes(isTemp bool, isRecovery
 		if !isTemp {
 			rbft.logger.Warningf("Replica %d reduce the voting power of abnormal node %d, view %d", rbft.chainConfig.SelfID, abnormalNodeID, v)
 		}
+		if rbft.chainConfig.isProposerElectionTypeReputation() {
+			// decay the timeout penalty of all nodes in each round, and penalize the abnormal node
+			for _, info := range validatorDynamicInfoMap {
+				info.ReputationPenalty = info.ReputationPenalty * reputationPenaltyDecayPercentage / 100
+			}
+			validatorDynamicInfoMap[abnormalNodeID].ReputationPenalty += reputationTimeoutPenalty
+		}
 		validatorDynamicInfoMap[abnormalNodeID].ConsensusVotingPowerReduced = true
 		validatorDynamicInfoMap[abnormalNodeID].ConsensusVotingPowerReduceView = v
 		validatorDynamicInfoMap[abnormalNodeID].ConsensusVotingPower = rbft.chainConfig.EpochInfo.ConsensusParams.NotActiveWeight
@@ -190,6 +206,7 @@ func (rbft *rbftImpl[T, Constraint]) setView(view uint64) {
 				ConsensusVotingPower:           v.ConsensusVotingPower,
 				ConsensusVotingPowerReduced:    v.ConsensusVotingPowerReduced,
 				ConsensusVotingPowerReduceView: v.ConsensusVotingPowerReduceView,
+				ReputationPenalty:              v.ReputationPenalty,
 			}
 		})
 		rbft.punishAbnormalNodes(false, false, view, unstableValidatorDynamicInfoMap)
@@ -211,6 +228,7 @@ func (rbft *rbftImpl[T, Constraint]) setViewWithRecovery(view uint64) {
 				ConsensusVotingPower:           v.ConsensusVotingPower,
 				ConsensusVotingPowerReduced:    v.ConsensusVotingPowerReduced,
 				ConsensusVotingPowerReduceView: v.ConsensusVotingPowerReduceView,
+				ReputationPenalty:              v.ReputationPenalty,
 			}
 		})
 		rbft.punishAbnormalNodes(false, true, view, unstableValidatorDynamicInfoMap)
@@ -232,6 +250,7 @@ func (rbft *rbftImpl[T, Constraint]) getUnstableValidatorDynamicInfoMap(view uin
 			ConsensusVotingPower:           v.ConsensusVotingPower,
 			ConsensusVotingPowerReduced:    v.ConsensusVotingPowerReduced,
 			ConsensusVotingPowerReduceView: v.ConsensusVotingPowerReduceView,
+			ReputationPenalty:              v.ReputationPenalty,
 		}
 	})
 	info := lo.MapToSlice(unstableValidatorDynamicInfoMap, func(id uint64, v *ValidatorInfo) ValidatorInfo {
@@ -240,6 +259,7 @@ func (rbft *rbftImpl[T, Constraint]) getUnstableValidatorDynamicInfoMap(view uin
 			ConsensusVotingPower:           v.ConsensusVotingPower,
 			ConsensusVotingPowerReduced:    v.ConsensusVotingPowerReduced,
 			ConsensusVotingPowerReduceView: v.ConsensusVotingPowerReduceView,
+			ReputationPenalty:              v.ReputationPenalty,
 		}
 	})
 	sort.Slice(info, func(i, j int) bool {
@@ -253,6 +273,7 @@ func (rbft *rbftImpl[T, Constraint]) getUnstableValidatorDynamicInfoMap(view uin
 			ConsensusVotingPower:           item.ConsensusVotingPower,
 			ConsensusVotingPowerReduced:    item.ConsensusVotingPowerReduced,
 			ConsensusVotingPowerReduceView: item.ConsensusVotingPowerReduceView,
+			ReputationPenalty:              item.ReputationPenalty,
 		}
 	})
 	sort.Slice(res, func(i, j int) bool {
@@ -324,8 +345,9 @@ func (rbft *rbftImpl[T, Constraint]) sendViewChange(status ...bool) consensusEve
 	// create viewChange message
 	vcBasis := rbft.getVcBasis()
 	vc := &consensus.ViewChange{
-		Basis:    vcBasis,
-		Recovery: recovery,
+		Basis:          vcBasis,
+		Recovery:       recovery,
+		LeaderTransfer: !recovery && vcBasis.GetView() == rbft.vcMgr.leaderTransferView,
 	}
 	sig, sErr := rbft.signViewChange(vc)
 	if sErr != nil {
@@ -525,6 +547,9 @@ func (rbft *rbftImpl[T, Constraint]) recvViewChange(vc *consensus.ViewChange, ve
 			if rbft.isPrimary(remoteReplicaID) {
 				rbft.logger.Infof("Replica %d received viewChange from old primary %d for view %d, "+
 					"trigger viewChange.", rbft.chainConfig.SelfID, remoteReplicaID, targetView)
+				if vc.LeaderTransfer && targetView == rbft.chainConfig.View+1 {
+					rbft.vcMgr.leaderTransferView = targetView
+				}
 				return rbft.sendViewChange()
 			}
 		} else {
@@ -532,6 +557,9 @@ func (rbft *rbftImpl[T, Constraint]) recvViewChange(vc *consensus.ViewChange, ve
 			if rbft.isPrimary(remoteReplicaID) {
 				rbft.logger.Infof("Replica %d received viewChange from old primary %d for view %d, "+
 					"trigger viewChange.", rbft.chainConfig.SelfID, remoteReplicaID, targetView)
+				if vc.LeaderTransfer && targetView == rbft.chainConfig.View+1 {
+					rbft.vcMgr.leaderTransferView = targetView
+				}
 				return rbft.sendViewChange()
 			}
 
@@ -548,6 +576,91 @@ func (rbft *rbftImpl[T, Constraint]) recvViewChange(vc *consensus.ViewChange, ve
 	return nil
 }
 
+// maybeSendLeaderTransfer broadcasts LeaderTransfer and starts a view change once all
+// in-flight batches proposed by current primary have been committed.
+func (rbft *rbftImpl[T, Constraint]) maybeSendLeaderTransfer() consensusEvent {
+	if !rbft.vcMgr.leaderTransferring {
+		return nil
+	}
+	if !rbft.isPrimary(rbft.chainConfig.SelfID) || !rbft.isNormal() {
+		rbft.logger.Infof("Replica %d is no longer a normal primary, stop leader transfer", rbft.chainConfig.SelfID)
+		rbft.vcMgr.leaderTransferring = false
+		return nil
+	}
+	if len(rbft.storeMgr.outstandingReqBatches) != 0 {
+		rbft.logger.Debugf("Replica %d wait for %d outstanding batches before leader transfer",
+			rbft.chainConfig.SelfID, len(rbft.storeMgr.outstandingReqBatches))
+		return nil
+	}
+
+	lt := &consensus.LeaderTransfer{
+		ReplicaId:      rbft.chainConfig.SelfID,
+		View:           rbft.chainConfig.View,
+		SequenceNumber: rbft.exec.lastExec,
+	}
+	sig, sErr := rbft.signLeaderTransfer(lt)
+	if sErr != nil {
+		rbft.logger.Warningf("Replica %d sign leader transfer failed: %s", rbft.chainConfig.SelfID, sErr)
+		rbft.vcMgr.leaderTransferring = false
+		return nil
+	}
+	lt.Signature = sig
+
+	payload, err := lt.MarshalVTStrict()
+	if err != nil {
+		rbft.logger.Errorf("ConsensusMessage_LEADER_TRANSFER Marshal Error: %s", err)
+		rbft.vcMgr.leaderTransferring = false
+		return nil
+	}
+	consensusMsg := &consensus.ConsensusMessage{
+		Type:    consensus.Type_LEADER_TRANSFER,
+		Payload: payload,
+	}
+	rbft.logger.Noticef("Replica %d broadcast leader transfer, view=%d/seqNo=%d", rbft.chainConfig.SelfID,
+		lt.View, lt.SequenceNumber)
+	rbft.peerMgr.broadcast(context.TODO(), consensusMsg)
+
+	rbft.vcMgr.leaderTransferView = rbft.chainConfig.View + 1
+	return rbft.sendViewChange()
+}
+
+// recvLeaderTransfer processes LeaderTransfer from current primary and moves to the next
+// view immediately without punishing the old primary.
+func (rbft *rbftImpl[T, Constraint]) recvLeaderTransfer(lt *consensus.LeaderTransfer) consensusEvent {
+	rbft.logger.Infof("Replica %d received leader transfer from replica %d, view=%d/seqNo=%d",
+		rbft.chainConfig.SelfID, lt.ReplicaId, lt.View, lt.SequenceNumber)
+
+	if lt.View != rbft.chainConfig.View {
+		rbft.logger.Warningf("Replica %d reject leader transfer for view %d, current view %d",
+			rbft.chainConfig.SelfID, lt.View, rbft.chainConfig.View)
+		return nil
+	}
+	if !rbft.isPrimary(lt.ReplicaId) {
+		rbft.logger.Warningf("Replica %d reject leader transfer from non-primary replica %d",
+			rbft.chainConfig.SelfID, lt.ReplicaId)
+		return nil
+	}
+	if !rbft.isNormal() {
+		rbft.logger.Debugf("Replica %d is in abnormal, ignore leader transfer", rbft.chainConfig.SelfID)
+		return nil
+	}
+	if err := rbft.verifyLeaderTransfer(lt); err != nil {
+		rbft.logger.Errorf("Replica %d found invalid leader transfer, error: %s", rbft.chainConfig.SelfID, err)
+		return nil
+	}
+	// primary must have executed all its proposals in this view before transferring leadership.
+	for idx, cert := range rbft.storeMgr.certStore {
+		if idx.v == lt.View && idx.n > lt.SequenceNumber && cert.prePrepare != nil {
+			rbft.logger.Warningf("Replica %d reject leader transfer with seqNo %d, primary has proposed seqNo %d",
+				rbft.chainConfig.SelfID, lt.SequenceNumber, idx.n)
+			return nil
+		}
+	}
+
+	rbft.vcMgr.leaderTransferView = lt.View + 1
+	return rbft.sendViewChange()
+}
+
 func (rbft *rbftImpl[T, Constraint]) recvQuorumViewChange(qvc *consensus.QuorumViewChange) consensusEvent {
 	if len(qvc.ViewChanges) == 0 {
 		return nil
@@ -1231,6 +1344,13 @@ func (rbft *rbftImpl[T, Constraint]) fetchRequestBatches() {
 			Payload: payload,
 		}
 		rbft.metrics.fetchRequestBatchCounter.Add(float64(1))
+		if rbft.fetcher.enabled() {
+			rbft.startFetch(&fetchTask{
+				key: fetchKey{typ: fetchTypeBatch, digest: digest},
+				msg: consensusMsg,
+			})
+			continue
+		}
 		rbft.peerMgr.broadcast(context.TODO(), consensusMsg)
 	}
 }
@@ -1285,7 +1405,8 @@ func (rbft *rbftImpl[T, Constraint]) recvFetchBatchResponse(batch *consensus.Fet
 		rbft.chainConfig.SelfID, batch.ReplicaId, batch.BatchDigest)
 
 	digest := batch.BatchDigest
-	if _, ok := rbft.storeMgr.missingReqBatches[digest]; !ok {
+	n, ok := rbft.storeMgr.missingReqBatches[digest]
+	if !ok {
 		rbft.logger.Debugf("Replica %d received missing request: %s, but we don't miss this request, ignore it",
 			rbft.chainConfig.SelfID, digest)
 		return nil // either the wrong digest, or we got it already from someone else
@@ -1296,9 +1417,41 @@ func (rbft *rbftImpl[T, Constraint]) recvFetchBatchResponse(batch *consensus.Fet
 		rbft.logger.Errorf("RequestBatch unmarshal Error: %s", err)
 		return nil
 	}
+	// with multi-peer fetcher, the batch is only accepted from peers asked, and verified against the
+	// known digest.
+	task := rbft.fetcher.get(fetchTypeBatch, digest)
+	if task != nil {
+		if _, ok = task.asked[batch.ReplicaId]; !ok {
+			rbft.logger.Warningf("Replica %d received request batch %s from replica %d which is not asked, ignore it",
+				rbft.chainConfig.SelfID, digest, batch.ReplicaId)
+			return nil
+		}
+		if err := verifyFetchedBatch(digest, receiveBatch); err != nil {
+			rbft.rejectFetchResponse(task, batch.ReplicaId, err)
+			return nil
+		}
+	}
+	// the VRF proof is covered by the batch digest, but the seqNo it's proved for isn't, so verify it
+	// against the assigned seqNo.
+	if rbft.isVRFSeedEnabled() {
+		if err := rbft.verifyBatchVRF(n, receiveBatch); err != nil {
+			if task != nil {
+				rbft.rejectFetchResponse(task, batch.ReplicaId, err)
+			} else {
+				rbft.logger.Warningf("Replica %d received request batch %s with invalid vrf proof from replica %d: %s",
+					rbft.chainConfig.SelfID, digest, batch.ReplicaId, err)
+			}
+			return nil
+		}
+	}
+	if task != nil {
+		rbft.finishFetch(task)
+	}
+	if _, ok = rbft.storeMgr.batchStore[digest]; !ok {
+		rbft.metrics.batchesGauge.Add(float64(1))
+	}
 	rbft.storeMgr.batchStore[digest] = receiveBatch
 	rbft.persistBatch(digest)
-	rbft.metrics.batchesGauge.Add(float64(1))
 
 	// delete missingReqBatches in this batch
 	delete(rbft.storeMgr.missingReqBatches, digest)
@@ -1377,7 +1530,10 @@ func (rbft *rbftImpl[T, Constraint]) beforeSendVC(recovery bool) error {
 	rbft.metrics.statusGaugeInConfChange.Set(0)
 
 	newView := rbft.chainConfig.View + uint64(1)
-	if recovery {
+	// leader transfer is finished once we start a view change.
+	rbft.vcMgr.leaderTransferring = false
+	// old primary has handed over its leadership gracefully, don't punish it.
+	if recovery || newView == rbft.vcMgr.leaderTransferView {
 		rbft.setViewWithRecovery(newView)
 	} else {
 		rbft.setView(newView)
@@ -1504,7 +1660,7 @@ func (rbft *rbftImpl[T, Constraint]) selectValidatorDynamicInfo(viewChanges []*c
 			notRecoverValidatorDynamicInfoRecord[hash] = notRecoverValidatorDynamicInfo
 		}
 
-		if vc.Recovery {
+		if vc.Recovery || vc.LeaderTransfer {
 			recoverCount++
 		} else {
 			notRecoverCount++
@@ -1720,6 +1876,17 @@ nLoop:
 		}
 
 		if quorum >= rbft.commonCaseQuorum() {
+			// a batch committed by fast path may be prepared by none of the view changes in S, but it
+			// must have been pre-prepared by all correct replicas.
+			if rbft.chainConfig.isFastPathEnabled() {
+				if d, ok := rbft.selectFastPathBatch(set, n); ok {
+					msgMap[n] = d
+					maxN = n
+
+					continue nLoop
+				}
+			}
+
 			// "then select the null request for number n"
 			msgMap[n] = ""
 
@@ -1753,6 +1920,47 @@ nLoop:
 	return msgList
 }
 
+// selectFastPathBatch selects the batch for number n which may have been committed by fast path in a
+// view where no view change in set has a prepared entry for n. A fast committed batch has been
+// pre-prepared by all correct replicas, so it's in m.Q of at least f+1 messages m ∈ set with m.h < n.
+// Among the batches with f+1 such messages, the one of the highest view is selected, as a batch of
+// lower view cannot be committed if another batch has been pre-prepared by a correct replica in a
+// higher view.
+func (rbft *rbftImpl[T, Constraint]) selectFastPathBatch(set []*consensus.VcBasis, n uint64) (string, bool) {
+	var (
+		selected     string
+		selectedView uint64
+		found        bool
+	)
+	for _, m := range set {
+		for _, em := range m.GetQset() {
+			if em.SequenceNumber != n || em.BatchDigest == "" {
+				continue
+			}
+			if found && em.View <= selectedView {
+				continue
+			}
+			quorum := 0
+			for _, mp := range set {
+				if mp.GetH() >= n {
+					continue
+				}
+				for _, emp := range mp.GetQset() {
+					if emp.SequenceNumber == n && emp.BatchDigest == em.BatchDigest && emp.View >= em.View {
+						quorum++
+						break
+					}
+				}
+			}
+			if quorum < rbft.oneCorrectQuorum() {
+				continue
+			}
+			selected, selectedView, found = em.BatchDigest, em.View, true
+		}
+	}
+	return selected, found
+}
+
 // updateViewChangeSeqNo updates viewChangeSeqNo by viewChangePeriod
 func (rbft *rbftImpl[T, Constraint]) updateViewChangeSeqNo(seqNo, K uint64) {
 	if rbft.vcMgr.viewChangePeriod <= 0 {
@@ -1766,7 +1974,7 @@ func (rbft *rbftImpl[T, Constraint]) updateViewChangeSeqNo(seqNo, K uint64) {
 // doesn't have all reqBatch in xset.
 func (rbft *rbftImpl[T, Constraint]) checkIfNeedFetchMissingReqBatch(xset []*consensus.VcPq) (newReqBatchMissing bool) {
 	// clear missingReqBatches to ensure it's only valid in one recovery round.
-	rbft.storeMgr.missingReqBatches = make(map[string]bool)
+	rbft.storeMgr.missingReqBatches = make(map[string]uint64)
 	newReqBatchMissing = false
 	for _, msg := range xset {
 		n := msg.SequenceNumber
@@ -1781,13 +1989,23 @@ func (rbft *rbftImpl[T, Constraint]) checkIfNeedFetchMissingReqBatch(xset []*con
 				continue
 			}
 
-			if _, ok := rbft.storeMgr.batchStore[d]; !ok {
+			batch, ok := rbft.storeMgr.batchStore[d]
+			if ok && rbft.isVRFSeedEnabled() {
+				// the seqNo the VRF proof is proved for isn't covered by the batch digest, refetch the batch if
+				// its proof is invalid for the assigned seqNo.
+				if err := rbft.verifyBatchVRF(n, batch); err != nil {
+					rbft.logger.Warningf("Replica %d found invalid vrf proof in request batch %s: %s",
+						rbft.chainConfig.SelfID, d, err)
+					ok = false
+				}
+			}
+			if !ok {
 				rbft.logger.Debugf("Replica %d missing assigned, non-checkpointed request batch %s",
 					rbft.chainConfig.SelfID, d)
 				if _, missing := rbft.storeMgr.missingReqBatches[d]; !missing {
 					rbft.logger.Infof("Replica %v needs to fetch batch %s", rbft.chainConfig.SelfID, d)
 					newReqBatchMissing = true
-					rbft.storeMgr.missingReqBatches[d] = true
+					rbft.storeMgr.missingReqBatches[d] = n
 				}
 			}
 		}
@@ -1847,11 +2065,7 @@ func (rbft *rbftImpl[T, Constraint]) processNewView(msgList []*consensus.VcPq) {
 			}
 
 			// rebuild prePrepare with batch recorded in batchStore
-			prePrep.HashBatch = &consensus.HashBatch{
-				RequestHashList: batch.RequestHashList,
-				Timestamp:       batch.Timestamp,
-				Proposer:        batch.Proposer,
-			}
+			prePrep.HashBatch = batch.hashBatch()
 
 			// re-construct batches by order in xSet to de-duplicate txs during different batches in msgList which
 			// may be caused by different primary puts the same txs into different batches with different seqNo'
@@ -1942,6 +2156,14 @@ func (rbft *rbftImpl[T, Constraint]) processNewView(msgList []*consensus.VcPq) {
 				cert.sentPrepare = true
 				_ = rbft.recvPrepare(context.TODO(), prep)
 			}
+			if rbft.chainConfig.isLinearVoteAggregation() {
+				sig, err := rbft.signPrepare(prep)
+				if err != nil {
+					rbft.logger.Errorf("Replica %d sign prepare failed: %s", rbft.chainConfig.SelfID, err)
+					return
+				}
+				prep.Signature = sig
+			}
 			payload, err := prep.MarshalVTStrict()
 			if err != nil {
 				rbft.logger.Errorf("ConsensusMessage_PREPARE Marshal Error: %s", err)
@@ -1975,6 +2197,14 @@ func (rbft *rbftImpl[T, Constraint]) processNewView(msgList []*consensus.VcPq) {
 			cert.sentCommit = true
 			_ = rbft.recvCommit(context.TODO(), cmt)
 
+			if rbft.chainConfig.isLinearVoteAggregation() {
+				sig, err := rbft.signCommit(cmt)
+				if err != nil {
+					rbft.logger.Errorf("Replica %d sign commit failed: %s", rbft.chainConfig.SelfID, err)
+					return
+				}
+				cmt.Signature = sig
+			}
 			payload, err := cmt.MarshalVTStrict()
 			if err != nil {
 				rbft.logger.Errorf("ConsensusMessage_COMMIT Marshal Error: %s", err)
@@ -1997,6 +2227,7 @@ func showSimpleValidatorDynamicInfo(v []*consensus.NodeDynamicInfo) []ValidatorI
 			ConsensusVotingPower:           item.ConsensusVotingPower,
 			ConsensusVotingPowerReduced:    item.ConsensusVotingPowerReduced,
 			ConsensusVotingPowerReduceView: item.ConsensusVotingPowerReduceView,
+			ReputationPenalty:              item.ReputationPenalty,
 		}
 	})
 }
@@ -3,9 +3,11 @@ package rbft
 import (
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-bft/types"
 	"github.com/axiomesh/axiom-kit/txpool"
 )
 
@@ -119,7 +121,7 @@ func TestVC_fetchRequestBatches(t *testing.T) {
 	// init recovery to stable view 1, primary is node2
 	clusterInitRecovery(t, nodes, rbfts, -1)
 
-	rbfts[2].storeMgr.missingReqBatches["lost-batch"] = true
+	rbfts[2].storeMgr.missingReqBatches["lost-batch"] = 1
 	rbfts[2].fetchRequestBatches()
 	assert.Equal(t, consensus.Type_FETCH_BATCH_REQUEST, nodes[2].broadcastMessageCache.Type)
 }
@@ -130,7 +132,7 @@ func TestVC_recvFetchRequestBatch(t *testing.T) {
 	// init recovery to stable view 1, primary is node2
 	clusterInitRecovery(t, nodes, rbfts, -1)
 
-	rbfts[2].storeMgr.missingReqBatches["lost-batch"] = true
+	rbfts[2].storeMgr.missingReqBatches["lost-batch"] = 1
 	rbfts[2].fetchRequestBatches()
 	fr := nodes[2].broadcastMessageCache
 	assert.Equal(t, consensus.Type_FETCH_BATCH_REQUEST, fr.Type)
@@ -201,20 +203,20 @@ func TestVC_recvFetchBatchResponse_AfterViewChanged(t *testing.T) {
 	}
 
 	// the request batch is not the one we want
-	rbfts[0].storeMgr.missingReqBatches["lost-batch-another"] = true
+	rbfts[0].storeMgr.missingReqBatches["lost-batch-another"] = 1
 	rbfts[0].recvFetchBatchResponse(sr)
-	assert.Equal(t, true, rbfts[0].storeMgr.missingReqBatches["lost-batch-another"])
+	assert.Contains(t, rbfts[0].storeMgr.missingReqBatches, "lost-batch-another")
 	delete(rbfts[0].storeMgr.missingReqBatches, "lost-batch-another")
 
 	// recv the request batch we want
-	rbfts[0].storeMgr.missingReqBatches["lost-batch"] = true
+	rbfts[0].storeMgr.missingReqBatches["lost-batch"] = 1
 	rbfts[0].atomicOn(InViewChange)
 	ret3 := rbfts[0].recvFetchBatchResponse(sr)
 	exp3 := &LocalEvent{
 		Service:   ViewChangeService,
 		EventType: ViewChangeDoneEvent,
 	}
-	assert.Equal(t, false, rbfts[0].storeMgr.missingReqBatches["lost-batch"])
+	assert.NotContains(t, rbfts[0].storeMgr.missingReqBatches, "lost-batch")
 	assert.Equal(t, exp3, ret3)
 }
 
@@ -440,3 +442,166 @@ func TestVC_assignSequenceNumbers(t *testing.T) {
 
 	assert.EqualValues(t, expectList, list)
 }
+
+func TestVC_assignSequenceNumbersWithFastPath(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+
+	// batch 5 was committed by fast path in view 1 by a replica out of the set, the others have
+	// pre-prepared it without a prepared certificate.
+	preprepared := &consensus.VcBasis{
+		ReplicaId: 2,
+		View:      2,
+		H:         4,
+		Qset: []*consensus.VcPq{
+			{SequenceNumber: 5, BatchDigest: "msg-old", View: 0},
+			{SequenceNumber: 5, BatchDigest: "msg", View: 1},
+		},
+	}
+	empty := &consensus.VcBasis{ReplicaId: 4, View: 2, H: 4}
+	set := []*consensus.VcBasis{preprepared, preprepared, empty}
+
+	// the batch is dropped without fast path.
+	assert.Empty(t, rbfts[1].assignSequenceNumbers(set, 4))
+
+	rbfts[1].chainConfig.FastPath = true
+	expectList := []*consensus.VcPq{{SequenceNumber: 5, BatchDigest: "msg"}}
+	assert.EqualValues(t, expectList, rbfts[1].assignSequenceNumbers(set, 4))
+
+	// batch pre-prepared by less than f+1 view changes cannot be committed by fast path.
+	set = []*consensus.VcBasis{preprepared, empty, empty}
+	assert.Empty(t, rbfts[1].assignSequenceNumbers(set, 4))
+}
+
+func TestVC_TransferLeadership(t *testing.T) {
+	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	// init recovery to stable view 1, primary is node2
+	clusterInitRecovery(t, nodes, rbfts, -1)
+
+	// only primary can transfer leadership.
+	req := &ReqTransferLeadershipMsg{ch: make(chan error, 1)}
+	rbfts[2].handleReqTransferLeadershipEvent(req)
+	assert.NotNil(t, <-req.ch)
+
+	// primary waits for outstanding batches before leader transfer.
+	rbfts[1].storeMgr.outstandingReqBatches["digest"] = &RequestBatch[consensus.FltTransaction, *consensus.FltTransaction]{}
+	req = &ReqTransferLeadershipMsg{ch: make(chan error, 1)}
+	rbfts[1].handleReqTransferLeadershipEvent(req)
+	assert.Nil(t, <-req.ch)
+	assert.True(t, rbfts[1].vcMgr.leaderTransferring)
+	assert.False(t, rbfts[1].inPrimaryTerm())
+	assert.Equal(t, uint64(1), rbfts[1].chainConfig.View)
+
+	// duplicate request is rejected.
+	req = &ReqTransferLeadershipMsg{ch: make(chan error, 1)}
+	rbfts[1].handleReqTransferLeadershipEvent(req)
+	assert.NotNil(t, <-req.ch)
+
+	// all batches committed while waiting for checkpoint, primary hands over its leadership after the
+	// stable checkpoint.
+	delete(rbfts[1].storeMgr.outstandingReqBatches, "digest")
+	h, d := rbfts[1].chainConfig.H, rbfts[1].chainConfig.LastCheckpointExecBlockHash
+	checkpoint := &consensus.SignedCheckpoint{
+		Author: 2,
+		Checkpoint: &consensus.Checkpoint{
+			Epoch:        rbfts[1].chainConfig.EpochInfo.Epoch,
+			ExecuteState: &consensus.Checkpoint_ExecuteState{Height: h, Digest: d},
+		},
+	}
+	rbfts[1].finishNormalCheckpoint(h, d, []*consensus.SignedCheckpoint{checkpoint})
+	assert.False(t, rbfts[1].vcMgr.leaderTransferring)
+	assert.Equal(t, uint64(2), rbfts[1].chainConfig.View)
+	assert.Equal(t, uint64(2), rbfts[1].vcMgr.leaderTransferView)
+	vc := &consensus.ViewChange{}
+	_ = vc.UnmarshalVT(nodes[1].broadcastMessageCache.Payload)
+	assert.True(t, vc.LeaderTransfer)
+
+	// backup accepts a valid leader transfer from primary and moves to next view directly.
+	lt := &consensus.LeaderTransfer{
+		ReplicaId:      2,
+		View:           1,
+		SequenceNumber: rbfts[1].exec.lastExec,
+	}
+	sig, err := rbfts[1].signLeaderTransfer(lt)
+	assert.Nil(t, err)
+	lt.Signature = sig
+
+	// leader transfer is rejected if primary has proposed batches beyond its seqNo.
+	rbfts[2].storeMgr.getCert(1, lt.SequenceNumber+1, "digest").prePrepare = &consensus.PrePrepare{}
+	rbfts[2].recvLeaderTransfer(lt)
+	assert.Equal(t, uint64(1), rbfts[2].chainConfig.View)
+	delete(rbfts[2].storeMgr.certStore, msgID{v: 1, n: lt.SequenceNumber + 1, d: "digest"})
+
+	rbfts[2].recvLeaderTransfer(lt)
+	assert.Equal(t, uint64(2), rbfts[2].chainConfig.View)
+	vc = &consensus.ViewChange{}
+	_ = vc.UnmarshalVT(nodes[2].broadcastMessageCache.Payload)
+	assert.True(t, vc.LeaderTransfer)
+
+	// leader transfer from non-primary is ignored.
+	lt.ReplicaId = 4
+	rbfts[3].recvLeaderTransfer(lt)
+	assert.Equal(t, uint64(1), rbfts[3].chainConfig.View)
+}
+
+func TestVC_punishAbnormalNodesReputation(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	rbfts[0].chainConfig.EpochInfo.ConsensusParams.ProposerElectionType = ProposerElectionTypeReputation
+	lastStableValidatorDynamicInfoMap := func() map[uint64]*ValidatorInfo {
+		return lo.MapEntries(rbfts[0].chainConfig.LastStableValidatorDynamicInfoMap, func(k uint64, v *ValidatorInfo) (uint64, *ValidatorInfo) {
+			return k, &ValidatorInfo{
+				ID:                   v.ID,
+				ConsensusVotingPower: v.ConsensusVotingPower,
+			}
+		})
+	}
+
+	// primary of view 0 timed out.
+	validatorDynamicInfoMap := lastStableValidatorDynamicInfoMap()
+	abnormal0 := rbfts[0].chainConfig.calPrimaryIDByView(0, validatorDynamicInfoMap)
+	rbfts[0].punishAbnormalNodes(true, false, 1, validatorDynamicInfoMap)
+	assert.Equal(t, reputationTimeoutPenalty, validatorDynamicInfoMap[abnormal0].ReputationPenalty)
+
+	// primary of view 1 timed out too, the penalty of view 0 decays.
+	abnormal1 := rbfts[0].chainConfig.calPrimaryIDByView(1, validatorDynamicInfoMap)
+	validatorDynamicInfoMap = lastStableValidatorDynamicInfoMap()
+	rbfts[0].punishAbnormalNodes(true, false, 2, validatorDynamicInfoMap)
+	decayedPenalty := reputationTimeoutPenalty * reputationPenaltyDecayPercentage / 100
+	if abnormal0 == abnormal1 {
+		assert.Equal(t, decayedPenalty+reputationTimeoutPenalty, validatorDynamicInfoMap[abnormal0].ReputationPenalty)
+	} else {
+		assert.Equal(t, decayedPenalty, validatorDynamicInfoMap[abnormal0].ReputationPenalty)
+		assert.Equal(t, reputationTimeoutPenalty, validatorDynamicInfoMap[abnormal1].ReputationPenalty)
+	}
+}
+
+func TestVC_reputationPenaltiesAcrossEpochs(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	rbft := rbfts[0]
+	rbft.chainConfig.EpochInfo.ConsensusParams.ProposerElectionType = ProposerElectionTypeReputation
+	rbft.chainConfig.LastStableValidatorDynamicInfoMap[2].ReputationPenalty = reputationTimeoutPenalty
+
+	// penalties are carried by the checkpoint of epoch change and covered by its signature.
+	state := &types.ServiceState{MetaState: &types.MetaState{Height: 10, Digest: "digest"}, Epoch: rbft.chainConfig.EpochInfo.Epoch}
+	signedCheckpoint, err := rbft.generateSignedCheckpoint(state, "batch", true, false)
+	assert.Nil(t, err)
+	expected := []*consensus.NodeDynamicInfo{{Id: 2, ReputationPenalty: reputationTimeoutPenalty}}
+	assert.Equal(t, expected, signedCheckpoint.Checkpoint.ReputationPenalties)
+	forged := signedCheckpoint.Checkpoint.CloneVT()
+	forged.ReputationPenalties[0].ReputationPenalty = 0
+	assert.NotEqual(t, signedCheckpoint.Checkpoint.Hash(), forged.Hash())
+
+	// penalties are restored from the quorum checkpoint of epoch change rather than local state.
+	rbft.chainConfig.LastStableValidatorDynamicInfoMap[2].ReputationPenalty = 0
+	rbft.chainConfig.ValidatorDynamicInfoMap[2].ReputationPenalty = 0
+	rbft.epochMgr.persistEpochQuorumCheckpoint(&consensus.QuorumCheckpoint{Checkpoint: signedCheckpoint.Checkpoint})
+	rbft.chainConfig.EpochInfo.Epoch++
+	rbft.restoreReputationPenalties()
+	assert.Equal(t, reputationTimeoutPenalty, rbft.chainConfig.LastStableValidatorDynamicInfoMap[2].ReputationPenalty)
+	assert.Equal(t, reputationTimeoutPenalty, rbft.chainConfig.ValidatorDynamicInfoMap[2].ReputationPenalty)
+
+	// checkpoint not of epoch change carries no penalties.
+	signedCheckpoint, err = rbft.generateSignedCheckpoint(state, "batch", false, false)
+	assert.Nil(t, err)
+	assert.Nil(t, signedCheckpoint.Checkpoint.ReputationPenalties)
+}
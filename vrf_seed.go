@@ -0,0 +1,100 @@
+package rbft
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// isVRFSeedEnabled returns if the proposer seed is derived from VRF output.
+func (rbft *rbftImpl[T, Constraint]) isVRFSeedEnabled() bool {
+	return rbft.vrf != nil
+}
+
+// vrfInput returns the message proved by the proposer of the batch with the given seqNo.
+func vrfInput(epoch uint64, seqNo uint64) []byte {
+	msg := []byte("rbft-vrf-seed")
+	msg = binary.BigEndian.AppendUint64(msg, epoch)
+	msg = binary.BigEndian.AppendUint64(msg, seqNo)
+	return msg
+}
+
+// proveVRF generates the VRF output and proof of local node for the batch with the given seqNo.
+func (rbft *rbftImpl[T, Constraint]) proveVRF(seqNo uint64) ([]byte, []byte, error) {
+	return rbft.vrf.VRFProve(vrfInput(rbft.chainConfig.EpochInfo.Epoch, seqNo))
+}
+
+// verifyVRFProof verifies the VRF proof published by the proposer of the batch with the given seqNo,
+// and returns the VRF output.
+func (rbft *rbftImpl[T, Constraint]) verifyVRFProof(seqNo uint64, proposer uint64, proof []byte) ([]byte, error) {
+	if len(proof) == 0 {
+		return nil, fmt.Errorf("missing vrf proof of proposer %d", proposer)
+	}
+	output, err := rbft.vrf.VRFVerify(proposer, vrfInput(rbft.chainConfig.EpochInfo.Epoch, seqNo), proof)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vrf proof of proposer %d: %w", proposer, err)
+	}
+	if len(output) == 0 {
+		return nil, fmt.Errorf("empty vrf output of proposer %d", proposer)
+	}
+	return output, nil
+}
+
+// verifyBatchVRF verifies the VRF proof of a batch which is not received from the primary in
+// pre-prepare, e.g. a fetched batch, and sets its VRF output. The VRF output sent by peers is never
+// trusted. The proposer and the VRF proof are covered by the batch digest, so a batch matching the
+// digest has the proof agreed by replicas, while the proof is still verified against the seqNo
+// assigned to the digest.
+func (rbft *rbftImpl[T, Constraint]) verifyBatchVRF(n uint64, batch *RequestBatch[T, Constraint]) error {
+	batch.VRFOutput = nil
+	if batch.SeqNo != n {
+		return fmt.Errorf("mismatch seqNo of batch, expected %d, got %d", n, batch.SeqNo)
+	}
+	output, err := rbft.verifyVRFProof(n, batch.Proposer, batch.VRFProof)
+	if err != nil {
+		return err
+	}
+	batch.VRFOutput = output
+	return nil
+}
+
+// recordVRFOutput records the VRF output of the executed batch, which is carried by the checkpoint of
+// this batch as the seed to select next proposer. The output is verified when the batch is accepted
+// and persisted with the batch, a batch without output, e.g. the batch of a null request, is recorded
+// without output and the block hash is used instead.
+func (rbft *rbftImpl[T, Constraint]) recordVRFOutput(n uint64, d string) {
+	batch, ok := rbft.storeMgr.batchStore[d]
+	if ok && len(batch.VRFOutput) == 0 && len(batch.VRFProof) != 0 {
+		// batches persisted by old versions have no VRF output.
+		_ = rbft.verifyBatchVRF(n, batch)
+	}
+	if !ok || len(batch.VRFOutput) == 0 {
+		if d == "" {
+			return
+		}
+		rbft.logger.Warningf("Replica %d found no vrf output for seqNo=%d/digest=%s",
+			rbft.chainConfig.SelfID, n, d)
+		return
+	}
+	rbft.storeMgr.vrfOutputs[n] = batch.VRFOutput
+}
+
+// vrfOutput returns the VRF output of the executed batch with the given seqNo, the persisted batch is
+// used if the output is not recorded yet, e.g. the replica restarted before the checkpoint.
+func (rbft *rbftImpl[T, Constraint]) vrfOutput(n uint64, d string) []byte {
+	if output, ok := rbft.storeMgr.vrfOutputs[n]; ok {
+		return output
+	}
+	if batch, ok := rbft.storeMgr.batchStore[d]; ok && batch.SeqNo == n {
+		return batch.VRFOutput
+	}
+	return nil
+}
+
+// cleanVRFOutputs cleans VRF outputs with seqNo not larger than h.
+func (rbft *rbftImpl[T, Constraint]) cleanVRFOutputs(h uint64) {
+	for n := range rbft.storeMgr.vrfOutputs {
+		if n <= h {
+			delete(rbft.storeMgr.vrfOutputs, n)
+		}
+	}
+}
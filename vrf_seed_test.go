@@ -0,0 +1,176 @@
+package rbft
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axiomesh/axiom-bft/common/consensus"
+	"github.com/axiomesh/axiom-bft/types"
+)
+
+// testVRF derives the VRF output from node ID and msg, and uses the output as proof.
+type testVRF struct {
+	id uint64
+}
+
+func testVRFOutput(id uint64, msg []byte) []byte {
+	h := sha256.Sum256(append(binary.BigEndian.AppendUint64(nil, id), msg...))
+	return h[:]
+}
+
+func (v *testVRF) VRFProve(msg []byte) ([]byte, []byte, error) {
+	output := testVRFOutput(v.id, msg)
+	return output, output, nil
+}
+
+func (v *testVRF) VRFVerify(nodeID uint64, msg []byte, proof []byte) ([]byte, error) {
+	output := testVRFOutput(nodeID, msg)
+	if !bytes.Equal(proof, output) {
+		return nil, errors.New("invalid proof")
+	}
+	return output, nil
+}
+
+func TestVRFSeed_ExecuteBatchWithVRFProof(t *testing.T) {
+	nodes, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	for _, r := range rbfts {
+		r.vrf = &testVRF{id: r.chainConfig.SelfID}
+	}
+
+	tx := newTx()
+	for _, r := range rbfts {
+		_ = r.batchMgr.requestPool.AddLocalTx(tx)
+	}
+	rbfts[0].processEvent(&LocalEvent{
+		Service:   CoreRbftService,
+		EventType: CoreBatchTimerEvent,
+	})
+	prePrepMsg := nodes[0].broadcastMessageCache
+	assert.Equal(t, consensus.Type_PRE_PREPARE, prePrepMsg.Type)
+	prePrep := &consensus.PrePrepare{}
+	assert.Nil(t, prePrep.UnmarshalVT(prePrepMsg.Payload))
+	expectedOutput := testVRFOutput(1, vrfInput(rbfts[0].chainConfig.EpochInfo.Epoch, 1))
+	assert.Equal(t, expectedOutput, prePrep.HashBatch.VrfProof)
+
+	// the proposer and the proof are covered by the batch digest.
+	assert.Equal(t, calculateBatchDigest(prePrep.HashBatch), prePrep.BatchDigest)
+	assert.NotEqual(t, calculateMD5Hash(prePrep.HashBatch.RequestHashList, prePrep.HashBatch.Timestamp), prePrep.BatchDigest)
+	forged := prePrep.HashBatch.CloneVT()
+	forged.Proposer = 2
+	assert.NotEqual(t, prePrep.BatchDigest, calculateBatchDigest(forged))
+
+	// replica rejects the batch with a proof of other node.
+	prePrep.HashBatch.VrfProof = testVRFOutput(2, vrfInput(rbfts[0].chainConfig.EpochInfo.Epoch, 1))
+	invalidMsg := prePrepMsg.CloneVT()
+	invalidMsg.Payload, _ = prePrep.MarshalVTStrict()
+	rbfts[3].processEvent(&consensusMessageWrapper{ctx: context.Background(), ConsensusMessage: invalidMsg})
+	assert.Equal(t, consensus.Type_VIEW_CHANGE, nodes[3].broadcastMessageCache.Type)
+
+	prepares := make([]*consensusMessageWrapper, 3)
+	for i := 1; i < 3; i++ {
+		rbfts[i].processEvent(prePrepMsg)
+		prepares[i] = nodes[i].broadcastMessageCache
+		assert.Equal(t, consensus.Type_PREPARE, prepares[i].Type)
+	}
+	commits := make([]*consensusMessageWrapper, 3)
+	for i := 0; i < 3; i++ {
+		for j := 1; j < 3; j++ {
+			if j != i {
+				rbfts[i].processEvent(prepares[j])
+			}
+		}
+		commits[i] = nodes[i].broadcastMessageCache
+		assert.Equal(t, consensus.Type_COMMIT, commits[i].Type)
+	}
+	for i := 0; i < 3; i++ {
+		rbfts[i].processEvent(commits[(i+1)%3])
+		rbfts[i].processEvent(commits[(i+2)%3])
+		assert.Equal(t, uint64(1), rbfts[i].exec.lastExec)
+		assert.Equal(t, expectedOutput, rbfts[i].storeMgr.vrfOutputs[1])
+	}
+
+	// the VRF output is carried by checkpoint and used as proposer seed.
+	state := &types.ServiceState{
+		MetaState: &types.MetaState{Height: 1, Digest: "block-1"},
+		Epoch:     rbfts[0].chainConfig.EpochInfo.Epoch,
+	}
+	signedCheckpoint, err := rbfts[0].generateSignedCheckpoint(state, prePrep.BatchDigest, false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedOutput, signedCheckpoint.Checkpoint.ExecuteState.VrfOutput)
+	rbfts[0].chainConfig.LastCheckpointVRFOutput = signedCheckpoint.Checkpoint.ExecuteState.VrfOutput
+	assert.True(t, bytes.HasPrefix(rbfts[0].chainConfig.wrfSeed(0), expectedOutput))
+}
+
+func TestVRFSeed_fetchBatchWithVRFProof(t *testing.T) {
+	_, rbfts := newBasicClusterInstance[consensus.FltTransaction, *consensus.FltTransaction]()
+	unlockCluster(rbfts)
+	rbft := rbfts[0]
+	rbft.vrf = &testVRF{id: rbft.chainConfig.SelfID}
+	epoch := rbft.chainConfig.EpochInfo.Epoch
+
+	tx := newTx()
+	batch := &RequestBatch[consensus.FltTransaction, *consensus.FltTransaction]{
+		RequestHashList: []string{tx.RbftGetTxHash()},
+		RequestList:     []*consensus.FltTransaction{tx},
+		Timestamp:       1,
+		SeqNo:           2,
+		LocalList:       []bool{false},
+		Proposer:        2,
+		VRFProof:        testVRFOutput(2, vrfInput(epoch, 2)),
+	}
+	digest := calculateBatchDigest(batch.hashBatch())
+	batch.BatchHash = digest
+
+	// a batch with the proof replaced doesn't match the digest.
+	forged := *batch
+	forged.Proposer = 3
+	forged.VRFProof = testVRFOutput(3, vrfInput(epoch, 2))
+	assert.NotNil(t, verifyFetchedBatch(digest, &forged))
+	rbft.storeMgr.missingReqBatches[digest] = 2
+
+	recv := func(b *RequestBatch[consensus.FltTransaction, *consensus.FltTransaction]) {
+		pb, err := b.ToPB()
+		assert.Nil(t, err)
+		rbft.recvFetchBatchResponse(&consensus.FetchBatchResponse{Batch: pb, BatchDigest: digest, ReplicaId: 3})
+	}
+
+	// the proof of the proposer for another seqNo is rejected.
+	replaced := *batch
+	replaced.SeqNo = 3
+	replaced.VRFProof = testVRFOutput(2, vrfInput(epoch, 3))
+	recv(&replaced)
+	assert.NotContains(t, rbft.storeMgr.batchStore, digest)
+
+	// the stripped proof is rejected, and the VRF output of peers is not trusted.
+	stripped := *batch
+	stripped.VRFProof = nil
+	stripped.VRFOutput = testVRFOutput(2, vrfInput(epoch, 2))
+	recv(&stripped)
+	assert.NotContains(t, rbft.storeMgr.batchStore, digest)
+
+	expectedOutput := testVRFOutput(2, vrfInput(epoch, 2))
+	recv(batch)
+	assert.Contains(t, rbft.storeMgr.batchStore, digest)
+	assert.Equal(t, expectedOutput, rbft.storeMgr.batchStore[digest].VRFOutput)
+
+	// the VRF output is persisted with the batch.
+	raw, err := rbft.storage.ReadState("batch." + digest)
+	assert.Nil(t, err)
+	restored := &RequestBatch[consensus.FltTransaction, *consensus.FltTransaction]{}
+	assert.Nil(t, restored.Unmarshal(raw))
+	assert.Equal(t, expectedOutput, restored.VRFOutput)
+	rbft.storeMgr.batchStore[digest] = restored
+	assert.Equal(t, expectedOutput, rbft.vrfOutput(2, digest))
+
+	// a batch in xset with an invalid proof is fetched again.
+	restored.VRFProof = testVRFOutput(1, vrfInput(epoch, 2))
+	assert.True(t, rbft.checkIfNeedFetchMissingReqBatch([]*consensus.VcPq{{SequenceNumber: 2, BatchDigest: digest}}))
+	assert.Equal(t, uint64(2), rbft.storeMgr.missingReqBatches[digest])
+}